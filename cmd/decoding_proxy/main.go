@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/codecrafters-io/kafka-tester/internal/decoding_proxy"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+// decoding_proxy sits between a Kafka client and a broker, and prints every request and response
+// passing through as a decoded tree.
+//
+// Usage: go run ./cmd/decoding_proxy -listen localhost:9093 -broker localhost:9092
+func main() {
+	listenAddr := flag.String("listen", "localhost:9093", "address to accept client connections on")
+	brokerAddr := flag.String("broker", "localhost:9092", "address of the broker to forward connections to")
+	flag.Parse()
+
+	proxy := decoding_proxy.DecodingProxy{
+		ListenAddr: *listenAddr,
+		BrokerAddr: *brokerAddr,
+		Logger:     logger.GetLogger(true, "[proxy] "),
+	}
+
+	if err := proxy.Run(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
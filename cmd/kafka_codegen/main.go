@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_codegen"
+)
+
+// kafka_codegen generates kafkaapi types, encoders and decoders from Kafka's JSON message specs
+// (clients/src/main/resources/common/message/*.json in the Apache Kafka repository).
+//
+// Usage: go run ./cmd/kafka_codegen -specs internal/kafka_codegen/specs -out protocol/generated_kafkaapi
+func main() {
+	specsDir := flag.String("specs", "internal/kafka_codegen/specs", "directory containing message specs")
+	outputDir := flag.String("out", "protocol/generated_kafkaapi", "directory to write generated code to")
+	flag.Parse()
+
+	absoluteOutputDir, err := filepath.Abs(*outputDir)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := kafka_codegen.Generate(*specsDir, *outputDir, filepath.Base(absoluteOutputDir)); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -7,6 +7,8 @@ toolchain go1.24.2
 require (
 	github.com/codecrafters-io/tester-utils v0.4.13
 	github.com/google/uuid v1.6.0
+	github.com/klauspost/compress v1.19.0
+	github.com/pierrec/lz4/v4 v4.1.30
 	github.com/stretchr/testify v1.10.0
 )
 
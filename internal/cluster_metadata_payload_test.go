@@ -80,3 +80,28 @@ func TestEncodePartitionRecordPayload(t *testing.T) {
 
 // ToDo: Add test for record
 // ToDo: Add test for recordBatch
+
+func TestEncodeUserScramCredentialRecordPayload(t *testing.T) {
+	userScramCredentialRecord := kafkaapi.ClusterMetadataPayload{
+		FrameVersion: 1,
+		Type:         11,
+		Version:      0,
+		Data: &kafkaapi.UserScramCredentialRecord{
+			Name:       "bob",
+			Mechanism:  1,
+			Salt:       []byte{0xaa, 0xbb},
+			StoredKey:  []byte{0x01},
+			ServerKey:  []byte{0x02},
+			Iterations: 4096,
+		},
+	}
+
+	encoder := encoder.NewEncoder()
+	userScramCredentialRecord.Encode(encoder)
+
+	encoderBytes := encoder.Bytes()
+
+	fmt.Printf("%s\n", hex.Dump(encoderBytes))
+
+	assert.Equal(t, "010b0004626f620103aabb020102020000100000", hex.EncodeToString(encoderBytes))
+}
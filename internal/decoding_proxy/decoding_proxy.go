@@ -0,0 +1,157 @@
+package decoding_proxy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_client"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+// DecodingProxy forwards connections to a broker, and prints a decoded tree for every request and
+// response passing through. It's used to observe how real clients (for eg. kafka-console-producer)
+// interact with a broker implementation.
+//
+// Messages are forwarded unchanged, including the broker addresses in Metadata and FindCoordinator responses. After
+// bootstrapping, real clients connect to the address the broker advertises and stop going through the proxy, unless
+// the broker is configured to advertise the proxy's ListenAddr (advertised.listeners).
+type DecodingProxy struct {
+	ListenAddr string
+	BrokerAddr string
+	Logger     *logger.Logger
+}
+
+func (p *DecodingProxy) Run() error {
+	listener, err := net.Listen("tcp", p.ListenAddr)
+	if err != nil {
+		return fmt.Errorf("Failed to listen on %s: %v", p.ListenAddr, err)
+	}
+	defer listener.Close()
+
+	p.Logger.Infof("Listening on %s, forwarding to broker at %s", p.ListenAddr, p.BrokerAddr)
+
+	connectionCount := 0
+
+	for {
+		clientConn, err := listener.Accept()
+		if err != nil {
+			return fmt.Errorf("Failed to accept connection: %v", err)
+		}
+
+		connectionCount++
+		connectionLogger := p.Logger.Clone()
+		connectionLogger.PushSecondaryPrefix(fmt.Sprintf("conn-%d", connectionCount))
+
+		go p.handleConnection(clientConn, connectionLogger)
+	}
+}
+
+func (p *DecodingProxy) handleConnection(clientConn net.Conn, logger *logger.Logger) {
+	defer clientConn.Close()
+
+	brokerConn, err := net.Dial("tcp", p.BrokerAddr)
+	if err != nil {
+		logger.Errorf("Failed to connect to broker at %s: %v", p.BrokerAddr, err)
+		return
+	}
+	defer brokerConn.Close()
+
+	logger.Infof("Accepted connection from %s", clientConn.RemoteAddr())
+
+	connection := &proxiedConnection{
+		logger:          logger,
+		pendingRequests: map[int32]pendingRequest{},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		// Closing the broker connection unblocks the other direction as well
+		defer brokerConn.Close()
+		connection.forwardMessages(clientConn, brokerConn, connection.logRequest)
+	}()
+
+	go func() {
+		defer wg.Done()
+		defer clientConn.Close()
+		connection.forwardMessages(brokerConn, clientConn, connection.logResponse)
+	}()
+
+	wg.Wait()
+	logger.Infof("Connection closed")
+}
+
+type pendingRequest struct {
+	apiKey     int16
+	apiVersion int16
+}
+
+// proxiedConnection holds state shared between both directions of a single client connection
+type proxiedConnection struct {
+	logger *logger.Logger
+
+	// pendingRequests maps correlation IDs to requests that haven't been responded to yet
+	pendingRequests      map[int32]pendingRequest
+	pendingRequestsMutex sync.Mutex
+}
+
+// forwardMessages copies length-prefixed messages from src to dst, logging each message before it's forwarded
+func (c *proxiedConnection) forwardMessages(src io.Reader, dst io.Writer, logMessage func(payload []byte)) {
+	for {
+		message, err := readMessage(src)
+
+		// A request has to be registered as pending before the broker can respond to it, so messages are logged first
+		if err == nil {
+			logMessage(message[4:])
+		}
+
+		if len(message) > 0 {
+			if _, writeErr := dst.Write(message); writeErr != nil {
+				c.logger.Errorf("Failed to forward message: %v", writeErr)
+				return
+			}
+		}
+
+		if err != nil {
+			if err != io.EOF {
+				c.logger.Errorf("%v", err)
+			}
+			return
+		}
+	}
+}
+
+// readMessage reads a single length-prefixed message. If the stream ends partway through a message,
+// the bytes read so far are returned along with an error, so that they can still be forwarded.
+func readMessage(src io.Reader) ([]byte, error) {
+	messageSizeBytes := make([]byte, 4)
+
+	// If no bytes were read, the connection was closed (by either side) between messages
+	n, err := io.ReadFull(src, messageSizeBytes)
+	if n == 0 && err != nil {
+		return nil, io.EOF
+	} else if err != nil {
+		return messageSizeBytes[:n], fmt.Errorf("Expected 4-byte integer (message size), only found %d bytes", n)
+	}
+
+	messageSize := int32(binary.BigEndian.Uint32(messageSizeBytes))
+	if messageSize < 0 {
+		return messageSizeBytes, fmt.Errorf("Expected message size to be a non-negative integer, got %d", messageSize)
+	}
+
+	if messageSize > kafka_client.MaxMessageSize {
+		return messageSizeBytes, fmt.Errorf("Expected message size to be at most %d bytes, got %d", kafka_client.MaxMessageSize, messageSize)
+	}
+
+	message, err := kafka_client.AppendMessageBody(messageSizeBytes, src, int(messageSize))
+	if err != nil {
+		return message, fmt.Errorf("Expected message to be %d bytes long (message size), only found %d bytes", messageSize, len(message)-4)
+	}
+
+	return message, nil
+}
@@ -0,0 +1,245 @@
+package decoding_proxy
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_tree_printer"
+	"github.com/codecrafters-io/kafka-tester/internal/inspectable_hex_dump"
+	"github.com/codecrafters-io/kafka-tester/internal/request_decoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+)
+
+type decodeFunc func(decoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError
+
+// supportedApi lists the decoders for an API, utils.IsSupportedApiVersion decides which versions they're used for
+type supportedApi struct {
+	decodeRequest decodeFunc
+	// decodeResponse returns a decoder for responses to requests with the given version
+	decodeResponse func(version int16) decodeFunc
+	// minDecodedVersion is set if the decoders don't handle the oldest versions that utils.IsSupportedApiVersion allows
+	minDecodedVersion int16
+}
+
+func (a supportedApi) isDecodedVersion(apiKey int16, apiVersion int16) bool {
+	return utils.IsSupportedApiVersion(apiKey, apiVersion) && apiVersion >= a.minDecodedVersion
+}
+
+var supportedApis = map[int16]supportedApi{
+	0: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeProduceRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(response_decoders.DecodeProduceResponseForVersion(version))
+		},
+	},
+	1: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeFetchRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(response_decoders.DecodeFetchResponseForVersion(version))
+		},
+	},
+	2: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeListOffsetsRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.ListOffsetsResponseDecoder(version))
+		},
+	},
+	3: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeMetadataRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.MetadataResponseDecoder(version))
+		},
+	},
+	8: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeOffsetCommitRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.OffsetCommitResponseDecoder(version))
+		},
+	},
+	9: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeOffsetFetchRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.OffsetFetchResponseDecoder(version))
+		},
+	},
+	10: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeFindCoordinatorRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.FindCoordinatorResponseDecoder(version))
+		},
+	},
+	11: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeJoinGroupRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.JoinGroupResponseDecoder(version))
+		},
+	},
+	12: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeHeartbeatRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.HeartbeatResponseDecoder(version))
+		},
+	},
+	13: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeLeaveGroupRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.LeaveGroupResponseDecoder(version))
+		},
+	},
+	14: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeSyncGroupRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.SyncGroupResponseDecoder(version))
+		},
+	},
+	17: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeSaslHandshakeRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(response_decoders.DecodeSaslHandshakeResponse)
+		},
+	},
+	18: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeApiVersionsRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(response_decoders.DecodeApiVersionsResponseForVersion(version))
+		},
+	},
+	19: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeCreateTopicsRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(generated_kafkaapi.CreateTopicsResponseDecoder(version))
+		},
+	},
+	36: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeSaslAuthenticateRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(response_decoders.DecodeSaslAuthenticateResponse)
+		},
+		// Versions 0 and 1 use non-compact AuthBytes, only the flexible version is decoded
+		minDecodedVersion: 2,
+	},
+	75: {
+		decodeRequest: ignoreDecodedValue(request_decoders.DecodeDescribeTopicPartitionsRequest),
+		decodeResponse: func(version int16) decodeFunc {
+			return ignoreDecodedValue(response_decoders.DecodeDescribeTopicPartitionsResponse)
+		},
+	},
+}
+
+func ignoreDecodedValue[T any](decode func(decoder *field_decoder.FieldDecoder) (T, field_decoder.FieldDecoderError)) decodeFunc {
+	return func(decoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {
+		_, err := decode(decoder)
+		return err
+	}
+}
+
+func (c *proxiedConnection) logRequest(payload []byte) {
+	// Every request header starts with api_key (2 bytes), api_version (2 bytes) and correlation_id (4 bytes)
+	if len(payload) < 8 {
+		c.logger.Errorf("Request is too short to contain a header (%d bytes)", len(payload))
+		return
+	}
+
+	apiKey := int16(binary.BigEndian.Uint16(payload[0:2]))
+	apiVersion := int16(binary.BigEndian.Uint16(payload[2:4]))
+	correlationId := int32(binary.BigEndian.Uint32(payload[4:8]))
+
+	c.pendingRequestsMutex.Lock()
+	c.pendingRequests[correlationId] = pendingRequest{apiKey: apiKey, apiVersion: apiVersion}
+	c.pendingRequestsMutex.Unlock()
+
+	c.logger.Infof("Request: %s v%d (correlation ID: %d)", apiKeyToName(apiKey), apiVersion, correlationId)
+
+	decoder := field_decoder.NewFieldDecoder(payload)
+	api, ok := supportedApis[apiKey]
+
+	if !ok || !api.isDecodedVersion(apiKey, apiVersion) {
+		_, err := request_decoders.DecodeRequestHeaderOnly(decoder, apiKeyToName(apiKey))
+		c.logDecodedTree("Request", decoder, payload, err)
+		c.logger.Infof("Body not decoded, %s v%d requests aren't supported by the tester", apiKeyToName(apiKey), apiVersion)
+		return
+	}
+
+	if err := api.decodeRequest(decoder); err != nil {
+		c.logDecodedTree("Request", decoder, payload, err)
+		return
+	}
+
+	c.logDecodedTree("Request", decoder, payload, nil)
+	c.logRemainingBytes("Request", decoder)
+}
+
+func (c *proxiedConnection) logResponse(payload []byte) {
+	if len(payload) < 4 {
+		c.logger.Errorf("Response is too short to contain a correlation ID (%d bytes)", len(payload))
+		return
+	}
+
+	correlationId := int32(binary.BigEndian.Uint32(payload[0:4]))
+
+	c.pendingRequestsMutex.Lock()
+	request, ok := c.pendingRequests[correlationId]
+	delete(c.pendingRequests, correlationId)
+	c.pendingRequestsMutex.Unlock()
+
+	if !ok {
+		c.logger.Errorf("Response has correlation ID %d, which doesn't match any pending request", correlationId)
+		c.logger.Debugf("Hexdump of response: \n%v\n", utils.GetFormattedHexdump(payload))
+		return
+	}
+
+	c.logger.Infof("Response: %s v%d (correlation ID: %d)", apiKeyToName(request.apiKey), request.apiVersion, correlationId)
+
+	api, ok := supportedApis[request.apiKey]
+	if !ok || !api.isDecodedVersion(request.apiKey, request.apiVersion) {
+		c.logger.Infof("Not decoded, %s v%d responses aren't supported by the tester", apiKeyToName(request.apiKey), request.apiVersion)
+		c.logger.Debugf("Hexdump of response: \n%v\n", utils.GetFormattedHexdump(payload))
+		return
+	}
+
+	decoder := field_decoder.NewFieldDecoder(payload)
+	if err := api.decodeResponse(request.apiVersion)(decoder); err != nil {
+		c.logDecodedTree("Response", decoder, payload, err)
+		return
+	}
+
+	c.logDecodedTree("Response", decoder, payload, nil)
+	c.logRemainingBytes("Response", decoder)
+}
+
+func (c *proxiedConnection) logDecodedTree(direction string, decoder *field_decoder.FieldDecoder, payload []byte, decodeError field_decoder.FieldDecoderError) {
+	fieldTreePrinterLogger := c.logger.Clone()
+	fieldTreePrinterLogger.PushSecondaryPrefix(direction)
+
+	fieldTreePrinter := field_tree_printer.FieldTreePrinter{
+		Fields: decoder.DecodedFields(),
+		Logger: fieldTreePrinterLogger,
+	}
+
+	if decodeError != nil {
+		fieldTreePrinter.PrintForDecodeError(decodeError.Path())
+		c.logger.Errorln("Received bytes:")
+		c.logger.Errorln(inspectable_hex_dump.NewInspectableHexDump(payload).FormatWithHighlightedRange(decodeError.StartOffset(), decodeError.EndOffset()))
+		c.logger.Errorln(decodeError.Error())
+		return
+	}
+
+	fieldTreePrinter.PrintForDebugLogs()
+}
+
+func (c *proxiedConnection) logRemainingBytes(direction string, decoder *field_decoder.FieldDecoder) {
+	if remainingBytesCount := decoder.RemainingBytesCount(); remainingBytesCount > 0 {
+		c.logger.Errorf("Found %d unexpected bytes after decoding %s", remainingBytesCount, direction)
+	}
+}
+
+func apiKeyToName(apiKey int16) string {
+	if !utils.IsKnownAPIKey(apiKey) {
+		return fmt.Sprintf("Unknown(%d)", apiKey)
+	}
+
+	return utils.APIKeyToName(apiKey)
+}
@@ -2,6 +2,8 @@ package field_decoder
 
 import (
 	"fmt"
+	"io"
+	"slices"
 	"strings"
 
 	"github.com/codecrafters-io/kafka-tester/internal/field"
@@ -29,6 +31,24 @@ func NewFieldDecoder(bytes []byte) *FieldDecoder {
 	}
 }
 
+// NewFieldDecoderFromReader returns a FieldDecoder that streams length bytes from reader.
+//
+// Offsets in decoded fields and errors are still relative to the start of the reader, so they can be highlighted in hex dumps.
+//
+// This is only used for log segments on disk. Responses are read into memory by kafka_client, which caps them at kafka_client.MaxMessageSize.
+func NewFieldDecoderFromReader(reader io.Reader, length uint64) *FieldDecoder {
+	return &FieldDecoder{
+		currentPathContexts: []pathContext{},
+		decodedFields:       []field.Field{},
+		decoder:             decoder.NewDecoderFromReader(reader, length),
+	}
+}
+
+// ReadErr returns the error that the underlying reader failed with, this should be checked before trusting decoded values
+func (d *FieldDecoder) ReadErr() error {
+	return d.decoder.ReadErr()
+}
+
 func (d *FieldDecoder) ReadBytesCount() uint64 {
 	return d.decoder.ReadBytesCount()
 }
@@ -117,11 +137,30 @@ func (d *FieldDecoder) ReadCompactNullableStringField(path string) (field.Field,
 	d.PushPathContext(path)
 	defer d.PopPathContext()
 
+	decodedValue, err := d.decoder.ReadCompactNullableString()
+	if err != nil {
+		return field.Field{}, d.wrapError(err)
+	}
+
+	d.appendDecodedField(decodedValue)
+
+	return d.getLastDecodedField(), nil
+}
+
+func (d *FieldDecoder) ReadCompactStringField(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
 	lengthValue, err := d.decoder.ReadCompactArrayLength()
+
 	if err != nil {
 		return field.Field{}, d.wrapError(err)
 	}
 
+	if lengthValue.Value == 0 {
+		return field.Field{}, d.getDecoderErrorForLastPathContext(fmt.Errorf("Expected length of compact string to be non-zero, got %d", lengthValue.Value))
+	}
+
 	rawBytes, err := d.decoder.ReadRawBytes(int(lengthValue.ActualLength()))
 
 	if err != nil {
@@ -130,8 +169,8 @@ func (d *FieldDecoder) ReadCompactNullableStringField(path string) (field.Field,
 
 	stringValue := string(rawBytes.Value)
 
-	decodedValue := value.CompactNullableString{
-		Value: &stringValue,
+	decodedValue := value.CompactString{
+		Value: stringValue,
 	}
 
 	d.appendDecodedField(decodedValue)
@@ -139,30 +178,83 @@ func (d *FieldDecoder) ReadCompactNullableStringField(path string) (field.Field,
 	return d.getLastDecodedField(), nil
 }
 
-func (d *FieldDecoder) ReadCompactStringField(path string) (field.Field, FieldDecoderError) {
+func (d *FieldDecoder) ReadStringField(path string) (field.Field, FieldDecoderError) {
 	d.PushPathContext(path)
 	defer d.PopPathContext()
 
-	lengthValue, err := d.decoder.ReadCompactArrayLength()
+	decodedValue, err := d.decoder.ReadString()
+	if err != nil {
+		return field.Field{}, d.wrapError(err)
+	}
+
+	d.appendDecodedField(decodedValue)
+
+	return d.getLastDecodedField(), nil
+}
 
+func (d *FieldDecoder) ReadNullableStringField(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
+	decodedValue, err := d.decoder.ReadNullableString()
 	if err != nil {
 		return field.Field{}, d.wrapError(err)
 	}
 
-	if lengthValue.Value == 0 {
-		return field.Field{}, d.getDecoderErrorForLastPathContext(fmt.Errorf("Expected length of compact string to be non-zero, got %d", lengthValue.Value))
+	d.appendDecodedField(decodedValue)
+
+	return d.getLastDecodedField(), nil
+}
+
+func (d *FieldDecoder) ReadCompactBytesField(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
+	decodedValue, err := d.decoder.ReadCompactBytes()
+	if err != nil {
+		return field.Field{}, d.wrapError(err)
 	}
 
-	rawBytes, err := d.decoder.ReadRawBytes(int(lengthValue.ActualLength()))
+	d.appendDecodedField(decodedValue)
+
+	return d.getLastDecodedField(), nil
+}
 
+func (d *FieldDecoder) ReadBytesField(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
+	decodedValue, err := d.decoder.ReadBytes()
 	if err != nil {
 		return field.Field{}, d.wrapError(err)
 	}
 
-	stringValue := string(rawBytes.Value)
+	d.appendDecodedField(decodedValue)
 
-	decodedValue := value.CompactString{
-		Value: stringValue,
+	return d.getLastDecodedField(), nil
+}
+
+func (d *FieldDecoder) ReadNullableBytesField(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
+	decodedValue, err := d.decoder.ReadNullableBytes()
+	if err != nil {
+		return field.Field{}, d.wrapError(err)
+	}
+
+	d.appendDecodedField(decodedValue)
+
+	return d.getLastDecodedField(), nil
+}
+
+func (d *FieldDecoder) ReadCompactNullableBytesField(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
+	decodedValue, err := d.decoder.ReadCompactNullableBytes()
+	if err != nil {
+		return field.Field{}, d.wrapError(err)
 	}
 
 	d.appendDecodedField(decodedValue)
@@ -226,6 +318,20 @@ func (d *FieldDecoder) ReadInt8Field(path string) (field.Field, FieldDecoderErro
 	return d.getLastDecodedField(), nil
 }
 
+func (d *FieldDecoder) ReadUint16Field(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
+	decodedValue, err := d.decoder.ReadUint16()
+	if err != nil {
+		return field.Field{}, d.wrapError(err)
+	}
+
+	d.appendDecodedField(decodedValue)
+
+	return d.getLastDecodedField(), nil
+}
+
 func (d *FieldDecoder) ReadInt32Field(path string) (field.Field, FieldDecoderError) {
 	d.PushPathContext(path)
 	defer d.PopPathContext()
@@ -255,6 +361,20 @@ func (d *FieldDecoder) ReadInt64Field(path string) (field.Field, FieldDecoderErr
 	return d.getLastDecodedField(), nil
 }
 
+func (d *FieldDecoder) ReadFloat64Field(path string) (field.Field, FieldDecoderError) {
+	d.PushPathContext(path)
+	defer d.PopPathContext()
+
+	decodedValue, err := d.decoder.ReadFloat64()
+	if err != nil {
+		return field.Field{}, d.wrapError(err)
+	}
+
+	d.appendDecodedField(decodedValue)
+
+	return d.getLastDecodedField(), nil
+}
+
 func (d *FieldDecoder) ReadRawBytes(path string, count int) (field.Field, FieldDecoderError) {
 	d.PushPathContext(path)
 	defer d.PopPathContext()
@@ -269,6 +389,143 @@ func (d *FieldDecoder) ReadRawBytes(path string, count int) (field.Field, FieldD
 	return d.getLastDecodedField(), nil
 }
 
+// KnownTaggedField describes a tagged field that ReadTaggedFieldsField decodes into named fields
+type KnownTaggedField struct {
+	Name string
+
+	// DecodeFunc reads the data of the tagged field, it's expected to read the value using Name as its path
+	DecodeFunc func(decoder *FieldDecoder) FieldDecoderError
+}
+
+// ReadTaggedFieldsField reads a tag buffer
+//
+// Tags present in knownTaggedFields are decoded in place, and show up in the field tree under their name. All other
+// tags show up as raw bytes, and are returned so that they aren't lost.
+func (d *FieldDecoder) ReadTaggedFieldsField(knownTaggedFields map[uint64]KnownTaggedField) (value.TaggedFields, FieldDecoderError) {
+	d.PushPathContext("TAG_BUFFER")
+	defer d.PopPathContext()
+
+	tagCount, err := d.decoder.ReadUnsignedVarint()
+	if err != nil {
+		return value.TaggedFields{}, d.wrapError(err)
+	}
+
+	unknownTaggedFields := value.TaggedFields{}
+	var previousTag uint64
+
+	for i := range tagCount.Value {
+		tagStartOffset := d.ReadBytesCount()
+		tag, err := d.decoder.ReadUnsignedVarint()
+		if err != nil {
+			return value.TaggedFields{}, d.wrapError(err)
+		}
+
+		if i > 0 && tag.Value <= previousTag {
+			return value.TaggedFields{}, d.wrapError(decoder.NewDecoderErrorForRange(
+				fmt.Errorf("Expected tags in tag buffer to be in increasing order, got tag %d after tag %d", tag.Value, previousTag),
+				int(tagStartOffset),
+				int(d.ReadBytesCount()-1),
+			))
+		}
+
+		previousTag = tag.Value
+
+		length, err := d.decoder.ReadUnsignedVarint()
+		if err != nil {
+			return value.TaggedFields{}, d.wrapError(err)
+		}
+
+		if length.Value > d.RemainingBytesCount() {
+			return value.TaggedFields{}, d.wrapError(decoder.NewDecoderErrorForOffset(
+				fmt.Errorf("Expected length of tagged field %d to be lesser than remaining bytes (%d), got %d", tag.Value, d.RemainingBytesCount(), length.Value),
+				int(d.ReadBytesCount()),
+			))
+		}
+
+		if knownTaggedField, ok := knownTaggedFields[tag.Value]; ok {
+			if err := d.readKnownTaggedField(knownTaggedField, length.Value); err != nil {
+				return value.TaggedFields{}, err
+			}
+
+			continue
+		}
+
+		d.PushPathContext(fmt.Sprintf("UnknownTag(%d)", tag.Value))
+		data, err := d.decoder.ReadRawBytes(int(length.Value))
+		if err != nil {
+			return value.TaggedFields{}, d.wrapError(err)
+		}
+
+		d.appendDecodedField(data)
+		d.PopPathContext()
+
+		unknownTaggedFields.Fields = append(unknownTaggedFields.Fields, value.TaggedField{
+			Tag:  tag.Value,
+			Data: data.Value,
+		})
+	}
+
+	return unknownTaggedFields, nil
+}
+
+func (d *FieldDecoder) readKnownTaggedField(knownTaggedField KnownTaggedField, length uint64) FieldDecoderError {
+	dataStartOffset := d.ReadBytesCount()
+
+	if err := knownTaggedField.DecodeFunc(d); err != nil {
+		return err
+	}
+
+	if decodedLength := d.ReadBytesCount() - dataStartOffset; decodedLength != length {
+		return d.wrapError(decoder.NewDecoderErrorForRange(
+			fmt.Errorf("Expected tagged field %s to be %d bytes long, decoded %d bytes", knownTaggedField.Name, length, decodedLength),
+			int(dataStartOffset),
+			int(d.ReadBytesCount()-1),
+		))
+	}
+
+	return nil
+}
+
+// DecodeEmbeddedBytes decodes bytes that were derived from sourceField instead of being read from the buffer, like
+// the decompressed records of a record batch
+//
+// Fields decoded by decodeFunc show up in the field tree under the current path, they point at sourceField since
+// the embedded bytes have no offsets of their own. decodeFunc is expected to consume all the embedded bytes.
+func (d *FieldDecoder) DecodeEmbeddedBytes(embeddedBytes []byte, sourceField field.Field, decodeFunc func(decoder *FieldDecoder) FieldDecoderError) FieldDecoderError {
+	embeddedDecoder := &FieldDecoder{
+		currentPathContexts: slices.Clone(d.currentPathContexts),
+		decodedFields:       []field.Field{},
+		decoder:             decoder.NewDecoder(embeddedBytes),
+	}
+
+	err := decodeFunc(embeddedDecoder)
+
+	for _, decodedField := range embeddedDecoder.decodedFields {
+		decodedField.StartOffset = sourceField.StartOffset
+		decodedField.EndOffset = sourceField.EndOffset
+		d.decodedFields = append(d.decodedFields, decodedField)
+	}
+
+	if err != nil {
+		return &fieldDecoderErrorImpl{
+			message:     err.Error(),
+			startOffset: sourceField.StartOffset,
+			endOffset:   max(sourceField.EndOffset, sourceField.StartOffset),
+			path:        err.Path(),
+		}
+	}
+
+	if remainingBytesCount := embeddedDecoder.RemainingBytesCount(); remainingBytesCount != 0 {
+		return d.GetDecoderErrorForField(
+			fmt.Errorf("Expected all %d bytes of %s to be decoded, %d bytes remaining", len(embeddedBytes), sourceField.Path, remainingBytesCount),
+			sourceField,
+		)
+	}
+
+	return nil
+}
+
+// ConsumeTagBufferField skips over a tag buffer, none of the tagged fields show up in the field tree
 func (d *FieldDecoder) ConsumeTagBufferField() FieldDecoderError {
 	d.PushPathContext("TAG_BUFFER")
 	defer d.PopPathContext()
@@ -280,6 +537,11 @@ func (d *FieldDecoder) ConsumeTagBufferField() FieldDecoderError {
 	return nil
 }
 
+// PeekInt8 returns the INT8 that's offset bytes ahead without reading it, false if there aren't enough bytes remaining
+func (d *FieldDecoder) PeekInt8(offset uint64) (int8, bool) {
+	return d.decoder.PeekInt8(offset)
+}
+
 func (d *FieldDecoder) RemainingBytesCount() uint64 {
 	return d.decoder.RemainingBytesCount()
 }
@@ -330,8 +592,9 @@ func (d *FieldDecoder) GetDecoderErrorForField(err error, field field.Field) Fie
 		message: err.Error(),
 		// Use the startOffset, endOffset and path from the field
 		startOffset: field.StartOffset,
-		endOffset:   field.EndOffset,
-		path:        field.Path,
+		// Zero-length fields (like empty bytes) end before they start, point at their start offset instead
+		endOffset: max(field.EndOffset, field.StartOffset),
+		path:      field.Path,
 	}
 }
 
@@ -51,6 +51,13 @@ func (e *FieldEncoder) Bytes() []byte {
 	return e.encoder.Bytes()
 }
 
+func (e *FieldEncoder) WriteBooleanField(variableName string, value kafka_value.Boolean) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteBoolean(value.Value)
+	e.appendEncodedField(value)
+}
+
 func (e *FieldEncoder) WriteInt8Field(variableName string, value kafka_value.Int8) {
 	e.PushPathContext(variableName)
 	defer e.PopPathContext()
@@ -65,6 +72,13 @@ func (e *FieldEncoder) WriteInt16Field(variableName string, value kafka_value.In
 	e.appendEncodedField(value)
 }
 
+func (e *FieldEncoder) WriteUint16Field(variableName string, value kafka_value.Uint16) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteUint16(value.Value)
+	e.appendEncodedField(value)
+}
+
 func (e *FieldEncoder) WriteInt32Field(variableName string, value kafka_value.Int32) {
 	e.PushPathContext(variableName)
 	defer e.PopPathContext()
@@ -79,6 +93,13 @@ func (e *FieldEncoder) WriteInt64Field(variableName string, value kafka_value.In
 	e.appendEncodedField(value)
 }
 
+func (e *FieldEncoder) WriteFloat64Field(variableName string, value kafka_value.Float64) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteFloat64(value.Value)
+	e.appendEncodedField(value)
+}
+
 func (e *FieldEncoder) WriteStringField(variableName string, value kafka_value.String) {
 	e.PushPathContext(variableName)
 	defer e.PopPathContext()
@@ -86,6 +107,27 @@ func (e *FieldEncoder) WriteStringField(variableName string, value kafka_value.S
 	e.appendEncodedField(value)
 }
 
+func (e *FieldEncoder) WriteNullableStringField(variableName string, value kafka_value.NullableString) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteNullableString(value.Value)
+	e.appendEncodedField(value)
+}
+
+func (e *FieldEncoder) WriteBytesField(variableName string, value kafka_value.Bytes) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteBytes(value.Value)
+	e.appendEncodedField(value)
+}
+
+func (e *FieldEncoder) WriteNullableBytesField(variableName string, value kafka_value.NullableBytes) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteNullableBytes(value.Value)
+	e.appendEncodedField(value)
+}
+
 func (e *FieldEncoder) WriteRawBytes(variableName string, value kafka_value.RawBytes) {
 	e.PushPathContext(variableName)
 	defer e.PopPathContext()
@@ -121,6 +163,20 @@ func (e *FieldEncoder) WriteCompactStringField(variableName string, value kafka_
 	e.appendEncodedField(value)
 }
 
+func (e *FieldEncoder) WriteCompactBytesField(variableName string, value kafka_value.CompactBytes) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteCompactBytes(value.Value)
+	e.appendEncodedField(value)
+}
+
+func (e *FieldEncoder) WriteCompactNullableBytesField(variableName string, value kafka_value.CompactNullableBytes) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+	e.encoder.WriteCompactNullableBytes(value.Value)
+	e.appendEncodedField(value)
+}
+
 func (e *FieldEncoder) WriteCompactArrayLengthField(variableName string, value kafka_value.CompactArrayLength) {
 	e.PushPathContext(variableName)
 	defer e.PopPathContext()
@@ -133,13 +189,26 @@ func (e *FieldEncoder) WriteCompactArrayOfValuesField(variableName string, value
 	defer e.PopPathContext()
 
 	e.WriteCompactArrayLengthField("Length", kafka_value.NewCompactArrayLength(values))
+	e.writeArrayElements(variableName, values)
+}
+
+// WriteArrayOfValuesField is like WriteCompactArrayOfValuesField, for arrays with an INT32 length (non-flexible versions)
+func (e *FieldEncoder) WriteArrayOfValuesField(variableName string, values []kafka_value.KafkaProtocolValue) {
+	e.PushPathContext(variableName)
+	defer e.PopPathContext()
+
+	e.WriteInt32Field("Length", kafka_value.Int32{Value: int32(len(values))})
+	e.writeArrayElements(variableName, values)
+}
+
+func (e *FieldEncoder) writeArrayElements(variableName string, values []kafka_value.KafkaProtocolValue) {
 	for i, value := range values {
 		if castedInt32, ok := value.(kafka_value.Int32); ok {
 			e.WriteInt32Field(fmt.Sprintf("%s[%d]", variableName, i), castedInt32)
 			continue
 		}
 
-		panic(fmt.Sprintf("Codecrafters Internal Error - Compact Array of %s cannot be encoded", value.GetType()))
+		panic(fmt.Sprintf("Codecrafters Internal Error - Array of %s cannot be encoded", value.GetType()))
 	}
 }
 
@@ -156,3 +225,22 @@ func (e *FieldEncoder) WriteUUIDField(variableName string, value kafka_value.UUI
 func (e *FieldEncoder) WriteEmptyTagBuffer() {
 	e.encoder.WriteEmptyTagBuffer()
 }
+
+// WriteTaggedFieldsField writes a tag buffer containing the given tagged fields
+// Like WriteEmptyTagBuffer, an empty tag buffer is not shown in field encoder's output
+func (e *FieldEncoder) WriteTaggedFieldsField(value kafka_value.TaggedFields) {
+	e.PushPathContext("TAG_BUFFER")
+	defer e.PopPathContext()
+
+	e.encoder.WriteUvarint(uint64(len(value.Fields)))
+
+	for i, taggedField := range value.Fields {
+		if i > 0 && taggedField.Tag <= value.Fields[i-1].Tag {
+			panic(fmt.Sprintf("Codecrafters Internal Error - Tagged fields must be in increasing order of tags, got %d after %d", taggedField.Tag, value.Fields[i-1].Tag))
+		}
+
+		e.encoder.WriteUvarint(taggedField.Tag)
+		e.encoder.WriteUvarint(uint64(len(taggedField.Data)))
+		e.WriteRawBytes(fmt.Sprintf("Tag(%d)", taggedField.Tag), kafka_value.RawBytes{Value: taggedField.Data})
+	}
+}
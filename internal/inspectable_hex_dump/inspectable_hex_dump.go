@@ -19,6 +19,13 @@ func NewInspectableHexDump(bytes []byte) InspectableHexDump {
 	return InspectableHexDump{bytes: bytes}
 }
 
+// NewInspectableHexDumpAtOffset returns a hexdump of bytes that start at startOffset in a larger payload (like a log file that's too large to read fully)
+//
+// Offsets passed to FormatWithHighlightedRange are absolute, i.e. relative to the start of the larger payload.
+func NewInspectableHexDumpAtOffset(bytes []byte, startOffset int) InspectableHexDump {
+	return InspectableHexDump{bytes: bytes, truncationStartIndex: startOffset}
+}
+
 // FormatWithHighlightedRange returns a string that represents the hexdump with the byteOffset highlighted
 //
 // For example, if called with startOffset 4 and endOffset 6, the return value will be something like this:
@@ -145,11 +152,11 @@ func (s InspectableHexDump) FormattedStringWithHeading() string {
 
 func (s InspectableHexDump) TruncateAroundOffset(offset int) InspectableHexDump {
 	// We've got about 16 raw bytes to use in the terminal line.
-	start := max(0, offset-5)
-	end := max(0, min(len(s.bytes), start+16))
+	start := max(s.truncationStartIndex, offset-5)
+	end := max(start, min(s.truncationStartIndex+len(s.bytes), start+16))
 
 	return InspectableHexDump{
-		bytes:                s.bytes[start:end],
+		bytes:                s.bytes[start-s.truncationStartIndex : end-s.truncationStartIndex],
 		truncationStartIndex: start,
 	}
 }
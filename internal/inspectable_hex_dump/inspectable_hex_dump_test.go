@@ -98,3 +98,17 @@ Hex (bytes 0-11)                                | ASCII
 		})
 	}
 }
+
+func TestFormatWithHighlightedRangeAtOffset(t *testing.T) {
+	// The bytes "World!" start at offset 1006 of a larger payload
+	ibs := NewInspectableHexDumpAtOffset([]byte("World!"), 1006)
+	result := ibs.FormatWithHighlightedRange(1008, 1009)
+
+	expected := strings.TrimSpace(`
+Hex (bytes 1006-1011)                           | ASCII
+------------------------------------------------+------------------
+57 6f 72 6c 64 21                               | World!
+       ^--^                                         ^^
+	`)
+	assert.Equal(t, expected, result)
+}
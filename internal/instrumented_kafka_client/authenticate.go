@@ -0,0 +1,110 @@
+package instrumented_kafka_client
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/sasl"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+// Authenticate performs a SaslHandshake followed by as many SaslAuthenticate requests as the mechanism needs.
+// Every response is expected to have an error code of 0.
+func (c *InstrumentedKafkaClient) Authenticate(mechanism sasl.Mechanism, stageLogger *logger.Logger) error {
+	if err := c.SendSaslHandshake(mechanism.Name(), stageLogger); err != nil {
+		return err
+	}
+
+	authBytes, err := mechanism.InitialResponse()
+	if err != nil {
+		return err
+	}
+
+	for {
+		response, err := c.SendSaslAuthenticate(authBytes, 0, stageLogger)
+		if err != nil {
+			return err
+		}
+
+		nextAuthBytes, isComplete, err := mechanism.HandleChallenge(response.Body.AuthBytes.Value)
+		if err != nil {
+			return fmt.Errorf("Invalid %s authentication bytes in SaslAuthenticate response: %v", mechanism.Name(), err)
+		}
+
+		if isComplete {
+			break
+		}
+
+		authBytes = nextAuthBytes
+	}
+
+	stageLogger.Successf("✓ Authenticated using %s", mechanism.Name())
+	return nil
+}
+
+// SendSaslHandshake sends a SaslHandshake request and asserts that the mechanism is enabled
+func (c *InstrumentedKafkaClient) SendSaslHandshake(mechanismName string, stageLogger *logger.Logger) error {
+	correlationId := utils.GetRandomCorrelationId()
+	request := builder.NewSaslHandshakeRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithMechanism(mechanismName).
+		Build()
+
+	rawResponse, err := c.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewSaslHandshakeResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCode(0).
+		ExpectMechanism(mechanismName)
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.SaslHandshakeResponse]{
+		DecodeFunc: response_decoders.DecodeSaslHandshakeResponse,
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
+
+// SendSaslAuthenticate sends a SaslAuthenticate request and asserts that the response has the expected error code
+func (c *InstrumentedKafkaClient) SendSaslAuthenticate(authBytes []byte, expectedErrorCode int16, stageLogger *logger.Logger) (kafkaapi.SaslAuthenticateResponse, error) {
+	correlationId := utils.GetRandomCorrelationId()
+	request := builder.NewSaslAuthenticateRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithAuthBytes(authBytes).
+		Build()
+
+	rawResponse, err := c.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return kafkaapi.SaslAuthenticateResponse{}, err
+	}
+
+	assertion := response_assertions.NewSaslAuthenticateResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCode(expectedErrorCode)
+
+	return response_asserter.ResponseAsserter[kafkaapi.SaslAuthenticateResponse]{
+		DecodeFunc: response_decoders.DecodeSaslAuthenticateResponse,
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+}
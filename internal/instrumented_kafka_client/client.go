@@ -1,7 +1,12 @@
 package instrumented_kafka_client
 
 import (
+	"net"
+	"strconv"
+
+	"github.com/codecrafters-io/kafka-tester/internal/pcapng"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafka_client"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
 	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/tester-utils/logger"
 )
@@ -10,15 +15,45 @@ type InstrumentedKafkaClient struct {
 	*kafka_client.Client
 
 	logger *logger.Logger
+
+	clientId string
+
+	// captureStream is only set if pcapng capturing is enabled, and the client is connected
+	captureStream *pcapng.TCPStream
+
+	latencyRecorder *latencyRecorder
 }
 
-func NewFromAddr(addr string, baseLogger *logger.Logger, clientId string) *InstrumentedKafkaClient {
+// NewFromAddr creates a client for the listener at addr, this is usually an address returned by FilesHandler
+func NewFromAddr(addr kafka_files_generator.ListenerAddress, baseLogger *logger.Logger, clientId string) *InstrumentedKafkaClient {
 	logger := baseLogger.Clone()
 	logger.PushSecondaryPrefix(clientId)
-	return &InstrumentedKafkaClient{
-		Client: kafka_client.NewFromAddr(addr, defaultCallbacks(logger)),
-		logger: logger,
+
+	client := &InstrumentedKafkaClient{
+		logger:          logger,
+		clientId:        clientId,
+		latencyRecorder: newLatencyRecorder(),
+	}
+
+	callbacks := client.withLatencyCallbacks(defaultCallbacks(logger))
+	if pcapng.IsCaptureEnabled() {
+		callbacks = client.withCaptureCallbacks(callbacks)
+	}
+
+	client.Client = kafka_client.NewFromAddr(addr.String(), callbacks)
+	return client
+}
+
+// Close closes the connection, and logs a summary of response latencies for every API used by this client
+func (c *InstrumentedKafkaClient) Close() error {
+	c.latencyRecorder.logSummary(c.logger)
+
+	if c.captureStream != nil {
+		c.logCaptureError(c.captureStream.Close())
+		c.captureStream = nil
 	}
+
+	return c.Client.Close()
 }
 
 func defaultCallbacks(logger *logger.Logger) kafka_client.KafkaClientCallbacks {
@@ -41,3 +76,73 @@ func defaultCallbacks(logger *logger.Logger) kafka_client.KafkaClientCallbacks {
 		},
 	}
 }
+
+// withLatencyCallbacks wraps callbacks so that the timing of every response is recorded
+func (c *InstrumentedKafkaClient) withLatencyCallbacks(callbacks kafka_client.KafkaClientCallbacks) kafka_client.KafkaClientCallbacks {
+	afterResponseReceived := callbacks.AfterResponseReceived
+
+	callbacks.AfterResponseReceived = func(response kafka_client.Response, apiName string) {
+		afterResponseReceived(response, apiName)
+		c.latencyRecorder.record(apiName, response.Timing)
+	}
+
+	return callbacks
+}
+
+// withCaptureCallbacks wraps callbacks so that all bytes sent and received are also written to the pcapng capture
+func (c *InstrumentedKafkaClient) withCaptureCallbacks(callbacks kafka_client.KafkaClientCallbacks) kafka_client.KafkaClientCallbacks {
+	afterConnected := callbacks.AfterConnected
+	beforeMessageSend := callbacks.BeforeMessageSend
+	afterResponseReceived := callbacks.AfterResponseReceived
+
+	callbacks.AfterConnected = func(addr string) {
+		afterConnected(addr)
+
+		stream, err := pcapng.NewCaptureStream(c.clientId, getPort(addr))
+		if err != nil {
+			c.logCaptureError(err)
+			return
+		}
+
+		c.captureStream = stream
+		c.logCaptureError(c.captureStream.Open())
+	}
+
+	callbacks.BeforeMessageSend = func(message []byte, apiName string) {
+		beforeMessageSend(message, apiName)
+
+		if c.captureStream != nil {
+			c.logCaptureError(c.captureStream.ClientSent(message))
+		}
+	}
+
+	callbacks.AfterResponseReceived = func(response kafka_client.Response, apiName string) {
+		afterResponseReceived(response, apiName)
+
+		if c.captureStream != nil {
+			c.logCaptureError(c.captureStream.ServerSent(response.RawBytes))
+		}
+	}
+
+	return callbacks
+}
+
+func (c *InstrumentedKafkaClient) logCaptureError(err error) {
+	if err != nil {
+		c.logger.Debugf("Failed to write pcapng capture: %v", err)
+	}
+}
+
+func getPort(addr string) uint16 {
+	_, portString, err := net.SplitHostPort(addr)
+	if err != nil {
+		return 0
+	}
+
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return 0
+	}
+
+	return uint16(port)
+}
@@ -0,0 +1,101 @@
+package instrumented_kafka_client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_client"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+// LatencyBounds are the limits on how long a response may take to arrive, a zero value means there's no limit
+type LatencyBounds struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// latencyRecorder collects the timing of every response received by a client, grouped by API
+type latencyRecorder struct {
+	// apiNames is used to print the summary in the order APIs were first used
+	apiNames []string
+
+	timingsByApiName map[string][]kafka_client.RequestTiming
+}
+
+func newLatencyRecorder() *latencyRecorder {
+	return &latencyRecorder{
+		timingsByApiName: map[string][]kafka_client.RequestTiming{},
+	}
+}
+
+func (r *latencyRecorder) record(apiName string, timing kafka_client.RequestTiming) {
+	if timing.SentAt.IsZero() {
+		return
+	}
+
+	if _, ok := r.timingsByApiName[apiName]; !ok {
+		r.apiNames = append(r.apiNames, apiName)
+	}
+
+	r.timingsByApiName[apiName] = append(r.timingsByApiName[apiName], timing)
+}
+
+func (r *latencyRecorder) logSummary(logger *logger.Logger) {
+	for _, apiName := range r.apiNames {
+		timings := r.timingsByApiName[apiName]
+
+		logger.Debugf(
+			"Latency for \"%s\" requests (%d): time to first byte %s, time to last byte %s",
+			apiName,
+			len(timings),
+			summarizeDurations(timings, kafka_client.RequestTiming.TimeToFirstByte),
+			summarizeDurations(timings, kafka_client.RequestTiming.TimeToLastByte),
+		)
+	}
+}
+
+func summarizeDurations(timings []kafka_client.RequestTiming, durationFunc func(kafka_client.RequestTiming) time.Duration) string {
+	var minDuration, maxDuration, totalDuration time.Duration
+
+	for i, timing := range timings {
+		duration := durationFunc(timing)
+
+		if i == 0 || duration < minDuration {
+			minDuration = duration
+		}
+
+		maxDuration = max(maxDuration, duration)
+		totalDuration += duration
+	}
+
+	averageDuration := totalDuration / time.Duration(len(timings))
+
+	return fmt.Sprintf("min=%s avg=%s max=%s", formatDuration(minDuration), formatDuration(averageDuration), formatDuration(maxDuration))
+}
+
+func formatDuration(duration time.Duration) string {
+	return duration.Round(time.Microsecond).String()
+}
+
+// AssertResponseLatency checks that the entire response arrived within bounds, measured from when the request was sent
+func AssertResponseLatency(response kafka_client.Response, apiName string, bounds LatencyBounds, logger *logger.Logger) error {
+	latency := response.Timing.TimeToLastByte()
+
+	if bounds.Min > 0 && latency < bounds.Min {
+		return fmt.Errorf("Expected %s response to take at least %s, received it after %s", apiName, bounds.Min, formatDuration(latency))
+	}
+
+	if bounds.Max > 0 && latency > bounds.Max {
+		return fmt.Errorf("Expected %s response to be received within %s, received it after %s", apiName, bounds.Max, formatDuration(latency))
+	}
+
+	if bounds.Min > 0 {
+		logger.Successf("✓ %s response took at least %s", apiName, bounds.Min)
+	}
+
+	if bounds.Max > 0 {
+		logger.Successf("✓ %s response was received within %s", apiName, bounds.Max)
+	}
+
+	return nil
+}
@@ -3,11 +3,12 @@ package instrumented_kafka_client
 import (
 	"fmt"
 
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
 	"github.com/codecrafters-io/tester-utils/logger"
 )
 
 // SpawnMultipleClients creates multiple clients and connects them all to the broker
-func SpawnMultipleClients(count int, addr string, logger *logger.Logger) []*InstrumentedKafkaClient {
+func SpawnMultipleClients(count int, addr kafka_files_generator.ListenerAddress, logger *logger.Logger) []*InstrumentedKafkaClient {
 	clients := make([]*InstrumentedKafkaClient, count)
 
 	for i := range count {
@@ -0,0 +1,484 @@
+package kafka_codegen
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+var primitiveValueTypes = map[string]string{
+	"bool":    "value.Boolean",
+	"int8":    "value.Int8",
+	"int16":   "value.Int16",
+	"uint16":  "value.Uint16",
+	"int32":   "value.Int32",
+	"int64":   "value.Int64",
+	"float64": "value.Float64",
+	"uuid":    "value.UUID",
+	"string":  "value.String",
+	"bytes":   "value.Bytes",
+}
+
+// nullableValueTypes are used instead of primitiveValueTypes for fields with nullableVersions
+var nullableValueTypes = map[string]string{
+	"string": "value.NullableString",
+	"bytes":  "value.NullableBytes",
+}
+
+var primitiveReadFuncs = map[string]string{
+	"bool":    "readBoolean",
+	"int8":    "readInt8",
+	"int16":   "readInt16",
+	"uint16":  "readUint16",
+	"int32":   "readInt32",
+	"int64":   "readInt64",
+	"float64": "readFloat64",
+	"uuid":    "readUUID",
+}
+
+var primitiveWriteMethods = map[string]string{
+	"bool":    "WriteBooleanField",
+	"int8":    "WriteInt8Field",
+	"int16":   "WriteInt16Field",
+	"uint16":  "WriteUint16Field",
+	"int32":   "WriteInt32Field",
+	"int64":   "WriteInt64Field",
+	"float64": "WriteFloat64Field",
+	"uuid":    "WriteUUIDField",
+}
+
+// variableLengthTypes are encoded differently in flexible versions, the helpers for these are passed isFlexible
+var variableLengthTypes = map[string]string{
+	"string": "String",
+	"bytes":  "Bytes",
+}
+
+type structDef struct {
+	goName string
+	fields []*fieldDef
+}
+
+type fieldDef struct {
+	name  string
+	about string
+
+	// kafkaType is the type of the field, or the type of its elements if isArray is set
+	kafkaType string
+	isArray   bool
+	structDef *structDef
+
+	versions         versionRange
+	nullableVersions versionRange
+	taggedVersions   versionRange
+	tag              int
+}
+
+func (f *fieldDef) isTagged() bool {
+	return !f.taggedVersions.isEmpty()
+}
+
+// generator emits the Go code for a single message spec
+type generator struct {
+	spec             MessageSpec
+	validVersions    versionRange
+	flexibleVersions versionRange
+
+	// structs are emitted in the order they were first referenced, starting with the body
+	structs       []*structDef
+	structsByName map[string]*structDef
+	commonStructs map[string]StructSpec
+}
+
+// GenerateMessage returns the formatted Go source for a message spec
+func GenerateMessage(spec MessageSpec, packageName string, specFileName string) ([]byte, error) {
+	validVersions, err := parseVersionRange(spec.ValidVersions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", spec.Name, err)
+	}
+
+	flexibleVersions, err := parseVersionRange(spec.FlexibleVersions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", spec.Name, err)
+	}
+
+	g := &generator{
+		spec:             spec,
+		validVersions:    validVersions,
+		flexibleVersions: flexibleVersions.intersect(validVersions),
+		structsByName:    map[string]*structDef{},
+		commonStructs:    map[string]StructSpec{},
+	}
+
+	for _, commonStruct := range spec.CommonStructs {
+		g.commonStructs[commonStruct.Name] = commonStruct
+	}
+
+	if _, err := g.resolveStruct(spec.Name+"Body", spec.Fields, validVersions); err != nil {
+		return nil, fmt.Errorf("%s: %v", spec.Name, err)
+	}
+
+	code := &strings.Builder{}
+	fmt.Fprintf(code, "// Code generated by kafka_codegen from %s. DO NOT EDIT.\n\n", specFileName)
+	fmt.Fprintf(code, "package %s\n\n", packageName)
+	fmt.Fprintf(code, "import (\n\"github.com/codecrafters-io/kafka-tester/internal/field_decoder\"\n\"github.com/codecrafters-io/kafka-tester/internal/field_encoder\"\n\"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers\"\n\"github.com/codecrafters-io/kafka-tester/protocol/value\"\n)\n\n")
+
+	g.writeMessage(code)
+
+	for _, s := range g.structs {
+		g.writeStructType(code, s)
+		g.writeEncodeFunc(code, s)
+		g.writeDecodeFunc(code, s)
+	}
+
+	formatted, err := format.Source([]byte(code.String()))
+	if err != nil {
+		return nil, fmt.Errorf("%s: generated code doesn't compile: %v", spec.Name, err)
+	}
+
+	return formatted, nil
+}
+
+func (g *generator) resolveStruct(goName string, fieldSpecs []FieldSpec, structVersions versionRange) (*structDef, error) {
+	// Common structs can be referenced by multiple fields, but are only emitted once
+	if s, ok := g.structsByName[goName]; ok {
+		return s, nil
+	}
+
+	s := &structDef{goName: goName}
+	g.structs = append(g.structs, s)
+	g.structsByName[goName] = s
+
+	for _, fieldSpec := range fieldSpecs {
+		field, err := g.resolveField(fieldSpec, structVersions)
+		if err != nil {
+			return nil, err
+		}
+
+		if field != nil {
+			s.fields = append(s.fields, field)
+		}
+	}
+
+	return s, nil
+}
+
+// structGoName prefixes nested struct names with the message name, unless the spec already does (e.g. MetadataRequestTopic)
+func (g *generator) structGoName(kafkaType string) string {
+	if strings.HasPrefix(kafkaType, g.spec.Name) {
+		return kafkaType
+	}
+
+	return g.spec.Name + kafkaType
+}
+
+// resolveField returns nil for fields that aren't present in any valid version
+func (g *generator) resolveField(fieldSpec FieldSpec, structVersions versionRange) (*fieldDef, error) {
+	if fieldSpec.Name == "" {
+		return nil, fmt.Errorf("fields must have a name")
+	}
+
+	versions, err := parseVersionRange(fieldSpec.Versions)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %v", fieldSpec.Name, err)
+	}
+
+	versions = versions.intersect(structVersions)
+	if versions.isEmpty() {
+		return nil, nil
+	}
+
+	nullableVersions, err := parseVersionRange(fieldSpec.NullableVersions)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %v", fieldSpec.Name, err)
+	}
+
+	taggedVersions, err := parseVersionRange(fieldSpec.TaggedVersions)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %v", fieldSpec.Name, err)
+	}
+
+	field := &fieldDef{
+		// Some specs use lowerCamelCase names (like CreateTopicsRequest's timeoutMs), Go fields need to be exported
+		name:             strings.ToUpper(fieldSpec.Name[:1]) + fieldSpec.Name[1:],
+		about:            fieldSpec.About,
+		kafkaType:        strings.TrimPrefix(fieldSpec.Type, "[]"),
+		isArray:          strings.HasPrefix(fieldSpec.Type, "[]"),
+		versions:         versions,
+		nullableVersions: nullableVersions.intersect(versions),
+		taggedVersions:   taggedVersions.intersect(versions),
+	}
+
+	if field.isTagged() {
+		if fieldSpec.Tag == nil {
+			return nil, fmt.Errorf("field %s: tagged fields must have a tag", field.name)
+		}
+
+		if !g.flexibleVersions.contains(field.taggedVersions) {
+			return nil, fmt.Errorf("field %s: tagged versions %s must be flexible", field.name, field.taggedVersions)
+		}
+
+		field.tag = *fieldSpec.Tag
+	}
+
+	if _, ok := primitiveValueTypes[field.kafkaType]; !ok {
+		structFields := fieldSpec.Fields
+		if len(structFields) == 0 {
+			commonStruct, ok := g.commonStructs[field.kafkaType]
+			if !ok {
+				return nil, fmt.Errorf("field %s: type %s is not supported", field.name, fieldSpec.Type)
+			}
+
+			structFields = commonStruct.Fields
+		}
+
+		if !field.isArray && !field.nullableVersions.isEmpty() {
+			return nil, fmt.Errorf("field %s: nullable structs are not supported", field.name)
+		}
+
+		structDef, err := g.resolveStruct(g.structGoName(field.kafkaType), structFields, versions)
+		if err != nil {
+			return nil, err
+		}
+
+		field.structDef = structDef
+		return field, nil
+	}
+
+	_, isNullableType := nullableValueTypes[field.kafkaType]
+	if !field.isArray && !isNullableType && !field.nullableVersions.isEmpty() {
+		return nil, fmt.Errorf("field %s: %s can't be nullable", field.name, field.kafkaType)
+	}
+
+	return field, nil
+}
+
+func (g *generator) writeMessage(code *strings.Builder) {
+	name := g.spec.Name
+	apiKey := -1
+	if g.spec.ApiKey != nil {
+		apiKey = *g.spec.ApiKey
+	}
+
+	fmt.Fprintf(code, "// %s is generated from Kafka's message spec (API key %d, valid versions %s, flexible versions %s)\n", name, apiKey, g.validVersions, g.flexibleVersions)
+
+	if g.spec.Type == "request" {
+		fmt.Fprintf(code, "type %s struct {\nHeader headers.RequestHeader\nBody %sBody\n}\n\n", name, name)
+		fmt.Fprintf(code, "// GetHeader implements the RequestI interface\nfunc (r %s) GetHeader() headers.RequestHeader {\nreturn r.Header\n}\n\n", name)
+		fmt.Fprintf(code, "// EncodeBody encodes the body using the version in the request header\nfunc (r %s) EncodeBody(encoder *field_encoder.FieldEncoder) {\nencoder.PushPathContext(\"Body\")\ndefer encoder.PopPathContext()\nencode%sBody(r.Body, r.Header.ApiVersion.Value, encoder)\n}\n\n", name, name)
+		fmt.Fprintf(code, "// Decode%sBody decodes the body of a request with the given version\nfunc Decode%sBody(decoder *field_decoder.FieldDecoder, version int16) (%sBody, field_decoder.FieldDecoderError) {\ndecoder.PushPathContext(\"Body\")\ndefer decoder.PopPathContext()\nreturn decode%sBody(decoder, version)\n}\n\n", name, name, name, name)
+		return
+	}
+
+	// ApiVersions responses always use the v0 response header, so that clients can parse them before knowing which versions are supported
+	flexibleHeaderCondition := g.flexibleVersions.condition(g.validVersions)
+	if apiKey == 18 {
+		flexibleHeaderCondition = "false"
+	}
+
+	fmt.Fprintf(code, "type %s struct {\nHeader headers.ResponseHeader\nBody %sBody\n}\n\n", name, name)
+	fmt.Fprintf(code, "// EncodeBody encodes the body using the given version\nfunc (r %s) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {\nencoder.PushPathContext(\"Body\")\ndefer encoder.PopPathContext()\nencode%sBody(r.Body, version, encoder)\n}\n\n", name, name)
+	fmt.Fprintf(code, "// %sDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter\n", name)
+	fmt.Fprintf(code, "func %sDecoder(version int16) func(*field_decoder.FieldDecoder) (%s, field_decoder.FieldDecoderError) {\n", name, name)
+	fmt.Fprintf(code, "return func(decoder *field_decoder.FieldDecoder) (%s, field_decoder.FieldDecoderError) {\n", name)
+	fmt.Fprintf(code, "decoder.PushPathContext(%q)\ndefer decoder.PopPathContext()\n\n", name)
+	fmt.Fprintf(code, "header, err := decodeResponseHeader(decoder, %s)\nif err != nil {\nreturn %s{}, err\n}\n\n", flexibleHeaderCondition, name)
+	fmt.Fprintf(code, "decoder.PushPathContext(\"Body\")\ndefer decoder.PopPathContext()\n\n")
+	fmt.Fprintf(code, "body, err := decode%sBody(decoder, version)\nif err != nil {\nreturn %s{}, err\n}\n\n", name, name)
+	fmt.Fprintf(code, "return %s{\nHeader: header,\nBody: body,\n}, nil\n}\n}\n\n", name)
+}
+
+func (g *generator) writeStructType(code *strings.Builder, s *structDef) {
+	fmt.Fprintf(code, "type %s struct {\n", s.goName)
+
+	for _, field := range s.fields {
+		if field.about != "" {
+			fmt.Fprintf(code, "// %s\n", strings.TrimSpace(field.about))
+		}
+
+		fmt.Fprintf(code, "%s %s\n", field.name, g.goType(field))
+	}
+
+	if !g.flexibleVersions.isEmpty() {
+		fmt.Fprintf(code, "// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes\nUnknownTaggedFields value.TaggedFields\n")
+	}
+
+	fmt.Fprintf(code, "}\n\n")
+}
+
+func (g *generator) goType(field *fieldDef) string {
+	elementType := primitiveValueTypes[field.kafkaType]
+
+	switch {
+	case field.structDef != nil:
+		elementType = field.structDef.goName
+	case g.isNullableValue(field):
+		elementType = nullableValueTypes[field.kafkaType]
+	}
+
+	if field.isArray {
+		return "[]" + elementType
+	}
+
+	return elementType
+}
+
+// isNullableValue returns true for strings and bytes that can be null, nullableVersions of an array applies to the array itself
+func (g *generator) isNullableValue(field *fieldDef) bool {
+	return !field.isArray && !field.nullableVersions.isEmpty()
+}
+
+func (g *generator) writeEncodeFunc(code *strings.Builder, s *structDef) {
+	body := &strings.Builder{}
+	taggedFields := []*fieldDef{}
+
+	for _, field := range s.fields {
+		if field.isTagged() {
+			taggedFields = append(taggedFields, field)
+			continue
+		}
+
+		g.writeConditional(body, field.versions, g.encodeStatement(field, "message."+field.name))
+	}
+
+	if !g.flexibleVersions.isEmpty() {
+		tagBuffer := &strings.Builder{}
+		fmt.Fprintf(tagBuffer, "taggedFields := []value.TaggedField{}\n")
+
+		sort.Slice(taggedFields, func(i, j int) bool { return taggedFields[i].tag < taggedFields[j].tag })
+		for _, field := range taggedFields {
+			appendStatement := fmt.Sprintf("taggedFields = append(taggedFields, encodeTaggedField(%d, func(encoder *field_encoder.FieldEncoder) {\n%s}))\n", field.tag, g.encodeStatement(field, "message."+field.name))
+			g.writeConditionalWithin(tagBuffer, field.taggedVersions, g.flexibleVersions.intersect(g.validVersions), appendStatement)
+		}
+
+		fmt.Fprintf(tagBuffer, "writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)\n")
+		g.writeConditional(body, g.flexibleVersions, tagBuffer.String())
+	}
+
+	fmt.Fprintf(code, "func encode%s(message %s, version int16, encoder *field_encoder.FieldEncoder) {\n", s.goName, s.goName)
+	g.writeFunctionBody(code, body.String())
+	fmt.Fprintf(code, "}\n\n")
+}
+
+func (g *generator) encodeStatement(field *fieldDef, accessor string) string {
+	if field.isArray {
+		return fmt.Sprintf(
+			"encodeArray(encoder, %q, %s, isFlexible, %s, func(encoder *field_encoder.FieldEncoder, path string, element %s) {\n%s})\n",
+			field.name, accessor, field.nullableVersions.condition(g.validVersions), strings.TrimPrefix(g.goType(field), "[]"), g.encodeValueStatement(field, "path", "element"),
+		)
+	}
+
+	return g.encodeValueStatement(field, fmt.Sprintf("%q", field.name), accessor)
+}
+
+// encodeValueStatement encodes a single value (or a single element, for arrays)
+func (g *generator) encodeValueStatement(field *fieldDef, pathExpression string, accessor string) string {
+	switch {
+	case field.structDef != nil:
+		return fmt.Sprintf("encoder.PushPathContext(%s)\nencode%s(%s, version, encoder)\nencoder.PopPathContext()\n", pathExpression, field.structDef.goName, accessor)
+	case g.isNullableValue(field):
+		return fmt.Sprintf("writeNullable%s(encoder, %s, %s, isFlexible)\n", variableLengthTypes[field.kafkaType], pathExpression, accessor)
+	case variableLengthTypes[field.kafkaType] != "":
+		return fmt.Sprintf("write%s(encoder, %s, %s, isFlexible)\n", variableLengthTypes[field.kafkaType], pathExpression, accessor)
+	default:
+		return fmt.Sprintf("encoder.%s(%s, %s)\n", primitiveWriteMethods[field.kafkaType], pathExpression, accessor)
+	}
+}
+
+func (g *generator) writeDecodeFunc(code *strings.Builder, s *structDef) {
+	body := &strings.Builder{}
+	fmt.Fprintf(body, "message := %s{}\n\n", s.goName)
+
+	taggedFields := []*fieldDef{}
+
+	for _, field := range s.fields {
+		if field.isTagged() {
+			taggedFields = append(taggedFields, field)
+			continue
+		}
+
+		statement := fmt.Sprintf("fieldValue, err := %s\nif err != nil {\nreturn %s{}, err\n}\n\nmessage.%s = fieldValue\n", g.decodeExpression(field), s.goName, field.name)
+		g.writeConditional(body, field.versions, statement)
+	}
+
+	if !g.flexibleVersions.isEmpty() {
+		tagBuffer := &strings.Builder{}
+
+		knownTaggedFields := "nil"
+		if len(taggedFields) > 0 {
+			sort.Slice(taggedFields, func(i, j int) bool { return taggedFields[i].tag < taggedFields[j].tag })
+
+			knownTaggedFieldsBuilder := &strings.Builder{}
+			fmt.Fprintf(knownTaggedFieldsBuilder, "map[uint64]field_decoder.KnownTaggedField{\n")
+			for _, field := range taggedFields {
+				fmt.Fprintf(knownTaggedFieldsBuilder, "%d: {Name: %q, DecodeFunc: func(decoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {\n", field.tag, field.name)
+				fmt.Fprintf(knownTaggedFieldsBuilder, "fieldValue, err := %s\nif err != nil {\nreturn err\n}\n\nmessage.%s = fieldValue\nreturn nil\n}},\n", g.decodeExpression(field), field.name)
+			}
+			fmt.Fprintf(knownTaggedFieldsBuilder, "}")
+			knownTaggedFields = knownTaggedFieldsBuilder.String()
+		}
+
+		fmt.Fprintf(tagBuffer, "unknownTaggedFields, err := decoder.ReadTaggedFieldsField(%s)\nif err != nil {\nreturn %s{}, err\n}\n\nmessage.UnknownTaggedFields = unknownTaggedFields\n", knownTaggedFields, s.goName)
+		g.writeConditional(body, g.flexibleVersions, tagBuffer.String())
+	}
+
+	fmt.Fprintf(body, "\nreturn message, nil\n")
+
+	fmt.Fprintf(code, "func decode%s(decoder *field_decoder.FieldDecoder, version int16) (%s, field_decoder.FieldDecoderError) {\n", s.goName, s.goName)
+	g.writeFunctionBody(code, body.String())
+	fmt.Fprintf(code, "}\n\n")
+}
+
+func (g *generator) decodeExpression(field *fieldDef) string {
+	if field.isArray {
+		elementType := strings.TrimPrefix(g.goType(field), "[]")
+
+		return fmt.Sprintf(
+			"decodeArray(decoder, %q, isFlexible, %s, func(decoder *field_decoder.FieldDecoder, path string) (%s, field_decoder.FieldDecoderError) {\nreturn %s\n})",
+			field.name, field.nullableVersions.condition(g.validVersions), elementType, g.decodeValueExpression(field, "path"),
+		)
+	}
+
+	return g.decodeValueExpression(field, fmt.Sprintf("%q", field.name))
+}
+
+// decodeValueExpression decodes a single value (or a single element, for arrays)
+func (g *generator) decodeValueExpression(field *fieldDef, pathExpression string) string {
+	switch {
+	case field.structDef != nil:
+		return fmt.Sprintf("decodeInPathContext(decoder, %s, func(decoder *field_decoder.FieldDecoder) (%s, field_decoder.FieldDecoderError) {\nreturn decode%s(decoder, version)\n})", pathExpression, field.structDef.goName, field.structDef.goName)
+	case g.isNullableValue(field):
+		return fmt.Sprintf("readNullable%s(decoder, %s, isFlexible)", variableLengthTypes[field.kafkaType], pathExpression)
+	case variableLengthTypes[field.kafkaType] != "":
+		return fmt.Sprintf("read%s(decoder, %s, isFlexible)", variableLengthTypes[field.kafkaType], pathExpression)
+	default:
+		return fmt.Sprintf("%s(decoder, %s)", primitiveReadFuncs[field.kafkaType], pathExpression)
+	}
+}
+
+// writeConditional wraps statements in a version check, unless they apply to all valid versions
+func (g *generator) writeConditional(code *strings.Builder, versions versionRange, statements string) {
+	g.writeConditionalWithin(code, versions, g.validVersions, statements)
+}
+
+// writeConditionalWithin is like writeConditional, for statements that are already inside a check for enclosingVersions
+func (g *generator) writeConditionalWithin(code *strings.Builder, versions versionRange, enclosingVersions versionRange, statements string) {
+	condition := versions.condition(enclosingVersions)
+
+	switch condition {
+	case "true":
+		fmt.Fprintf(code, "{\n%s}\n\n", statements)
+	case "false":
+	default:
+		fmt.Fprintf(code, "if %s {\n%s}\n\n", condition, statements)
+	}
+}
+
+// writeFunctionBody declares the variables that generated statements rely on, and then writes the statements
+func (g *generator) writeFunctionBody(code *strings.Builder, body string) {
+	if strings.Contains(body, "isFlexible") {
+		fmt.Fprintf(code, "isFlexible := %s\n\n", g.flexibleVersions.condition(g.validVersions))
+	}
+
+	code.WriteString(strings.TrimRight(body, "\n") + "\n")
+}
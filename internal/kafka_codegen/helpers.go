@@ -0,0 +1,335 @@
+package kafka_codegen
+
+import (
+	"fmt"
+	"go/format"
+)
+
+// helpersSource is emitted alongside generated messages, these are the functions that generated code calls
+const helpersSource = `// Code generated by kafka_codegen. DO NOT EDIT.
+
+package %s
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func encodeArray[T any](encoder *field_encoder.FieldEncoder, path string, array []T, isFlexible bool, isNullable bool, encodeElement func(*field_encoder.FieldEncoder, string, T)) {
+	encoder.PushPathContext(path)
+	defer encoder.PopPathContext()
+
+	switch {
+	case isFlexible && isNullable:
+		encoder.WriteCompactArrayLengthField("Length", value.NewCompactArrayLength(array))
+	case isFlexible:
+		encoder.WriteCompactArrayLengthField("Length", value.CompactArrayLength{Value: uint64(len(array) + 1)})
+	case isNullable && array == nil:
+		encoder.WriteInt32Field("Length", value.Int32{Value: -1})
+	default:
+		encoder.WriteInt32Field("Length", value.Int32{Value: int32(len(array))})
+	}
+
+	for i, element := range array {
+		encodeElement(encoder, fmt.Sprintf("%%s[%%d]", path, i), element)
+	}
+}
+
+func decodeArray[T any](decoder *field_decoder.FieldDecoder, path string, isFlexible bool, isNullable bool, decodeElement func(*field_decoder.FieldDecoder, string) (T, field_decoder.FieldDecoderError)) ([]T, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext(path)
+	defer decoder.PopPathContext()
+
+	var length int
+	var lengthField field.Field
+	var err field_decoder.FieldDecoderError
+
+	if isFlexible {
+		lengthField, err = decoder.ReadCompactArrayLengthField("Length")
+		if err != nil {
+			return nil, err
+		}
+
+		compactLength := value.MustBeCompactArrayLength(lengthField.Value)
+		if compactLength.Value == 0 {
+			if !isNullable {
+				return nil, decoder.GetDecoderErrorForField(fmt.Errorf("Expected %%s to be a non-null array", path), lengthField)
+			}
+
+			return nil, nil
+		}
+
+		length = int(compactLength.ActualLength())
+	} else {
+		lengthField, err = decoder.ReadInt32Field("Length")
+		if err != nil {
+			return nil, err
+		}
+
+		int32Length := value.MustBeInt32(lengthField.Value).Value
+		if int32Length == -1 && isNullable {
+			return nil, nil
+		}
+
+		if int32Length < 0 {
+			return nil, decoder.GetDecoderErrorForField(fmt.Errorf("Expected length of %%s to be non-negative, got %%d", path, int32Length), lengthField)
+		}
+
+		length = int(int32Length)
+	}
+
+	// Every element takes up at least one byte, this stops a corrupt length from allocating a huge array
+	if uint64(length) > decoder.RemainingBytesCount() {
+		return nil, decoder.GetDecoderErrorForField(fmt.Errorf("Expected %%s to have %%d elements, only %%d bytes remaining", path, length, decoder.RemainingBytesCount()), lengthField)
+	}
+
+	elements := make([]T, 0, length)
+
+	for i := range length {
+		element, err := decodeElement(decoder, fmt.Sprintf("%%s[%%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
+
+func decodeInPathContext[T any](decoder *field_decoder.FieldDecoder, path string, decodeFunc func(*field_decoder.FieldDecoder) (T, field_decoder.FieldDecoderError)) (T, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext(path)
+	defer decoder.PopPathContext()
+
+	return decodeFunc(decoder)
+}
+
+func encodeTaggedField(tag uint64, encodeFunc func(*field_encoder.FieldEncoder)) value.TaggedField {
+	encoder := field_encoder.NewFieldEncoder()
+	encodeFunc(encoder)
+
+	return value.TaggedField{
+		Tag:  tag,
+		Data: encoder.Bytes(),
+	}
+}
+
+func writeTaggedFields(encoder *field_encoder.FieldEncoder, knownTaggedFields []value.TaggedField, unknownTaggedFields value.TaggedFields) {
+	allTaggedFields := append(knownTaggedFields, unknownTaggedFields.Fields...)
+	sort.Slice(allTaggedFields, func(i, j int) bool { return allTaggedFields[i].Tag < allTaggedFields[j].Tag })
+
+	encoder.WriteTaggedFieldsField(value.TaggedFields{Fields: allTaggedFields})
+}
+
+func writeString(encoder *field_encoder.FieldEncoder, path string, stringValue value.String, isFlexible bool) {
+	if isFlexible {
+		encoder.WriteCompactStringField(path, value.CompactString{Value: stringValue.Value})
+	} else {
+		encoder.WriteStringField(path, stringValue)
+	}
+}
+
+func writeNullableString(encoder *field_encoder.FieldEncoder, path string, stringValue value.NullableString, isFlexible bool) {
+	if isFlexible {
+		encoder.WriteCompactNullableStringField(path, value.CompactNullableString{Value: stringValue.Value})
+	} else {
+		encoder.WriteNullableStringField(path, stringValue)
+	}
+}
+
+func readString(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (value.String, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		decodedField, err := decoder.ReadCompactStringField(path)
+		if err != nil {
+			return value.String{}, err
+		}
+
+		return value.String{Value: value.MustBeCompactString(decodedField.Value).Value}, nil
+	}
+
+	decodedField, err := decoder.ReadStringField(path)
+	if err != nil {
+		return value.String{}, err
+	}
+
+	return value.MustBeString(decodedField.Value), nil
+}
+
+func readNullableString(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (value.NullableString, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		decodedField, err := decoder.ReadCompactNullableStringField(path)
+		if err != nil {
+			return value.NullableString{}, err
+		}
+
+		return value.NullableString{Value: value.MustBeCompactNullableString(decodedField.Value).Value}, nil
+	}
+
+	decodedField, err := decoder.ReadNullableStringField(path)
+	if err != nil {
+		return value.NullableString{}, err
+	}
+
+	return value.MustBeNullableString(decodedField.Value), nil
+}
+
+func writeBytes(encoder *field_encoder.FieldEncoder, path string, bytesValue value.Bytes, isFlexible bool) {
+	if isFlexible {
+		encoder.WriteCompactBytesField(path, value.CompactBytes{Value: bytesValue.Value})
+	} else {
+		encoder.WriteBytesField(path, bytesValue)
+	}
+}
+
+func writeNullableBytes(encoder *field_encoder.FieldEncoder, path string, bytesValue value.NullableBytes, isFlexible bool) {
+	if isFlexible {
+		encoder.WriteCompactNullableBytesField(path, value.CompactNullableBytes{Value: bytesValue.Value})
+	} else {
+		encoder.WriteNullableBytesField(path, bytesValue)
+	}
+}
+
+func readBytes(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (value.Bytes, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		decodedField, err := decoder.ReadCompactBytesField(path)
+		if err != nil {
+			return value.Bytes{}, err
+		}
+
+		return value.Bytes{Value: value.MustBeCompactBytes(decodedField.Value).Value}, nil
+	}
+
+	decodedField, err := decoder.ReadBytesField(path)
+	if err != nil {
+		return value.Bytes{}, err
+	}
+
+	return value.MustBeBytes(decodedField.Value), nil
+}
+
+func readNullableBytes(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (value.NullableBytes, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		decodedField, err := decoder.ReadCompactNullableBytesField(path)
+		if err != nil {
+			return value.NullableBytes{}, err
+		}
+
+		return value.NullableBytes{Value: value.MustBeCompactNullableBytes(decodedField.Value).Value}, nil
+	}
+
+	decodedField, err := decoder.ReadNullableBytesField(path)
+	if err != nil {
+		return value.NullableBytes{}, err
+	}
+
+	return value.MustBeNullableBytes(decodedField.Value), nil
+}
+
+func readBoolean(decoder *field_decoder.FieldDecoder, path string) (value.Boolean, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadBooleanField(path)
+	if err != nil {
+		return value.Boolean{}, err
+	}
+
+	return value.MustBeBoolean(decodedField.Value), nil
+}
+
+func readInt8(decoder *field_decoder.FieldDecoder, path string) (value.Int8, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadInt8Field(path)
+	if err != nil {
+		return value.Int8{}, err
+	}
+
+	return value.MustBeInt8(decodedField.Value), nil
+}
+
+func readInt16(decoder *field_decoder.FieldDecoder, path string) (value.Int16, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadInt16Field(path)
+	if err != nil {
+		return value.Int16{}, err
+	}
+
+	return value.MustBeInt16(decodedField.Value), nil
+}
+
+func readUint16(decoder *field_decoder.FieldDecoder, path string) (value.Uint16, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadUint16Field(path)
+	if err != nil {
+		return value.Uint16{}, err
+	}
+
+	return value.MustBeUint16(decodedField.Value), nil
+}
+
+func readInt32(decoder *field_decoder.FieldDecoder, path string) (value.Int32, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadInt32Field(path)
+	if err != nil {
+		return value.Int32{}, err
+	}
+
+	return value.MustBeInt32(decodedField.Value), nil
+}
+
+func readInt64(decoder *field_decoder.FieldDecoder, path string) (value.Int64, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadInt64Field(path)
+	if err != nil {
+		return value.Int64{}, err
+	}
+
+	return value.MustBeInt64(decodedField.Value), nil
+}
+
+func readFloat64(decoder *field_decoder.FieldDecoder, path string) (value.Float64, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadFloat64Field(path)
+	if err != nil {
+		return value.Float64{}, err
+	}
+
+	return value.MustBeFloat64(decodedField.Value), nil
+}
+
+func readUUID(decoder *field_decoder.FieldDecoder, path string) (value.UUID, field_decoder.FieldDecoderError) {
+	decodedField, err := decoder.ReadUUIDField(path)
+	if err != nil {
+		return value.UUID{}, err
+	}
+
+	return value.MustBeUUID(decodedField.Value), nil
+}
+
+func decodeResponseHeader(decoder *field_decoder.FieldDecoder, isFlexible bool) (headers.ResponseHeader, field_decoder.FieldDecoderError) {
+	correlationId, err := decoder.ReadInt32Field("Header.CorrelationID")
+	if err != nil {
+		return headers.ResponseHeader{}, err
+	}
+
+	if !isFlexible {
+		return headers.ResponseHeader{
+			Version:       0,
+			CorrelationId: value.MustBeInt32(correlationId.Value),
+		}, nil
+	}
+
+	decoder.PushPathContext("Header")
+	defer decoder.PopPathContext()
+
+	if err := decoder.ConsumeTagBufferField(); err != nil {
+		return headers.ResponseHeader{}, err
+	}
+
+	return headers.ResponseHeader{
+		Version:       1,
+		CorrelationId: value.MustBeInt32(correlationId.Value),
+	}, nil
+}
+`
+
+// GenerateHelpers returns the formatted Go source for the helpers that generated messages use
+func GenerateHelpers(packageName string) ([]byte, error) {
+	return format.Source([]byte(fmt.Sprintf(helpersSource, packageName)))
+}
@@ -0,0 +1,53 @@
+package kafka_codegen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Generate reads every message spec in specsDir, and writes a Go file for each of them to outputDir
+func Generate(specsDir string, outputDir string, packageName string) error {
+	specPaths, err := filepath.Glob(filepath.Join(specsDir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	if len(specPaths) == 0 {
+		return fmt.Errorf("no message specs found in %s", specsDir)
+	}
+
+	sort.Strings(specPaths)
+
+	for _, specPath := range specPaths {
+		spec, err := ReadMessageSpec(specPath)
+		if err != nil {
+			return err
+		}
+
+		source, err := GenerateMessage(spec, packageName, filepath.Base(specPath))
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(outputDir, toSnakeCase(spec.Name)+".go"), source, 0644); err != nil {
+			return err
+		}
+	}
+
+	helpersSource, err := GenerateHelpers(packageName)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(outputDir, "helpers.go"), helpersSource, 0644)
+}
+
+var wordBoundaryRegex = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func toSnakeCase(name string) string {
+	return strings.ToLower(wordBoundaryRegex.ReplaceAllString(name, "${1}_${2}"))
+}
@@ -0,0 +1,105 @@
+package kafka_codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedCodeIsUpToDate(t *testing.T) {
+	outputDir := t.TempDir()
+	assert.NoError(t, Generate("specs", outputDir, "generated_kafkaapi"))
+
+	generatedPaths, err := filepath.Glob(filepath.Join(outputDir, "*.go"))
+	assert.NoError(t, err)
+
+	for _, generatedPath := range generatedPaths {
+		expected, err := os.ReadFile(generatedPath)
+		assert.NoError(t, err)
+
+		actual, err := os.ReadFile(filepath.Join("../../protocol/generated_kafkaapi", filepath.Base(generatedPath)))
+		assert.NoError(t, err)
+
+		assert.Equal(t, string(expected), string(actual), "%s is out of date, run go generate ./protocol/generated_kafkaapi", filepath.Base(generatedPath))
+	}
+}
+
+func TestVersionRangeCondition(t *testing.T) {
+	validVersions, _ := parseVersionRange("0-4")
+
+	for versions, expectedCondition := range map[string]string{
+		"0+":   "true",
+		"none": "false",
+		"3+":   "version >= 3",
+		"0-2":  "version <= 2",
+		"2":    "version == 2",
+		"1-3":  "version >= 1 && version <= 3",
+		"5+":   "false",
+	} {
+		versionRange, err := parseVersionRange(versions)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCondition, versionRange.condition(validVersions), versions)
+	}
+}
+
+func TestGenerateMessageRejectsUnsupportedFields(t *testing.T) {
+	spec := MessageSpec{
+		Type:             "request",
+		Name:             "ExampleRequest",
+		ValidVersions:    "0-1",
+		FlexibleVersions: "none",
+		Fields: []FieldSpec{
+			{Name: "Tagged", Type: "int32", Versions: "0+", Tag: new(int), TaggedVersions: "0+"},
+		},
+	}
+
+	_, err := GenerateMessage(spec, "generated_kafkaapi", "ExampleRequest.json")
+	assert.Error(t, err)
+}
+
+func TestGenerateMessageSupportsAllPrimitiveTypes(t *testing.T) {
+	spec := MessageSpec{
+		Type:             "request",
+		Name:             "ExampleRequest",
+		ValidVersions:    "0-1",
+		FlexibleVersions: "1+",
+		Fields: []FieldSpec{
+			{Name: "Port", Type: "uint16", Versions: "0+"},
+			{Name: "Ratio", Type: "float64", Versions: "0+"},
+			{Name: "Data", Type: "bytes", Versions: "0+"},
+			{Name: "OptionalData", Type: "bytes", Versions: "0+", NullableVersions: "0+"},
+			{Name: "OptionalName", Type: "string", Versions: "0+", NullableVersions: "0+"},
+		},
+	}
+
+	generatedCode, err := GenerateMessage(spec, "generated_kafkaapi", "ExampleRequest.json")
+	assert.NoError(t, err)
+	assert.Contains(t, string(generatedCode), "encoder.WriteUint16Field(\"Port\", message.Port)")
+	assert.Contains(t, string(generatedCode), "readFloat64(decoder, \"Ratio\")")
+	assert.Contains(t, string(generatedCode), "writeNullableBytes(encoder, \"OptionalData\", message.OptionalData, isFlexible)")
+}
+
+func TestGenerateMessageDoesNotRepeatMessageNameInNestedStructs(t *testing.T) {
+	spec := MessageSpec{
+		Type:             "request",
+		Name:             "ExampleRequest",
+		ValidVersions:    "0",
+		FlexibleVersions: "none",
+		Fields: []FieldSpec{
+			{Name: "Topics", Type: "[]ExampleRequestTopic", Versions: "0+", Fields: []FieldSpec{
+				{Name: "Name", Type: "string", Versions: "0+"},
+			}},
+			{Name: "Partitions", Type: "[]Partition", Versions: "0+", Fields: []FieldSpec{
+				{Name: "Index", Type: "int32", Versions: "0+"},
+			}},
+		},
+	}
+
+	generatedCode, err := GenerateMessage(spec, "generated_kafkaapi", "ExampleRequest.json")
+	assert.NoError(t, err)
+	assert.Contains(t, string(generatedCode), "type ExampleRequestTopic struct")
+	assert.Contains(t, string(generatedCode), "type ExampleRequestPartition struct")
+	assert.NotContains(t, string(generatedCode), "ExampleRequestExampleRequestTopic")
+}
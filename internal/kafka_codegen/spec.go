@@ -0,0 +1,146 @@
+package kafka_codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// MessageSpec is a message schema, in the format used by Apache Kafka's clients/src/main/resources/common/message/*.json
+type MessageSpec struct {
+	ApiKey           *int         `json:"apiKey"`
+	Type             string       `json:"type"`
+	Name             string       `json:"name"`
+	ValidVersions    string       `json:"validVersions"`
+	FlexibleVersions string       `json:"flexibleVersions"`
+	Fields           []FieldSpec  `json:"fields"`
+	CommonStructs    []StructSpec `json:"commonStructs"`
+}
+
+type FieldSpec struct {
+	Name             string      `json:"name"`
+	Type             string      `json:"type"`
+	Versions         string      `json:"versions"`
+	NullableVersions string      `json:"nullableVersions"`
+	TaggedVersions   string      `json:"taggedVersions"`
+	Tag              *int        `json:"tag"`
+	About            string      `json:"about"`
+	Fields           []FieldSpec `json:"fields"`
+}
+
+type StructSpec struct {
+	Name     string      `json:"name"`
+	Versions string      `json:"versions"`
+	Fields   []FieldSpec `json:"fields"`
+}
+
+// ReadMessageSpec reads a message schema, skipping the comment lines that Kafka's schema files start with
+func ReadMessageSpec(path string) (MessageSpec, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return MessageSpec{}, err
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(string(contents), "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+			lines = append(lines, line)
+		}
+	}
+
+	var spec MessageSpec
+	if err := json.Unmarshal([]byte(strings.Join(lines, "\n")), &spec); err != nil {
+		return MessageSpec{}, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	if spec.Type != "request" && spec.Type != "response" {
+		return MessageSpec{}, fmt.Errorf("%s: expected type to be request or response, got %q", path, spec.Type)
+	}
+
+	return spec, nil
+}
+
+// versionRange is an inclusive range of versions, as written in schemas ("0+", "1-3", "2" or "none")
+type versionRange struct {
+	min int
+	max int
+}
+
+func parseVersionRange(s string) (versionRange, error) {
+	switch {
+	case s == "" || s == "none":
+		return versionRange{min: 0, max: -1}, nil
+	case strings.HasSuffix(s, "+"):
+		min, err := strconv.Atoi(strings.TrimSuffix(s, "+"))
+		if err != nil {
+			return versionRange{}, fmt.Errorf("invalid version range %q", s)
+		}
+
+		return versionRange{min: min, max: math.MaxInt16}, nil
+	case strings.Contains(s, "-"):
+		parts := strings.SplitN(s, "-", 2)
+
+		min, minErr := strconv.Atoi(parts[0])
+		max, maxErr := strconv.Atoi(parts[1])
+		if minErr != nil || maxErr != nil {
+			return versionRange{}, fmt.Errorf("invalid version range %q", s)
+		}
+
+		return versionRange{min: min, max: max}, nil
+	default:
+		version, err := strconv.Atoi(s)
+		if err != nil {
+			return versionRange{}, fmt.Errorf("invalid version range %q", s)
+		}
+
+		return versionRange{min: version, max: version}, nil
+	}
+}
+
+func (r versionRange) isEmpty() bool {
+	return r.min > r.max
+}
+
+func (r versionRange) intersect(other versionRange) versionRange {
+	return versionRange{min: max(r.min, other.min), max: min(r.max, other.max)}
+}
+
+func (r versionRange) contains(other versionRange) bool {
+	return other.isEmpty() || (r.min <= other.min && other.max <= r.max)
+}
+
+// condition returns a Go expression that checks if version is in the range, assuming version is within validVersions
+func (r versionRange) condition(validVersions versionRange) string {
+	r = r.intersect(validVersions)
+
+	switch {
+	case r.isEmpty():
+		return "false"
+	case r.contains(validVersions):
+		return "true"
+	case r.min <= validVersions.min:
+		return fmt.Sprintf("version <= %d", r.max)
+	case r.max >= validVersions.max:
+		return fmt.Sprintf("version >= %d", r.min)
+	case r.min == r.max:
+		return fmt.Sprintf("version == %d", r.min)
+	default:
+		return fmt.Sprintf("version >= %d && version <= %d", r.min, r.max)
+	}
+}
+
+func (r versionRange) String() string {
+	switch {
+	case r.isEmpty():
+		return "none"
+	case r.max == math.MaxInt16:
+		return fmt.Sprintf("%d+", r.min)
+	case r.min == r.max:
+		return strconv.Itoa(r.min)
+	default:
+		return fmt.Sprintf("%d-%d", r.min, r.max)
+	}
+}
@@ -31,6 +31,33 @@ func NewKafkaExecutable(stageHarness *test_case_harness.TestCaseHarness) *KafkaE
 func (b *KafkaExecutable) Run(args ...string) error {
 	b.args = args
 	b.args = append(b.args, common.SERVER_PROPERTIES_FILE_PATH)
+	b.logCommand()
+
+	if err := b.executable.Start(b.args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Restart terminates the program and starts it again with the same arguments.
+// Log directories aren't regenerated, so this can be used to check that data is persisted across restarts.
+func (b *KafkaExecutable) Restart() error {
+	b.logger.Infof("Restarting program")
+
+	// Kill only errors if the program didn't exit after SIGTERM and had to be killed, that's fine for a restart
+	_ = b.Kill()
+
+	b.logCommand()
+
+	if err := b.executable.Start(b.args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *KafkaExecutable) logCommand() {
 	if len(b.args) == 0 {
 		b.logger.Infof("$ ./%s", path.Base(b.executable.Path))
 	} else {
@@ -43,14 +70,8 @@ func (b *KafkaExecutable) Run(args ...string) error {
 				log += " " + arg
 			}
 		}
-		b.logger.Infof(log)
+		b.logger.Infof("%s", log)
 	}
-
-	if err := b.executable.Start(b.args...); err != nil {
-		return err
-	}
-
-	return nil
 }
 
 func (b *KafkaExecutable) HasExited() bool {
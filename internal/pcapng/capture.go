@@ -0,0 +1,50 @@
+package pcapng
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+// CaptureFilePathEnvVar enables capturing all tester traffic to a pcapng file at the given path.
+const CaptureFilePathEnvVar = "CODECRAFTERS_KAFKA_PCAPNG_PATH"
+
+// Each connection gets its own (synthetic) client port, so that Wireshark shows it as a separate stream
+const firstClientPort = 50000
+
+var (
+	captureWriter     *Writer
+	captureWriterErr  error
+	captureWriterOnce sync.Once
+
+	nextClientPort atomic.Uint32
+)
+
+// IsCaptureEnabled returns true if the capture file path environment variable is set.
+func IsCaptureEnabled() bool {
+	return os.Getenv(CaptureFilePathEnvVar) != ""
+}
+
+// NewCaptureStream returns a new TCPStream that writes to the capture file shared by all clients.
+// The capture file is created the first time this is called.
+func NewCaptureStream(name string, serverPort uint16) (*TCPStream, error) {
+	captureWriterOnce.Do(func() {
+		path := os.Getenv(CaptureFilePathEnvVar)
+
+		file, err := os.Create(path)
+		if err != nil {
+			captureWriterErr = fmt.Errorf("failed to create pcapng capture file at %s: %v", path, err)
+			return
+		}
+
+		captureWriter, captureWriterErr = NewWriter(file)
+	})
+
+	if captureWriterErr != nil {
+		return nil, captureWriterErr
+	}
+
+	clientPort := uint16(firstClientPort + nextClientPort.Add(1) - 1)
+	return NewTCPStream(captureWriter, name, clientPort, serverPort), nil
+}
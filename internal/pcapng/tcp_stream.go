@@ -0,0 +1,162 @@
+package pcapng
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+const (
+	tcpFlagFin = 0x01
+	tcpFlagSyn = 0x02
+	tcpFlagPsh = 0x08
+	tcpFlagAck = 0x10
+
+	// Keeps each synthetic IPv4 packet well below the 65535 byte limit
+	maxSegmentPayloadSize = 60000
+)
+
+var loopbackAddress = [4]byte{127, 0, 0, 1}
+
+// TCPStream writes synthetic TCP/IP packets for a single client connection, so that Wireshark
+// can reassemble (and dissect) the bytes exchanged between a client and the broker.
+type TCPStream struct {
+	writer *Writer
+
+	// name is attached as a comment to every packet in the stream (for eg. client-1)
+	name string
+
+	clientPort uint16
+	serverPort uint16
+
+	clientSeq uint32
+	serverSeq uint32
+}
+
+func NewTCPStream(writer *Writer, name string, clientPort uint16, serverPort uint16) *TCPStream {
+	return &TCPStream{
+		writer:     writer,
+		name:       name,
+		clientPort: clientPort,
+		serverPort: serverPort,
+	}
+}
+
+// Open writes the TCP three-way handshake.
+func (s *TCPStream) Open() error {
+	if err := s.writeSegment(true, tcpFlagSyn, nil); err != nil {
+		return err
+	}
+	s.clientSeq++
+
+	if err := s.writeSegment(false, tcpFlagSyn|tcpFlagAck, nil); err != nil {
+		return err
+	}
+	s.serverSeq++
+
+	return s.writeSegment(true, tcpFlagAck, nil)
+}
+
+// ClientSent writes the bytes sent by the client to the broker.
+func (s *TCPStream) ClientSent(data []byte) error {
+	return s.writeData(true, data)
+}
+
+// ServerSent writes the bytes sent by the broker to the client.
+func (s *TCPStream) ServerSent(data []byte) error {
+	return s.writeData(false, data)
+}
+
+// Close writes a FIN from the client, acknowledged by the broker.
+func (s *TCPStream) Close() error {
+	if err := s.writeSegment(true, tcpFlagFin|tcpFlagAck, nil); err != nil {
+		return err
+	}
+	s.clientSeq++
+
+	return s.writeSegment(false, tcpFlagAck, nil)
+}
+
+func (s *TCPStream) writeData(fromClient bool, data []byte) error {
+	for start := 0; start < len(data); start += maxSegmentPayloadSize {
+		end := min(start+maxSegmentPayloadSize, len(data))
+
+		if err := s.writeSegment(fromClient, tcpFlagPsh|tcpFlagAck, data[start:end]); err != nil {
+			return err
+		}
+
+		if fromClient {
+			s.clientSeq += uint32(end - start)
+		} else {
+			s.serverSeq += uint32(end - start)
+		}
+	}
+
+	return nil
+}
+
+func (s *TCPStream) writeSegment(fromClient bool, flags byte, payload []byte) error {
+	sourcePort, destinationPort := s.clientPort, s.serverPort
+	seq, ack := s.clientSeq, s.serverSeq
+
+	if !fromClient {
+		sourcePort, destinationPort = s.serverPort, s.clientPort
+		seq, ack = s.serverSeq, s.clientSeq
+	}
+
+	if flags&tcpFlagAck == 0 {
+		ack = 0
+	}
+
+	tcpSegment := make([]byte, 20+len(payload))
+	binary.BigEndian.PutUint16(tcpSegment[0:2], sourcePort)
+	binary.BigEndian.PutUint16(tcpSegment[2:4], destinationPort)
+	binary.BigEndian.PutUint32(tcpSegment[4:8], seq)
+	binary.BigEndian.PutUint32(tcpSegment[8:12], ack)
+	tcpSegment[12] = 5 << 4 // Data offset: 5 * 4 bytes, no options
+	tcpSegment[13] = flags
+	binary.BigEndian.PutUint16(tcpSegment[14:16], 65535) // Window size
+	copy(tcpSegment[20:], payload)
+	binary.BigEndian.PutUint16(tcpSegment[16:18], tcpChecksum(tcpSegment))
+
+	packet := make([]byte, 20+len(tcpSegment))
+	packet[0] = 0x45 // IPv4, header length: 5 * 4 bytes
+	binary.BigEndian.PutUint16(packet[2:4], uint16(len(packet)))
+	binary.BigEndian.PutUint16(packet[6:8], 0x4000) // Don't fragment
+	packet[8] = 64                                  // TTL
+	packet[9] = 6                                   // Protocol: TCP
+	copy(packet[12:16], loopbackAddress[:])
+	copy(packet[16:20], loopbackAddress[:])
+	binary.BigEndian.PutUint16(packet[10:12], internetChecksum(packet[:20], 0))
+	copy(packet[20:], tcpSegment)
+
+	return s.writer.WritePacket(time.Now(), packet, s.name)
+}
+
+func tcpChecksum(tcpSegment []byte) uint16 {
+	// Pseudo header: source address, destination address, zero, protocol, TCP length
+	var pseudoHeaderSum uint32
+	pseudoHeaderSum += uint32(binary.BigEndian.Uint16(loopbackAddress[0:2])) * 2
+	pseudoHeaderSum += uint32(binary.BigEndian.Uint16(loopbackAddress[2:4])) * 2
+	pseudoHeaderSum += 6
+	pseudoHeaderSum += uint32(len(tcpSegment))
+
+	return internetChecksum(tcpSegment, pseudoHeaderSum)
+}
+
+func internetChecksum(data []byte, initialSum uint32) uint16 {
+	sum := initialSum
+
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(data[i : i+2]))
+	}
+
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+
+	return ^uint16(sum)
+}
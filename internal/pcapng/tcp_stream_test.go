@@ -0,0 +1,50 @@
+package pcapng
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTCPStreamWritesValidBlocks(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	writer, err := NewWriter(buffer)
+	assert.NoError(t, err)
+
+	stream := NewTCPStream(writer, "client-1", 50000, 9092)
+	assert.NoError(t, stream.Open())
+	assert.NoError(t, stream.ClientSent([]byte{0, 0, 0, 3, 1, 2, 3}))
+	assert.NoError(t, stream.ServerSent([]byte{0, 0, 0, 1, 4}))
+	assert.NoError(t, stream.Close())
+
+	blockTypes := []uint32{}
+	data := buffer.Bytes()
+
+	for len(data) > 0 {
+		blockType := binary.LittleEndian.Uint32(data[0:4])
+		totalLength := binary.LittleEndian.Uint32(data[4:8])
+
+		assert.Equal(t, uint32(0), totalLength%4)
+		assert.Equal(t, totalLength, binary.LittleEndian.Uint32(data[totalLength-4:totalLength]))
+
+		if blockType == enhancedPacketBlockType {
+			capturedLength := binary.LittleEndian.Uint32(data[20:24])
+			packet := data[28 : 28+capturedLength]
+
+			// A valid IPv4 header checksums to zero
+			assert.Equal(t, uint16(0), internetChecksum(packet[:20], 0))
+			assert.Equal(t, uint16(len(packet)), binary.BigEndian.Uint16(packet[2:4]))
+		}
+
+		blockTypes = append(blockTypes, blockType)
+		data = data[totalLength:]
+	}
+
+	// Section header, interface description, 3 handshake packets, 2 data packets, 2 close packets
+	assert.Equal(t, []uint32{sectionHeaderBlockType, interfaceDescriptionType}, blockTypes[:2])
+	assert.Len(t, blockTypes, 9)
+	assert.Equal(t, uint32(1+7+1), stream.clientSeq)
+	assert.Equal(t, uint32(1+5), stream.serverSeq)
+}
@@ -0,0 +1,105 @@
+package pcapng
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	sectionHeaderBlockType   = 0x0A0D0D0A
+	interfaceDescriptionType = 0x00000001
+	enhancedPacketBlockType  = 0x00000006
+	byteOrderMagic           = 0x1A2B3C4D
+	linkTypeRaw              = 101
+	optionCodeEndOfOptions   = 0
+	optionCodeComment        = 1
+	maxSnapshotLength        = 262144
+	interfaceId              = 0
+)
+
+// Writer writes packets to a pcapng file with a single raw IPv4 interface.
+//
+// It's safe for concurrent use, since multiple clients write to the same capture.
+type Writer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriter writes the section header and interface description blocks to w and returns a Writer.
+func NewWriter(w io.Writer) (*Writer, error) {
+	writer := &Writer{w: w}
+
+	sectionHeaderBody := new(bytes.Buffer)
+	binary.Write(sectionHeaderBody, binary.LittleEndian, uint32(byteOrderMagic))
+	binary.Write(sectionHeaderBody, binary.LittleEndian, uint16(1)) // Major version
+	binary.Write(sectionHeaderBody, binary.LittleEndian, uint16(0)) // Minor version
+	binary.Write(sectionHeaderBody, binary.LittleEndian, int64(-1)) // Section length (unspecified)
+
+	if err := writer.writeBlock(sectionHeaderBlockType, sectionHeaderBody.Bytes()); err != nil {
+		return nil, err
+	}
+
+	interfaceDescriptionBody := new(bytes.Buffer)
+	binary.Write(interfaceDescriptionBody, binary.LittleEndian, uint16(linkTypeRaw))
+	binary.Write(interfaceDescriptionBody, binary.LittleEndian, uint16(0)) // Reserved
+	binary.Write(interfaceDescriptionBody, binary.LittleEndian, uint32(maxSnapshotLength))
+
+	if err := writer.writeBlock(interfaceDescriptionType, interfaceDescriptionBody.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// WritePacket writes a single raw IPv4 packet, with an optional comment shown by Wireshark.
+func (w *Writer) WritePacket(timestamp time.Time, packet []byte, comment string) error {
+	body := new(bytes.Buffer)
+	timestampMicros := uint64(timestamp.UnixMicro())
+
+	binary.Write(body, binary.LittleEndian, uint32(interfaceId))
+	binary.Write(body, binary.LittleEndian, uint32(timestampMicros>>32))
+	binary.Write(body, binary.LittleEndian, uint32(timestampMicros))
+	binary.Write(body, binary.LittleEndian, uint32(len(packet))) // Captured length
+	binary.Write(body, binary.LittleEndian, uint32(len(packet))) // Original length
+	body.Write(padTo4Bytes(packet))
+
+	if comment != "" {
+		binary.Write(body, binary.LittleEndian, uint16(optionCodeComment))
+		binary.Write(body, binary.LittleEndian, uint16(len(comment)))
+		body.Write(padTo4Bytes([]byte(comment)))
+		binary.Write(body, binary.LittleEndian, uint16(optionCodeEndOfOptions))
+		binary.Write(body, binary.LittleEndian, uint16(0))
+	}
+
+	return w.writeBlock(enhancedPacketBlockType, body.Bytes())
+}
+
+// writeBlock writes a block as: block type, total length, body, total length
+func (w *Writer) writeBlock(blockType uint32, body []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	totalLength := uint32(len(body) + 12)
+	block := new(bytes.Buffer)
+
+	binary.Write(block, binary.LittleEndian, blockType)
+	binary.Write(block, binary.LittleEndian, totalLength)
+	block.Write(body)
+	binary.Write(block, binary.LittleEndian, totalLength)
+
+	_, err := w.w.Write(block.Bytes())
+	return err
+}
+
+func padTo4Bytes(data []byte) []byte {
+	if len(data)%4 == 0 {
+		return data
+	}
+
+	padded := make([]byte, len(data)+4-len(data)%4)
+	copy(padded, data)
+	return padded
+}
@@ -0,0 +1,192 @@
+package request_decoders
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// Request decoders mirror the field paths used by request_encoders, so that the same tree is printed
+// for a request regardless of whether it was encoded by the tester or decoded from another client.
+
+func decodeV1Header(decoder *field_decoder.FieldDecoder) (headers.RequestHeader, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Header")
+	defer decoder.PopPathContext()
+
+	apiKey, err := decoder.ReadInt16Field("APIKey")
+	if err != nil {
+		return headers.RequestHeader{}, err
+	}
+
+	apiVersion, err := decoder.ReadInt16Field("APIVersion")
+	if err != nil {
+		return headers.RequestHeader{}, err
+	}
+
+	correlationId, err := decoder.ReadInt32Field("CorrelationID")
+	if err != nil {
+		return headers.RequestHeader{}, err
+	}
+
+	clientId, err := decoder.ReadStringField("ClientID")
+	if err != nil {
+		return headers.RequestHeader{}, err
+	}
+
+	return headers.RequestHeader{
+		ApiKey:        value.MustBeInt16(apiKey.Value),
+		ApiVersion:    value.MustBeInt16(apiVersion.Value),
+		CorrelationId: value.MustBeInt32(correlationId.Value),
+		ClientId:      value.MustBeString(clientId.Value),
+	}, nil
+}
+
+// decodeHeader decodes a v2 request header (with a tag buffer) if the request's version is flexible, and a v1 header otherwise
+func decodeHeader(decoder *field_decoder.FieldDecoder) (headers.RequestHeader, field_decoder.FieldDecoderError) {
+	header, err := decodeV1Header(decoder)
+	if err != nil {
+		return headers.RequestHeader{}, err
+	}
+
+	if !utils.UsesFlexibleRequestHeader(header.ApiKey.Value, header.ApiVersion.Value) {
+		return header, nil
+	}
+
+	decoder.PushPathContext("Header")
+	defer decoder.PopPathContext()
+
+	taggedFields, err := decoder.ReadTaggedFieldsField(nil)
+	if err != nil {
+		return headers.RequestHeader{}, err
+	}
+
+	header.TaggedFields = taggedFields
+
+	return header, nil
+}
+
+func decodeCompactArray[T any](decoder *field_decoder.FieldDecoder, decodeFunc func(*field_decoder.FieldDecoder) (T, field_decoder.FieldDecoderError), path string) ([]T, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext(path)
+	defer decoder.PopPathContext()
+
+	lengthValue, err := decoder.ReadCompactArrayLengthField("Length")
+	if err != nil {
+		return nil, err
+	}
+
+	lengthValueAsCompactArrayLength := value.MustBeCompactArrayLength(lengthValue.Value)
+
+	// Null array
+	if lengthValueAsCompactArrayLength.Value == 0 {
+		return nil, nil
+	}
+
+	if lengthValueAsCompactArrayLength.ActualLength() > decoder.RemainingBytesCount() {
+		return nil, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected array length to be at most %d (the number of remaining bytes), got %d", decoder.RemainingBytesCount(), lengthValueAsCompactArrayLength.ActualLength()),
+			lengthValue,
+		)
+	}
+
+	elements := make([]T, lengthValueAsCompactArrayLength.ActualLength())
+
+	for i := 0; i < int(lengthValueAsCompactArrayLength.ActualLength()); i++ {
+		decoder.PushPathContext(fmt.Sprintf("%s[%d]", path, i))
+		element, err := decodeFunc(decoder)
+		decoder.PopPathContext()
+
+		if err != nil {
+			return nil, err
+		}
+
+		elements[i] = element
+	}
+
+	return elements, nil
+}
+
+func decodeArray[T any](decoder *field_decoder.FieldDecoder, decodeFunc func(*field_decoder.FieldDecoder) (T, field_decoder.FieldDecoderError), path string) ([]T, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext(path)
+	defer decoder.PopPathContext()
+
+	lengthValue, err := decoder.ReadInt32Field("Length")
+	if err != nil {
+		return nil, err
+	}
+
+	lengthValueAsInt32 := value.MustBeInt32(lengthValue.Value)
+	if lengthValueAsInt32.Value < 0 {
+		// Null array
+		if lengthValueAsInt32.Value == -1 {
+			return nil, nil
+		}
+
+		return nil, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected array length to be -1 or a non-negative number, got %d", lengthValueAsInt32.Value),
+			lengthValue,
+		)
+	}
+
+	if uint64(lengthValueAsInt32.Value) > decoder.RemainingBytesCount() {
+		return nil, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected array length to be at most %d (the number of remaining bytes), got %d", decoder.RemainingBytesCount(), lengthValueAsInt32.Value),
+			lengthValue,
+		)
+	}
+
+	elements := make([]T, lengthValueAsInt32.Value)
+
+	for i := 0; i < int(lengthValueAsInt32.Value); i++ {
+		decoder.PushPathContext(fmt.Sprintf("%s[%d]", path, i))
+		element, err := decodeFunc(decoder)
+		decoder.PopPathContext()
+
+		if err != nil {
+			return nil, err
+		}
+
+		elements[i] = element
+	}
+
+	return elements, nil
+}
+
+// decodeArrayForVersion decodes a compact array in flexible versions, and an array with an INT32 length otherwise
+func decodeArrayForVersion[T any](decoder *field_decoder.FieldDecoder, decodeFunc func(*field_decoder.FieldDecoder) (T, field_decoder.FieldDecoderError), path string, isFlexible bool) ([]T, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		return decodeCompactArray(decoder, decodeFunc, path)
+	}
+
+	return decodeArray(decoder, decodeFunc, path)
+}
+
+// readStringForVersion reads a COMPACT_STRING in flexible versions, and a STRING otherwise
+func readStringForVersion(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (value.CompactString, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		compactString, err := decoder.ReadCompactStringField(path)
+		if err != nil {
+			return value.CompactString{}, err
+		}
+
+		return value.MustBeCompactString(compactString.Value), nil
+	}
+
+	stringField, err := decoder.ReadStringField(path)
+	if err != nil {
+		return value.CompactString{}, err
+	}
+
+	return value.CompactString{Value: value.MustBeString(stringField.Value).Value}, nil
+}
+
+// consumeTagBufferForVersion consumes a tag buffer, tag buffers are only present in flexible versions
+func consumeTagBufferForVersion(decoder *field_decoder.FieldDecoder, isFlexible bool) field_decoder.FieldDecoderError {
+	if !isFlexible {
+		return nil
+	}
+
+	return decoder.ConsumeTagBufferField()
+}
@@ -0,0 +1,61 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func DecodeApiVersionsRequest(decoder *field_decoder.FieldDecoder) (kafkaapi.ApiVersionsRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("ApiVersionsRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return kafkaapi.ApiVersionsRequest{}, err
+	}
+
+	// Versions 0-2 have an empty body
+	body := kafkaapi.ApiVersionsRequestBody{}
+
+	if utils.IsFlexibleVersion(18, header.ApiVersion.Value) {
+		body, err = decodeApiVersionsRequestBody(decoder)
+		if err != nil {
+			return kafkaapi.ApiVersionsRequest{}, err
+		}
+	}
+
+	body.Version = header.ApiVersion
+
+	return kafkaapi.ApiVersionsRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeApiVersionsRequestBody(decoder *field_decoder.FieldDecoder) (kafkaapi.ApiVersionsRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+
+	clientSoftwareName, err := decoder.ReadCompactStringField("ClientSoftwareName")
+	if err != nil {
+		return kafkaapi.ApiVersionsRequestBody{}, err
+	}
+
+	clientSoftwareVersion, err := decoder.ReadCompactStringField("ClientSoftwareVersion")
+	if err != nil {
+		return kafkaapi.ApiVersionsRequestBody{}, err
+	}
+
+	taggedFields, err := decoder.ReadTaggedFieldsField(nil)
+	if err != nil {
+		return kafkaapi.ApiVersionsRequestBody{}, err
+	}
+
+	return kafkaapi.ApiVersionsRequestBody{
+		ClientSoftwareName:    value.MustBeCompactString(clientSoftwareName.Value),
+		ClientSoftwareVersion: value.MustBeCompactString(clientSoftwareVersion.Value),
+		TaggedFields:          taggedFields,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeCreateTopicsRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.CreateTopicsRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("CreateTopicsRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.CreateTopicsRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeCreateTopicsRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.CreateTopicsRequest{}, err
+	}
+
+	return generated_kafkaapi.CreateTopicsRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
@@ -0,0 +1,107 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func DecodeDescribeTopicPartitionsRequest(decoder *field_decoder.FieldDecoder) (kafkaapi.DescribeTopicPartitionsRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("DescribeTopicPartitionsRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return kafkaapi.DescribeTopicPartitionsRequest{}, err
+	}
+
+	body, err := decodeDescribeTopicPartitionsRequestBody(decoder)
+	if err != nil {
+		return kafkaapi.DescribeTopicPartitionsRequest{}, err
+	}
+
+	return kafkaapi.DescribeTopicPartitionsRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeDescribeTopicPartitionsRequestBody(decoder *field_decoder.FieldDecoder) (kafkaapi.DescribeTopicPartitionsRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+
+	topicNames, err := decodeCompactArray(decoder, decodeDescribeTopicPartitionsRequestTopic, "Topics")
+	if err != nil {
+		return kafkaapi.DescribeTopicPartitionsRequestBody{}, err
+	}
+
+	responsePartitionLimit, err := decoder.ReadInt32Field("ResponsePartitionLimit")
+	if err != nil {
+		return kafkaapi.DescribeTopicPartitionsRequestBody{}, err
+	}
+
+	cursor, err := decodeCursor(decoder)
+	if err != nil {
+		return kafkaapi.DescribeTopicPartitionsRequestBody{}, err
+	}
+
+	if err := decoder.ConsumeTagBufferField(); err != nil {
+		return kafkaapi.DescribeTopicPartitionsRequestBody{}, err
+	}
+
+	return kafkaapi.DescribeTopicPartitionsRequestBody{
+		TopicNames:             topicNames,
+		ResponsePartitionLimit: value.MustBeInt32(responsePartitionLimit.Value),
+		Cursor:                 cursor,
+	}, nil
+}
+
+func decodeDescribeTopicPartitionsRequestTopic(decoder *field_decoder.FieldDecoder) (value.CompactString, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Topic")
+	defer decoder.PopPathContext()
+
+	name, err := decoder.ReadCompactStringField("Name")
+	if err != nil {
+		return value.CompactString{}, err
+	}
+
+	if err := decoder.ConsumeTagBufferField(); err != nil {
+		return value.CompactString{}, err
+	}
+
+	return value.MustBeCompactString(name.Value), nil
+}
+
+func decodeCursor(decoder *field_decoder.FieldDecoder) (*kafkaapi.Cursor, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Cursor")
+	defer decoder.PopPathContext()
+
+	isCursorPresent, err := decoder.ReadInt8Field("IsCursorPresent")
+	if err != nil {
+		return nil, err
+	}
+
+	// -1 indicates a null cursor
+	if value.MustBeInt8(isCursorPresent.Value).Value == -1 {
+		return nil, nil
+	}
+
+	topicName, err := decoder.ReadCompactStringField("TopicName")
+	if err != nil {
+		return nil, err
+	}
+
+	partitionIndex, err := decoder.ReadInt32Field("PartitionIndex")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.ConsumeTagBufferField(); err != nil {
+		return nil, err
+	}
+
+	return &kafkaapi.Cursor{
+		TopicName:      value.MustBeCompactString(topicName.Value),
+		PartitionIndex: value.MustBeInt32(partitionIndex.Value),
+	}, nil
+}
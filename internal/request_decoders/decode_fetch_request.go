@@ -0,0 +1,266 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func DecodeFetchRequest(decoder *field_decoder.FieldDecoder) (kafkaapi.FetchRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("FetchRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return kafkaapi.FetchRequest{}, err
+	}
+
+	body, err := decodeFetchRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return kafkaapi.FetchRequest{}, err
+	}
+
+	return kafkaapi.FetchRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeFetchRequestBody(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.FetchRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+
+	isFlexible := utils.IsFlexibleVersion(1, version)
+	body := kafkaapi.FetchRequestBody{}
+
+	if version <= 14 {
+		replicaId, err := decoder.ReadInt32Field("ReplicaID")
+		if err != nil {
+			return kafkaapi.FetchRequestBody{}, err
+		}
+
+		body.ReplicaId = value.MustBeInt32(replicaId.Value)
+	}
+
+	maxWaitMS, err := decoder.ReadInt32Field("MaxWaitMS")
+	if err != nil {
+		return kafkaapi.FetchRequestBody{}, err
+	}
+
+	minBytes, err := decoder.ReadInt32Field("MinBytes")
+	if err != nil {
+		return kafkaapi.FetchRequestBody{}, err
+	}
+
+	maxBytes, err := decoder.ReadInt32Field("MaxBytes")
+	if err != nil {
+		return kafkaapi.FetchRequestBody{}, err
+	}
+
+	isolationLevel, err := decoder.ReadInt8Field("IsolationLevel")
+	if err != nil {
+		return kafkaapi.FetchRequestBody{}, err
+	}
+
+	body.MaxWaitMS = value.MustBeInt32(maxWaitMS.Value)
+	body.MinBytes = value.MustBeInt32(minBytes.Value)
+	body.MaxBytes = value.MustBeInt32(maxBytes.Value)
+	body.IsolationLevel = value.MustBeInt8(isolationLevel.Value)
+
+	if version >= 7 {
+		sessionId, err := decoder.ReadInt32Field("SessionID")
+		if err != nil {
+			return kafkaapi.FetchRequestBody{}, err
+		}
+
+		sessionEpoch, err := decoder.ReadInt32Field("SessionEpoch")
+		if err != nil {
+			return kafkaapi.FetchRequestBody{}, err
+		}
+
+		body.SessionId = value.MustBeInt32(sessionId.Value)
+		body.SessionEpoch = value.MustBeInt32(sessionEpoch.Value)
+	}
+
+	topics, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.Topic, field_decoder.FieldDecoderError) {
+		return decodeFetchRequestTopic(decoder, version)
+	}, "Topics", isFlexible)
+	if err != nil {
+		return kafkaapi.FetchRequestBody{}, err
+	}
+
+	body.Topics = topics
+
+	if version >= 7 {
+		forgottenTopics, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.ForgottenTopic, field_decoder.FieldDecoderError) {
+			return decodeForgottenTopic(decoder, version)
+		}, "ForgottenTopics", isFlexible)
+		if err != nil {
+			return kafkaapi.FetchRequestBody{}, err
+		}
+
+		body.ForgottenTopics = forgottenTopics
+	}
+
+	if version >= 11 {
+		rackId, err := readStringForVersion(decoder, "RackID", isFlexible)
+		if err != nil {
+			return kafkaapi.FetchRequestBody{}, err
+		}
+
+		body.RackId = rackId
+	}
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
+		return kafkaapi.FetchRequestBody{}, err
+	}
+
+	return body, nil
+}
+
+func decodeFetchRequestTopic(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.Topic, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Topic")
+	defer decoder.PopPathContext()
+
+	isFlexible := utils.IsFlexibleVersion(1, version)
+	topic := kafkaapi.Topic{}
+
+	if version >= 13 {
+		uuid, err := decoder.ReadUUIDField("UUID")
+		if err != nil {
+			return kafkaapi.Topic{}, err
+		}
+
+		topic.UUID = value.MustBeUUID(uuid.Value)
+	} else {
+		name, err := readStringForVersion(decoder, "Name", isFlexible)
+		if err != nil {
+			return kafkaapi.Topic{}, err
+		}
+
+		topic.Name = name
+	}
+
+	partitions, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.Partition, field_decoder.FieldDecoderError) {
+		return decodeTopicPartition(decoder, version)
+	}, "Partitions", isFlexible)
+	if err != nil {
+		return kafkaapi.Topic{}, err
+	}
+
+	topic.Partitions = partitions
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
+		return kafkaapi.Topic{}, err
+	}
+
+	return topic, nil
+}
+
+func decodeTopicPartition(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.Partition, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Partition")
+	defer decoder.PopPathContext()
+
+	id, err := decoder.ReadInt32Field("ID")
+	if err != nil {
+		return kafkaapi.Partition{}, err
+	}
+
+	partition := kafkaapi.Partition{
+		ID: value.MustBeInt32(id.Value),
+	}
+
+	if version >= 9 {
+		currentLeaderEpoch, err := decoder.ReadInt32Field("CurrentLeaderEpoch")
+		if err != nil {
+			return kafkaapi.Partition{}, err
+		}
+
+		partition.CurrentLeaderEpoch = value.MustBeInt32(currentLeaderEpoch.Value)
+	}
+
+	fetchOffset, err := decoder.ReadInt64Field("FetchOffset")
+	if err != nil {
+		return kafkaapi.Partition{}, err
+	}
+
+	partition.FetchOffset = value.MustBeInt64(fetchOffset.Value)
+
+	if version >= 12 {
+		lastFetchedOffset, err := decoder.ReadInt32Field("LastFetchedOffset")
+		if err != nil {
+			return kafkaapi.Partition{}, err
+		}
+
+		partition.LastFetchedOffset = value.MustBeInt32(lastFetchedOffset.Value)
+	}
+
+	if version >= 5 {
+		logStartOffset, err := decoder.ReadInt64Field("LogStartOffset")
+		if err != nil {
+			return kafkaapi.Partition{}, err
+		}
+
+		partition.LogStartOffset = value.MustBeInt64(logStartOffset.Value)
+	}
+
+	partitionMaxBytes, err := decoder.ReadInt32Field("PartitionMaxBytes")
+	if err != nil {
+		return kafkaapi.Partition{}, err
+	}
+
+	partition.PartitionMaxBytes = value.MustBeInt32(partitionMaxBytes.Value)
+
+	if err := consumeTagBufferForVersion(decoder, utils.IsFlexibleVersion(1, version)); err != nil {
+		return kafkaapi.Partition{}, err
+	}
+
+	return partition, nil
+}
+
+func decodeForgottenTopic(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.ForgottenTopic, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("ForgottenTopic")
+	defer decoder.PopPathContext()
+
+	isFlexible := utils.IsFlexibleVersion(1, version)
+	forgottenTopic := kafkaapi.ForgottenTopic{}
+
+	if version >= 13 {
+		uuid, err := decoder.ReadUUIDField("UUID")
+		if err != nil {
+			return kafkaapi.ForgottenTopic{}, err
+		}
+
+		forgottenTopic.UUID = value.MustBeUUID(uuid.Value)
+	} else {
+		name, err := readStringForVersion(decoder, "Name", isFlexible)
+		if err != nil {
+			return kafkaapi.ForgottenTopic{}, err
+		}
+
+		forgottenTopic.Name = name
+	}
+
+	partitionIds, err := decodeArrayForVersion(decoder, decodePartitionId, "Partitions", isFlexible)
+	if err != nil {
+		return kafkaapi.ForgottenTopic{}, err
+	}
+
+	forgottenTopic.PartitionIds = partitionIds
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
+		return kafkaapi.ForgottenTopic{}, err
+	}
+
+	return forgottenTopic, nil
+}
+
+func decodePartitionId(decoder *field_decoder.FieldDecoder) (value.Int32, field_decoder.FieldDecoderError) {
+	partitionId, err := decoder.ReadInt32Field("PartitionID")
+	if err != nil {
+		return value.Int32{}, err
+	}
+
+	return value.MustBeInt32(partitionId.Value), nil
+}
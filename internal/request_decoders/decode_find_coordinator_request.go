@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeFindCoordinatorRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.FindCoordinatorRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("FindCoordinatorRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.FindCoordinatorRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeFindCoordinatorRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.FindCoordinatorRequest{}, err
+	}
+
+	return generated_kafkaapi.FindCoordinatorRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeHeartbeatRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.HeartbeatRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("HeartbeatRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.HeartbeatRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeHeartbeatRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.HeartbeatRequest{}, err
+	}
+
+	return generated_kafkaapi.HeartbeatRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeJoinGroupRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.JoinGroupRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("JoinGroupRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.JoinGroupRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeJoinGroupRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.JoinGroupRequest{}, err
+	}
+
+	return generated_kafkaapi.JoinGroupRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
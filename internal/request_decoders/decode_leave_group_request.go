@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeLeaveGroupRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.LeaveGroupRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("LeaveGroupRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.LeaveGroupRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeLeaveGroupRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.LeaveGroupRequest{}, err
+	}
+
+	return generated_kafkaapi.LeaveGroupRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeListOffsetsRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.ListOffsetsRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("ListOffsetsRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.ListOffsetsRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeListOffsetsRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.ListOffsetsRequest{}, err
+	}
+
+	return generated_kafkaapi.ListOffsetsRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
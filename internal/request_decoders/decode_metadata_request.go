@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeMetadataRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.MetadataRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("MetadataRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.MetadataRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeMetadataRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.MetadataRequest{}, err
+	}
+
+	return generated_kafkaapi.MetadataRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeOffsetCommitRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.OffsetCommitRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("OffsetCommitRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.OffsetCommitRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeOffsetCommitRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.OffsetCommitRequest{}, err
+	}
+
+	return generated_kafkaapi.OffsetCommitRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
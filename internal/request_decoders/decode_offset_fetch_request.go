@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeOffsetFetchRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.OffsetFetchRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("OffsetFetchRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.OffsetFetchRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeOffsetFetchRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.OffsetFetchRequest{}, err
+	}
+
+	return generated_kafkaapi.OffsetFetchRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
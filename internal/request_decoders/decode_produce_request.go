@@ -0,0 +1,177 @@
+package request_decoders
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func DecodeProduceRequest(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("ProduceRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return kafkaapi.ProduceRequest{}, err
+	}
+
+	body, err := decodeProduceRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return kafkaapi.ProduceRequest{}, err
+	}
+
+	return kafkaapi.ProduceRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeProduceRequestBody(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.ProduceRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := utils.IsFlexibleVersion(0, version)
+
+	transactionalId, err := readTransactionalId(decoder, isFlexible)
+	if err != nil {
+		return kafkaapi.ProduceRequestBody{}, err
+	}
+
+	acks, err := decoder.ReadInt16Field("Acks")
+	if err != nil {
+		return kafkaapi.ProduceRequestBody{}, err
+	}
+
+	timeoutMs, err := decoder.ReadInt32Field("TimeoutMS")
+	if err != nil {
+		return kafkaapi.ProduceRequestBody{}, err
+	}
+
+	topics, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceRequestTopicData, field_decoder.FieldDecoderError) {
+		return decodeProduceRequestTopicData(decoder, isFlexible)
+	}, "Topics", isFlexible)
+	if err != nil {
+		return kafkaapi.ProduceRequestBody{}, err
+	}
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
+		return kafkaapi.ProduceRequestBody{}, err
+	}
+
+	return kafkaapi.ProduceRequestBody{
+		TransactionalId: transactionalId,
+		Acks:            value.MustBeInt16(acks.Value),
+		TimeoutMs:       value.MustBeInt32(timeoutMs.Value),
+		Topics:          topics,
+	}, nil
+}
+
+func readTransactionalId(decoder *field_decoder.FieldDecoder, isFlexible bool) (value.CompactNullableString, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		transactionalId, err := decoder.ReadCompactNullableStringField("TransactionalID")
+		if err != nil {
+			return value.CompactNullableString{}, err
+		}
+
+		return value.MustBeCompactNullableString(transactionalId.Value), nil
+	}
+
+	transactionalId, err := decoder.ReadNullableStringField("TransactionalID")
+	if err != nil {
+		return value.CompactNullableString{}, err
+	}
+
+	return value.CompactNullableString{Value: value.MustBeNullableString(transactionalId.Value).Value}, nil
+}
+
+func decodeProduceRequestTopicData(decoder *field_decoder.FieldDecoder, isFlexible bool) (kafkaapi.ProduceRequestTopicData, field_decoder.FieldDecoderError) {
+	name, err := readStringForVersion(decoder, "Name", isFlexible)
+	if err != nil {
+		return kafkaapi.ProduceRequestTopicData{}, err
+	}
+
+	partitions, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceRequestPartitionData, field_decoder.FieldDecoderError) {
+		return decodeProduceRequestPartitionData(decoder, isFlexible)
+	}, "Partitions", isFlexible)
+	if err != nil {
+		return kafkaapi.ProduceRequestTopicData{}, err
+	}
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
+		return kafkaapi.ProduceRequestTopicData{}, err
+	}
+
+	return kafkaapi.ProduceRequestTopicData{
+		Name:       name,
+		Partitions: partitions,
+	}, nil
+}
+
+func decodeProduceRequestPartitionData(decoder *field_decoder.FieldDecoder, isFlexible bool) (kafkaapi.ProduceRequestPartitionData, field_decoder.FieldDecoderError) {
+	id, err := decoder.ReadInt32Field("Id")
+	if err != nil {
+		return kafkaapi.ProduceRequestPartitionData{}, err
+	}
+
+	recordBatchesSize, recordBatchesSizeValue, err := readRecordBatchesSize(decoder, isFlexible)
+	if err != nil {
+		return kafkaapi.ProduceRequestPartitionData{}, err
+	}
+
+	if decoder.RemainingBytesCount() < recordBatchesSizeValue {
+		return kafkaapi.ProduceRequestPartitionData{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("RecordBatch byte count was decoded as %d bytes, got %d bytes remaining", recordBatchesSizeValue, decoder.RemainingBytesCount()),
+			recordBatchesSize,
+		)
+	}
+
+	recordBatchesEndOffset := decoder.ReadBytesCount() + recordBatchesSizeValue
+	recordBatches := kafkaapi.RecordBatches{}
+
+	for decoder.ReadBytesCount() < recordBatchesEndOffset {
+		recordBatch, err := response_decoders.DecodeCompactRecordBatch(decoder, "RecordBatch")
+		if err != nil {
+			return kafkaapi.ProduceRequestPartitionData{}, err
+		}
+
+		recordBatches = append(recordBatches, recordBatch)
+	}
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
+		return kafkaapi.ProduceRequestPartitionData{}, err
+	}
+
+	return kafkaapi.ProduceRequestPartitionData{
+		Id:            value.MustBeInt32(id.Value),
+		RecordBatches: recordBatches,
+	}, nil
+}
+
+// readRecordBatchesSize reads the size of the records field, a COMPACT_RECORDS size in flexible versions and an INT32 otherwise
+func readRecordBatchesSize(decoder *field_decoder.FieldDecoder, isFlexible bool) (field.Field, uint64, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		recordBatchesSize, err := decoder.ReadCompactRecordSizeField("RecordBatchesSize")
+		if err != nil {
+			return field.Field{}, 0, err
+		}
+
+		return recordBatchesSize, value.MustBeCompactRecordSize(recordBatchesSize.Value).ActualSize(), nil
+	}
+
+	recordBatchesSize, err := decoder.ReadInt32Field("RecordBatchesSize")
+	if err != nil {
+		return field.Field{}, 0, err
+	}
+
+	recordBatchesSizeAsInt32 := value.MustBeInt32(recordBatchesSize.Value).Value
+	if recordBatchesSizeAsInt32 < 0 {
+		return field.Field{}, 0, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected RecordBatchesSize to be non-negative, got %d", recordBatchesSizeAsInt32),
+			recordBatchesSize,
+		)
+	}
+
+	return recordBatchesSize, uint64(recordBatchesSizeAsInt32), nil
+}
@@ -0,0 +1,48 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// DecodeSaslAuthenticateRequest only decodes v2 requests, the only version that the tester sends
+func DecodeSaslAuthenticateRequest(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslAuthenticateRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("SaslAuthenticateRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return kafkaapi.SaslAuthenticateRequest{}, err
+	}
+
+	body, err := decodeSaslAuthenticateRequestBody(decoder)
+	if err != nil {
+		return kafkaapi.SaslAuthenticateRequest{}, err
+	}
+
+	body.Version = header.ApiVersion
+
+	return kafkaapi.SaslAuthenticateRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeSaslAuthenticateRequestBody(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslAuthenticateRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+
+	authBytes, err := decoder.ReadCompactBytesField("AuthBytes")
+	if err != nil {
+		return kafkaapi.SaslAuthenticateRequestBody{}, err
+	}
+
+	if err := decoder.ConsumeTagBufferField(); err != nil {
+		return kafkaapi.SaslAuthenticateRequestBody{}, err
+	}
+
+	return kafkaapi.SaslAuthenticateRequestBody{
+		AuthBytes: value.MustBeCompactBytes(authBytes.Value),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func DecodeSaslHandshakeRequest(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslHandshakeRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("SaslHandshakeRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return kafkaapi.SaslHandshakeRequest{}, err
+	}
+
+	// Versions 0 and 1 have the same body, v1 only changes how SaslAuthenticate bytes are sent afterwards
+	body, err := decodeSaslHandshakeRequestBody(decoder)
+	if err != nil {
+		return kafkaapi.SaslHandshakeRequest{}, err
+	}
+
+	body.Version = header.ApiVersion
+
+	return kafkaapi.SaslHandshakeRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeSaslHandshakeRequestBody(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslHandshakeRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+
+	mechanism, err := decoder.ReadStringField("Mechanism")
+	if err != nil {
+		return kafkaapi.SaslHandshakeRequestBody{}, err
+	}
+
+	return kafkaapi.SaslHandshakeRequestBody{
+		Mechanism: value.MustBeString(mechanism.Value),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package request_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+)
+
+func DecodeSyncGroupRequest(decoder *field_decoder.FieldDecoder) (generated_kafkaapi.SyncGroupRequest, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("SyncGroupRequest")
+	defer decoder.PopPathContext()
+
+	header, err := decodeHeader(decoder)
+	if err != nil {
+		return generated_kafkaapi.SyncGroupRequest{}, err
+	}
+
+	body, err := generated_kafkaapi.DecodeSyncGroupRequestBody(decoder, header.ApiVersion.Value)
+	if err != nil {
+		return generated_kafkaapi.SyncGroupRequest{}, err
+	}
+
+	return generated_kafkaapi.SyncGroupRequest{
+		Header: header,
+		Body:   body,
+	}, nil
+}
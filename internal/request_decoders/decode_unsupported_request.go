@@ -0,0 +1,17 @@
+package request_decoders
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+)
+
+// DecodeRequestHeaderOnly decodes the fields common to all request header versions. It's used for requests
+// whose body we can't decode (for eg. unknown API keys or unsupported versions).
+func DecodeRequestHeaderOnly(decoder *field_decoder.FieldDecoder, apiName string) (headers.RequestHeader, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext(fmt.Sprintf("%sRequest", apiName))
+	defer decoder.PopPathContext()
+
+	return decodeV1Header(decoder)
+}
@@ -0,0 +1,199 @@
+package request_decoders
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeEncodedApiVersionsRequest(t *testing.T) {
+	request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(7).Build()
+	encodedBytes := request_encoders.Encode(request, logger.GetQuietLogger(""))
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedRequest, err := DecodeApiVersionsRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, request.Header, decodedRequest.Header)
+	assert.Equal(t, request.Body, decodedRequest.Body)
+	assert.Equal(t, "ApiVersionsRequest.Body.ClientSoftwareName", decoder.DecodedFields()[4].Path.String())
+}
+
+func TestDecodeEncodedApiVersionsRequestWithTaggedFields(t *testing.T) {
+	request := builder.NewApiVersionsRequestBuilder().
+		WithCorrelationId(7).
+		WithHeaderTaggedFields(value.TaggedFields{Fields: []value.TaggedField{{Tag: 3, Data: []byte{1, 2}}}}).
+		WithBodyTaggedFields(value.TaggedFields{Fields: []value.TaggedField{{Tag: 0, Data: []byte{}}, {Tag: 300, Data: []byte("abc")}}}).
+		Build()
+	encodedBytes := request_encoders.Encode(request, logger.GetQuietLogger(""))
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedRequest, err := DecodeApiVersionsRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, request.Header, decodedRequest.Header)
+	assert.Equal(t, request.Body.TaggedFields, decodedRequest.Body.TaggedFields)
+	assert.Equal(t, "ApiVersionsRequest.Header.TAG_BUFFER.UnknownTag(3)", decoder.DecodedFields()[4].Path.String())
+}
+
+func TestDecodeEncodedFetchRequest(t *testing.T) {
+	request := builder.NewFetchRequestBuilder().
+		WithCorrelationId(11).
+		WithTopicUUID("71a59a51-8968-4f8b-937e-000000000001").
+		WithPartitionID(1).
+		Build()
+	encodedBytes := request_encoders.Encode(request, logger.GetQuietLogger(""))
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedRequest, err := DecodeFetchRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, request.Header, decodedRequest.Header)
+	assert.Equal(t, request.Body.Topics, decodedRequest.Body.Topics)
+}
+
+func TestDecodeEncodedFetchRequestV4(t *testing.T) {
+	request := builder.NewFetchRequestBuilder().
+		WithApiVersion(4).
+		WithCorrelationId(11).
+		WithTopicName("foo").
+		WithPartitionID(1).
+		Build()
+	encodedBytes := request_encoders.Encode(request, logger.GetQuietLogger(""))
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedRequest, err := DecodeFetchRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, request.Header, decodedRequest.Header)
+	assert.Equal(t, "foo", decodedRequest.Body.Topics[0].Name.Value)
+	assert.Equal(t, int32(1), decodedRequest.Body.Topics[0].Partitions[0].ID.Value)
+}
+
+func TestDecodeEncodedProduceRequestV3(t *testing.T) {
+	request := builder.NewProduceRequestBuilder().
+		WithApiVersion(3).
+		WithCorrelationId(13).
+		WithTopicRequestData([]builder.ProduceRequestTopicData{
+			{
+				TopicName: "foo",
+				PartitionsCreationData: []builder.ProduceRequestPartitionData{
+					{PartitionId: 0, Logs: []string{"bar"}},
+				},
+			},
+		}).
+		Build()
+	encodedBytes := request_encoders.Encode(request, logger.GetQuietLogger(""))
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedRequest, err := DecodeProduceRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, request.Header, decodedRequest.Header)
+	assert.Equal(t, "foo", decodedRequest.Body.Topics[0].Name.Value)
+	assert.Equal(t, 1, len(decodedRequest.Body.Topics[0].Partitions[0].RecordBatches))
+}
+
+func TestDecodeEncodedProduceRequestWithRecordHeaders(t *testing.T) {
+	headers := []kafkaapi.RecordHeader{
+		{Key: value.RawBytes{Value: []byte("trace-id")}, Value: value.RawBytes{Value: []byte("abc")}},
+		{Key: value.RawBytes{Value: []byte("empty")}, Value: value.NewEmptyRawBytes()},
+		{Key: value.RawBytes{Value: []byte("null")}},
+	}
+
+	request := builder.NewProduceRequestBuilder().
+		WithCorrelationId(13).
+		WithTopicRequestData([]builder.ProduceRequestTopicData{
+			{
+				TopicName: "foo",
+				PartitionsCreationData: []builder.ProduceRequestPartitionData{
+					{PartitionId: 0, Logs: []string{"bar", "baz"}, Headers: headers},
+				},
+			},
+		}).
+		Build()
+	encodedBytes := request_encoders.Encode(request, logger.GetQuietLogger(""))
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedRequest, err := DecodeProduceRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+
+	for _, record := range decodedRequest.Body.Topics[0].Partitions[0].RecordBatches[0].Records {
+		assert.Equal(t, headers, record.Headers)
+	}
+}
+
+func TestDecodeEncodedProduceRequestWithCompressedRecords(t *testing.T) {
+	for _, codec := range []compression.Codec{compression.Gzip, compression.Snappy, compression.LZ4, compression.Zstd} {
+		request := builder.NewProduceRequestBuilder().
+			WithCorrelationId(14).
+			WithCompressionCodec(codec).
+			WithTopicRequestData([]builder.ProduceRequestTopicData{
+				{
+					TopicName: "foo",
+					PartitionsCreationData: []builder.ProduceRequestPartitionData{
+						{PartitionId: 0, Logs: []string{"bar", "baz"}},
+					},
+				},
+			}).
+			Build()
+		encodedBytes := request_encoders.Encode(request, logger.GetQuietLogger(""))
+
+		decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+		decodedRequest, err := DecodeProduceRequest(decoder)
+
+		assert.Nil(t, err, codec.String())
+		assert.Equal(t, uint64(0), decoder.RemainingBytesCount(), codec.String())
+
+		decodedRecordBatch := decodedRequest.Body.Topics[0].Partitions[0].RecordBatches[0]
+		assert.Equal(t, codec, decodedRecordBatch.GetCompressionCodec())
+		assert.Equal(t, "bar", string(decodedRecordBatch.Records[0].Value.Value), codec.String())
+		assert.Equal(t, "baz", string(decodedRecordBatch.Records[1].Value.Value), codec.String())
+
+		// Decompressed records show up in the field tree like uncompressed ones
+		assert.True(t, slices.ContainsFunc(decoder.DecodedFields(), func(decodedField field.Field) bool {
+			return strings.HasSuffix(decodedField.Path.String(), ".RecordBatch.Records.Records[1].Record.Value")
+		}), codec.String())
+	}
+}
+
+func TestDecodeEncodedSaslRequests(t *testing.T) {
+	handshakeRequest := builder.NewSaslHandshakeRequestBuilder().WithCorrelationId(7).WithMechanism("PLAIN").Build()
+	encodedBytes := request_encoders.Encode(handshakeRequest, logger.GetQuietLogger(""))
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedHandshakeRequest, err := DecodeSaslHandshakeRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, handshakeRequest.Header, decodedHandshakeRequest.Header)
+	assert.Equal(t, handshakeRequest.Body, decodedHandshakeRequest.Body)
+
+	authenticateRequest := builder.NewSaslAuthenticateRequestBuilder().WithCorrelationId(8).WithAuthBytes([]byte("\x00user\x00password")).Build()
+	encodedBytes = request_encoders.Encode(authenticateRequest, logger.GetQuietLogger(""))
+
+	decoder = field_decoder.NewFieldDecoder(encodedBytes[4:])
+	decodedAuthenticateRequest, err := DecodeSaslAuthenticateRequest(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, authenticateRequest.Header, decodedAuthenticateRequest.Header)
+	assert.Equal(t, authenticateRequest.Body, decodedAuthenticateRequest.Body)
+}
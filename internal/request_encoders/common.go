@@ -25,15 +25,23 @@ func Encode(request kafka_interface.RequestI, logger *logger.Logger) []byte {
 	encodeHeader(request.GetHeader(), requestEncoder)
 
 	// Encode the request body using the field encoder
+	apiVersion := requestHeader.ApiVersion.Value
+
 	switch req := request.(type) {
 	case kafkaapi.ApiVersionsRequest:
 		encodeApiVersionsRequestBody(req.Body, requestEncoder)
 	case kafkaapi.DescribeTopicPartitionsRequest:
 		encodeDescribeTopicPartitionsRequestBody(req.Body, requestEncoder)
 	case kafkaapi.FetchRequest:
-		encodeFetchRequestBody(req.Body, requestEncoder)
+		encodeFetchRequestBody(req.Body, apiVersion, requestEncoder)
 	case kafkaapi.ProduceRequest:
-		encodeProduceRequestBody(req.Body, requestEncoder)
+		encodeProduceRequestBody(req.Body, apiVersion, requestEncoder)
+	case kafkaapi.SaslHandshakeRequest:
+		encodeSaslHandshakeRequestBody(req.Body, requestEncoder)
+	case kafkaapi.SaslAuthenticateRequest:
+		encodeSaslAuthenticateRequestBody(req.Body, requestEncoder)
+	case generatedRequest:
+		req.EncodeBody(requestEncoder)
 	default:
 		panic(fmt.Sprintf("Codecrafters Internal Error - Body encoder not implemented for %s request", apiName))
 	}
@@ -43,6 +51,11 @@ func Encode(request kafka_interface.RequestI, logger *logger.Logger) []byte {
 	return protocol_encoder.PackEncodedBytesAsMessage(requestEncoder.Bytes())
 }
 
+// generatedRequest is implemented by requests in protocol/generated_kafkaapi, they encode their own body
+type generatedRequest interface {
+	EncodeBody(encoder *field_encoder.FieldEncoder)
+}
+
 func encodeHeader(header headers.RequestHeader, encoder *field_encoder.FieldEncoder) {
 	encoder.PushPathContext("Header")
 	defer encoder.PopPathContext()
@@ -50,7 +63,12 @@ func encodeHeader(header headers.RequestHeader, encoder *field_encoder.FieldEnco
 	encoder.WriteInt16Field("APIVersion", header.ApiVersion)
 	encoder.WriteInt32Field("CorrelationID", header.CorrelationId)
 	encoder.WriteStringField("ClientID", header.ClientId)
-	encoder.WriteEmptyTagBuffer()
+
+	if utils.UsesFlexibleRequestHeader(header.ApiKey.Value, header.ApiVersion.Value) {
+		encoder.WriteTaggedFieldsField(header.TaggedFields)
+	} else if len(header.TaggedFields.Fields) > 0 {
+		panic(fmt.Sprintf("Codecrafters Internal Error - %s v%d requests don't use a flexible header, tagged fields can't be encoded", utils.APIKeyToName(header.ApiKey.Value), header.ApiVersion.Value))
+	}
 }
 
 func printEncodedTree(encoder *field_encoder.FieldEncoder, logger *logger.Logger) {
@@ -90,3 +108,28 @@ func encodeArray[T any](array []T, encoder *field_encoder.FieldEncoder, path str
 		encoder.PopPathContext()
 	}
 }
+
+// encodeArrayForVersion encodes a compact array in flexible versions, and an array with an INT32 length otherwise
+func encodeArrayForVersion[T any](array []T, encoder *field_encoder.FieldEncoder, path string, isFlexible bool, encodeFunc func(T, *field_encoder.FieldEncoder)) {
+	if isFlexible {
+		encodeCompactArray(array, encoder, path, encodeFunc)
+	} else {
+		encodeArray(array, encoder, path, encodeFunc)
+	}
+}
+
+// writeStringForVersion writes a COMPACT_STRING in flexible versions, and a STRING otherwise
+func writeStringForVersion(encoder *field_encoder.FieldEncoder, variableName string, stringValue value.CompactString, isFlexible bool) {
+	if isFlexible {
+		encoder.WriteCompactStringField(variableName, stringValue)
+	} else {
+		encoder.WriteStringField(variableName, value.String{Value: stringValue.Value})
+	}
+}
+
+// writeTagBufferForVersion writes an empty tag buffer, tag buffers are only present in flexible versions
+func writeTagBufferForVersion(encoder *field_encoder.FieldEncoder, isFlexible bool) {
+	if isFlexible {
+		encoder.WriteEmptyTagBuffer()
+	}
+}
@@ -3,58 +3,102 @@ package request_encoders
 import (
 	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
 
-func encodeFetchRequestBody(requestBody kafkaapi.FetchRequestBody, encoder *field_encoder.FieldEncoder) {
+func encodeFetchRequestBody(requestBody kafkaapi.FetchRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
 	encoder.PushPathContext("Body")
 	defer encoder.PopPathContext()
 
+	isFlexible := utils.IsFlexibleVersion(1, version)
+
+	if version <= 14 {
+		encoder.WriteInt32Field("ReplicaID", requestBody.ReplicaId)
+	}
+
 	encoder.WriteInt32Field("MaxWaitMS", requestBody.MaxWaitMS)
 	encoder.WriteInt32Field("MinBytes", requestBody.MinBytes)
 	encoder.WriteInt32Field("MaxBytes", requestBody.MaxBytes)
 	encoder.WriteInt8Field("IsolationLevel", requestBody.IsolationLevel)
-	encoder.WriteInt32Field("SessionID", requestBody.SessionId)
-	encoder.WriteInt32Field("SessionEpoch", requestBody.SessionEpoch)
+
+	if version >= 7 {
+		encoder.WriteInt32Field("SessionID", requestBody.SessionId)
+		encoder.WriteInt32Field("SessionEpoch", requestBody.SessionEpoch)
+	}
 
 	// Encode topics and forgotten topics
-	encodeCompactArray(requestBody.Topics, encoder, "Topics", encodeFetchRequestTopic)
-	encodeCompactArray(requestBody.ForgottenTopics, encoder, "ForgottenTopics", encodeForgottenTopic)
+	encodeArrayForVersion(requestBody.Topics, encoder, "Topics", isFlexible, func(topic kafkaapi.Topic, encoder *field_encoder.FieldEncoder) {
+		encodeFetchRequestTopic(topic, version, encoder)
+	})
+
+	if version >= 7 {
+		encodeArrayForVersion(requestBody.ForgottenTopics, encoder, "ForgottenTopics", isFlexible, func(forgottenTopic kafkaapi.ForgottenTopic, encoder *field_encoder.FieldEncoder) {
+			encodeForgottenTopic(forgottenTopic, version, encoder)
+		})
+	}
 
-	encoder.WriteCompactStringField("RackID", requestBody.RackId)
-	encoder.WriteEmptyTagBuffer()
+	if version >= 11 {
+		writeStringForVersion(encoder, "RackID", requestBody.RackId, isFlexible)
+	}
+
+	writeTagBufferForVersion(encoder, isFlexible)
 }
 
-func encodeFetchRequestTopic(topic kafkaapi.Topic, encoder *field_encoder.FieldEncoder) {
+func encodeFetchRequestTopic(topic kafkaapi.Topic, version int16, encoder *field_encoder.FieldEncoder) {
 	encoder.PushPathContext("Topic")
 	defer encoder.PopPathContext()
 
-	encoder.WriteUUIDField("UUID", topic.UUID)
+	isFlexible := utils.IsFlexibleVersion(1, version)
 
-	encodeCompactArray(topic.Partitions, encoder, "Partitions", encodeTopicPartition)
+	if version >= 13 {
+		encoder.WriteUUIDField("UUID", topic.UUID)
+	} else {
+		writeStringForVersion(encoder, "Name", topic.Name, isFlexible)
+	}
 
-	encoder.WriteEmptyTagBuffer()
+	encodeArrayForVersion(topic.Partitions, encoder, "Partitions", isFlexible, func(partition kafkaapi.Partition, encoder *field_encoder.FieldEncoder) {
+		encodeTopicPartition(partition, version, encoder)
+	})
+
+	writeTagBufferForVersion(encoder, isFlexible)
 }
 
-func encodeTopicPartition(partition kafkaapi.Partition, encoder *field_encoder.FieldEncoder) {
+func encodeTopicPartition(partition kafkaapi.Partition, version int16, encoder *field_encoder.FieldEncoder) {
 	encoder.PushPathContext("Partition")
 	defer encoder.PopPathContext()
 
 	encoder.WriteInt32Field("ID", partition.ID)
-	encoder.WriteInt32Field("CurrentLeaderEpoch", partition.CurrentLeaderEpoch)
+
+	if version >= 9 {
+		encoder.WriteInt32Field("CurrentLeaderEpoch", partition.CurrentLeaderEpoch)
+	}
+
 	encoder.WriteInt64Field("FetchOffset", partition.FetchOffset)
-	encoder.WriteInt32Field("LastFetchedOffset", partition.LastFetchedOffset)
-	encoder.WriteInt64Field("LogStartOffset", partition.LogStartOffset)
+
+	if version >= 12 {
+		encoder.WriteInt32Field("LastFetchedOffset", partition.LastFetchedOffset)
+	}
+
+	if version >= 5 {
+		encoder.WriteInt64Field("LogStartOffset", partition.LogStartOffset)
+	}
+
 	encoder.WriteInt32Field("PartitionMaxBytes", partition.PartitionMaxBytes)
-	encoder.WriteEmptyTagBuffer()
+	writeTagBufferForVersion(encoder, utils.IsFlexibleVersion(1, version))
 }
 
-func encodeForgottenTopic(forgottenTopic kafkaapi.ForgottenTopic, encoder *field_encoder.FieldEncoder) {
+func encodeForgottenTopic(forgottenTopic kafkaapi.ForgottenTopic, version int16, encoder *field_encoder.FieldEncoder) {
 	encoder.PushPathContext("ForgottenTopic")
 	defer encoder.PopPathContext()
 
-	// Encode UUID
-	encoder.WriteUUIDField("UUID", forgottenTopic.UUID)
+	isFlexible := utils.IsFlexibleVersion(1, version)
+
+	if version >= 13 {
+		encoder.WriteUUIDField("UUID", forgottenTopic.UUID)
+	} else {
+		writeStringForVersion(encoder, "Name", forgottenTopic.Name, isFlexible)
+	}
 
 	// Encode partitionIds
 	partitionIdsKafkaValue := make([]value.KafkaProtocolValue, len(forgottenTopic.PartitionIds))
@@ -63,5 +107,11 @@ func encodeForgottenTopic(forgottenTopic kafkaapi.ForgottenTopic, encoder *field
 		partitionIdsKafkaValue[i] = partitionId
 	}
 
-	encoder.WriteCompactArrayOfValuesField("Partitions", partitionIdsKafkaValue)
+	if isFlexible {
+		encoder.WriteCompactArrayOfValuesField("Partitions", partitionIdsKafkaValue)
+	} else {
+		encoder.WriteArrayOfValuesField("Partitions", partitionIdsKafkaValue)
+	}
+
+	writeTagBufferForVersion(encoder, isFlexible)
 }
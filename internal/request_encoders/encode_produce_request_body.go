@@ -1,39 +1,59 @@
 package request_encoders
 
 import (
+	"fmt"
+
 	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
 	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
 
-func encodeProduceRequestBody(requestBody kafkaapi.ProduceRequestBody, encoder *field_encoder.FieldEncoder) {
-	encoder.WriteCompactNullableStringField("TransactionalID", requestBody.TransactionalId)
+func encodeProduceRequestBody(requestBody kafkaapi.ProduceRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := utils.IsFlexibleVersion(0, version)
+
+	if isFlexible {
+		encoder.WriteCompactNullableStringField("TransactionalID", requestBody.TransactionalId)
+	} else {
+		encoder.WriteNullableStringField("TransactionalID", value.NullableString{Value: requestBody.TransactionalId.Value})
+	}
+
 	encoder.WriteInt16Field("Acks", requestBody.Acks)
 	encoder.WriteInt32Field("TimeoutMS", requestBody.TimeoutMs)
-	encodeCompactArray(requestBody.Topics, encoder, "Topics", encodeProduceRequestTopicData)
-	encoder.WriteEmptyTagBuffer()
+	encodeArrayForVersion(requestBody.Topics, encoder, "Topics", isFlexible, func(topicData kafkaapi.ProduceRequestTopicData, encoder *field_encoder.FieldEncoder) {
+		encodeProduceRequestTopicData(topicData, isFlexible, encoder)
+	})
+	writeTagBufferForVersion(encoder, isFlexible)
 }
 
-func encodeProduceRequestTopicData(topicData kafkaapi.ProduceRequestTopicData, encoder *field_encoder.FieldEncoder) {
-	encoder.WriteCompactStringField("Name", topicData.Name)
-	encodeCompactArray(topicData.Partitions, encoder, "Partitions", encodeProduceRequestPartitionData)
-	encoder.WriteEmptyTagBuffer()
+func encodeProduceRequestTopicData(topicData kafkaapi.ProduceRequestTopicData, isFlexible bool, encoder *field_encoder.FieldEncoder) {
+	writeStringForVersion(encoder, "Name", topicData.Name, isFlexible)
+	encodeArrayForVersion(topicData.Partitions, encoder, "Partitions", isFlexible, func(partitionData kafkaapi.ProduceRequestPartitionData, encoder *field_encoder.FieldEncoder) {
+		encodeProduceRequestPartitionData(partitionData, isFlexible, encoder)
+	})
+	writeTagBufferForVersion(encoder, isFlexible)
 }
 
-func encodeProduceRequestPartitionData(partitionData kafkaapi.ProduceRequestPartitionData, fieldEncoder *field_encoder.FieldEncoder) {
+func encodeProduceRequestPartitionData(partitionData kafkaapi.ProduceRequestPartitionData, isFlexible bool, fieldEncoder *field_encoder.FieldEncoder) {
 	fieldEncoder.WriteInt32Field("Id", partitionData.Id)
 
 	encoder := encoder.NewEncoder()
 	partitionData.RecordBatches.Encode(encoder)
 	recordBatchesTotalSize := len(encoder.Bytes())
-	fieldEncoder.WriteUvarint("RecordBatchesSize", value.UnsignedVarint{Value: uint64(recordBatchesTotalSize) + 1})
+
+	if isFlexible {
+		fieldEncoder.WriteUvarint("RecordBatchesSize", value.UnsignedVarint{Value: uint64(recordBatchesTotalSize) + 1})
+	} else {
+		fieldEncoder.WriteInt32Field("RecordBatchesSize", value.Int32{Value: int32(recordBatchesTotalSize)})
+	}
 
 	for _, recordBatch := range partitionData.RecordBatches {
 		encodeProduceRequestRecordBatch(recordBatch, fieldEncoder)
 	}
 
-	fieldEncoder.WriteEmptyTagBuffer()
+	writeTagBufferForVersion(fieldEncoder, isFlexible)
 }
 
 func encodeProduceRequestRecordBatch(recordBatch kafkaapi.RecordBatch, encoder *field_encoder.FieldEncoder) {
@@ -58,7 +78,20 @@ func encodeProduceRequestRecordBatch(recordBatch kafkaapi.RecordBatch, encoder *
 	encoder.WriteInt64Field("ProducerId", recordBatch.ProducerId)
 	encoder.WriteInt16Field("ProducerEpoch", recordBatch.ProducerEpoch)
 	encoder.WriteInt32Field("BaseSequence", recordBatch.BaseSequence)
-	encodeArray(recordBatch.Records, encoder, "Records", encodeProduceRequestRecord)
+
+	if recordBatch.GetCompressionCodec() == compression.None {
+		encodeArray(recordBatch.Records, encoder, "Records", encodeProduceRequestRecord)
+	} else {
+		encodeProduceRequestCompressedRecords(recordBatch, encoder)
+	}
+}
+
+func encodeProduceRequestCompressedRecords(recordBatch kafkaapi.RecordBatch, encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Records")
+	defer encoder.PopPathContext()
+
+	encoder.WriteInt32Field("Length", value.Int32{Value: int32(len(recordBatch.Records))})
+	encoder.WriteRawBytes("Compressed", value.RawBytes{Value: recordBatch.GetCompressedRecords()})
 }
 
 func encodeProduceRequestRecord(record kafkaapi.Record, encoder *field_encoder.FieldEncoder) {
@@ -88,13 +121,34 @@ func encodeProduceRequestRecord(record kafkaapi.Record, encoder *field_encoder.F
 		encoder.WriteRawBytes("Value", record.Value)
 	}
 
-	if len(record.Headers) > 0 {
-		panic("Codecrafters Internal Error - Record Headers in Produce Request is neither empty nor nil")
-	}
-
 	if record.Headers == nil {
 		encoder.WriteVarint("HeadersLength", value.Varint{Value: -1})
 	} else {
-		encoder.WriteVarint("HeadersLength", value.Varint{Value: 0})
+		encoder.WriteVarint("HeadersLength", value.Varint{Value: int64(len(record.Headers))})
+	}
+
+	for i, header := range record.Headers {
+		encoder.PushPathContext(fmt.Sprintf("Headers[%d]", i))
+		encodeProduceRequestRecordHeader(header, encoder)
+		encoder.PopPathContext()
+	}
+}
+
+func encodeProduceRequestRecordHeader(header kafkaapi.RecordHeader, encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("RecordHeader")
+	defer encoder.PopPathContext()
+
+	if header.Key.Value == nil {
+		encoder.WriteVarint("KeyLength", value.Varint{Value: -1})
+	} else {
+		encoder.WriteVarint("KeyLength", value.Varint{Value: int64(len(header.Key.Value))})
+		encoder.WriteRawBytes("Key", header.Key)
+	}
+
+	if header.Value.Value == nil {
+		encoder.WriteVarint("ValueLength", value.Varint{Value: -1})
+	} else {
+		encoder.WriteVarint("ValueLength", value.Varint{Value: int64(len(header.Value.Value))})
+		encoder.WriteRawBytes("Value", header.Value)
 	}
 }
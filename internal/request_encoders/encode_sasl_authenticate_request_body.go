@@ -0,0 +1,19 @@
+package request_encoders
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+)
+
+func encodeSaslAuthenticateRequestBody(requestBody kafkaapi.SaslAuthenticateRequestBody, encoder *field_encoder.FieldEncoder) {
+	if requestBody.Version.Value != 2 {
+		panic(fmt.Sprintf("CodeCrafters Internal Error: Unsupported API version: %d", requestBody.Version.Value))
+	}
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+
+	encoder.WriteCompactBytesField("AuthBytes", requestBody.AuthBytes)
+	encoder.WriteEmptyTagBuffer()
+}
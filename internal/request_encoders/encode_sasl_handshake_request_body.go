@@ -0,0 +1,18 @@
+package request_encoders
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+)
+
+func encodeSaslHandshakeRequestBody(requestBody kafkaapi.SaslHandshakeRequestBody, encoder *field_encoder.FieldEncoder) {
+	if requestBody.Version.Value != 1 {
+		panic(fmt.Sprintf("CodeCrafters Internal Error: Unsupported API version: %d", requestBody.Version.Value))
+	}
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+
+	encoder.WriteStringField("Mechanism", requestBody.Mechanism)
+}
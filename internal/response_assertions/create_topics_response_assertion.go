@@ -0,0 +1,130 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	compact_array_length_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/compact_array_length"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+// emptyTopicUUID is returned as the TopicId of topics that weren't created
+const emptyTopicUUID = "00000000-0000-0000-0000-000000000000"
+
+type ExpectedCreateTopicsResponseTopic struct {
+	Name      string
+	ErrorCode int16
+	// NumPartitions and ReplicationFactor are only asserted if ErrorCode is 0
+	NumPartitions     int32
+	ReplicationFactor int16
+}
+
+type CreateTopicsResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedTopics        []ExpectedCreateTopicsResponseTopic
+}
+
+func NewCreateTopicsResponseAssertion() *CreateTopicsResponseAssertion {
+	return &CreateTopicsResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedTopics:        []ExpectedCreateTopicsResponseTopic{},
+	}
+}
+
+func (a *CreateTopicsResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *CreateTopicsResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *CreateTopicsResponseAssertion) ExpectTopics(expectedTopics []ExpectedCreateTopicsResponseTopic) *CreateTopicsResponseAssertion {
+	a.expectedTopics = expectedTopics
+	return a
+}
+
+func (a *CreateTopicsResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "CreateTopicsResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "CreateTopicsResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "CreateTopicsResponse.Body.Topics.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedTopics), field.Value)
+	}
+
+	// Topic level fields are validated in AssertAcrossFields, since they depend on the error code
+	topicFieldPattern := regexp.MustCompile(`^CreateTopicsResponse\.Body\.Topics\.Topics\[\d+\]\.(Name|TopicId|ErrorCode|ErrorMessage|NumPartitions|ReplicationFactor)$`)
+	if topicFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	// We don't send custom configs, so the configs in the response are the broker's defaults
+	topicConfigsPattern := regexp.MustCompile(`^CreateTopicsResponse\.Body\.Topics\.Topics\[\d+\]\.Configs\..*$`)
+	if topicConfigsPattern.MatchString(path) {
+		return nil
+	}
+
+	// TopicConfigErrorCode and any unknown tagged fields
+	tagBufferPattern := regexp.MustCompile(`^CreateTopicsResponse\.Body\.(Topics\.Topics\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *CreateTopicsResponseAssertion) AssertAcrossFields(response generated_kafkaapi.CreateTopicsResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ Topics array length: %d", len(a.expectedTopics))
+
+	for i, expectedTopic := range a.expectedTopics {
+		actualTopic := response.Body.Topics[i]
+
+		if actualTopic.Name.Value != expectedTopic.Name {
+			return fmt.Errorf("Expected Topics[%d].Name to be %q, got %q", i, expectedTopic.Name, actualTopic.Name.Value)
+		}
+
+		logger.Successf("✓ Topics[%d].Name: %s", i, expectedTopic.Name)
+
+		if actualTopic.ErrorCode.Value != expectedTopic.ErrorCode {
+			return fmt.Errorf("Expected Topics[%d].ErrorCode to be %d (%s), got %d", i, expectedTopic.ErrorCode, utils.ErrorCodeToName(expectedTopic.ErrorCode), actualTopic.ErrorCode.Value)
+		}
+
+		logger.Successf("✓ Topics[%d].ErrorCode: %d (%s)", i, expectedTopic.ErrorCode, utils.ErrorCodeToName(expectedTopic.ErrorCode))
+
+		// The remaining fields are only meaningful if the topic was created
+		if expectedTopic.ErrorCode != 0 {
+			continue
+		}
+
+		if actualTopic.TopicId.Value == emptyTopicUUID {
+			return fmt.Errorf("Expected Topics[%d].TopicId to be the ID of the created topic, got %s", i, actualTopic.TopicId.Value)
+		}
+
+		logger.Successf("✓ Topics[%d].TopicId: %s", i, actualTopic.TopicId.Value)
+
+		if actualTopic.NumPartitions.Value != expectedTopic.NumPartitions {
+			return fmt.Errorf("Expected Topics[%d].NumPartitions to be %d, got %d", i, expectedTopic.NumPartitions, actualTopic.NumPartitions.Value)
+		}
+
+		logger.Successf("✓ Topics[%d].NumPartitions: %d", i, expectedTopic.NumPartitions)
+
+		if actualTopic.ReplicationFactor.Value != expectedTopic.ReplicationFactor {
+			return fmt.Errorf("Expected Topics[%d].ReplicationFactor to be %d, got %d", i, expectedTopic.ReplicationFactor, actualTopic.ReplicationFactor.Value)
+		}
+
+		logger.Successf("✓ Topics[%d].ReplicationFactor: %d", i, expectedTopic.ReplicationFactor)
+	}
+
+	return nil
+}
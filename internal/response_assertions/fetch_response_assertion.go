@@ -22,6 +22,7 @@ type FetchResponseAssertion struct {
 	expectedThrottleTimeMs       int32
 	expectedErrorCodeInBody      int16
 	expectedTopicUUID            *string
+	expectedTopicName            *string
 	expectedTopicsLength         value.CompactArrayLength
 	expectedPartitionId          *int32
 	expectedErrorCodeInPartition *int16
@@ -65,6 +66,15 @@ func (a *FetchResponseAssertion) ExpectTopicUUID(expectedTopicUUID string) *Fetc
 	return a
 }
 
+// ExpectTopicName is used instead of ExpectTopicUUID for versions before 13, which identify topics by name
+func (a *FetchResponseAssertion) ExpectTopicName(expectedTopicName string) *FetchResponseAssertion {
+	a.expectedTopicName = &expectedTopicName
+	a.expectedTopicsLength = value.CompactArrayLength{
+		Value: 2,
+	}
+	return a
+}
+
 func (a *FetchResponseAssertion) ExpectPartitionID(expectedPartitionId int32) *FetchResponseAssertion {
 	a.expectedPartitionId = &expectedPartitionId
 	return a
@@ -84,7 +94,7 @@ func (a *FetchResponseAssertion) AssertAcrossFields(response kafkaapi.FetchRespo
 
 func (a *FetchResponseAssertion) assertTopicResponses(response kafkaapi.FetchResponse, logger *logger.Logger) error {
 	expectedTopicCount := 1
-	if a.expectedTopicUUID == nil {
+	if a.expectedTopicUUID == nil && a.expectedTopicName == nil {
 		expectedTopicCount = 0
 	}
 
@@ -98,11 +108,22 @@ func (a *FetchResponseAssertion) assertTopicResponses(response kafkaapi.FetchRes
 		actualTopic := response.Body.TopicResponses[0]
 
 		// Check topic UUID
-		actualTopicUUID := actualTopic.UUID.Value
-		if actualTopicUUID != *a.expectedTopicUUID {
-			return fmt.Errorf("Expected TopicResponse[0] TopicUUID to be %s, got %s", *a.expectedTopicUUID, actualTopicUUID)
+		if a.expectedTopicUUID != nil {
+			actualTopicUUID := actualTopic.UUID.Value
+			if actualTopicUUID != *a.expectedTopicUUID {
+				return fmt.Errorf("Expected TopicResponse[0] TopicUUID to be %s, got %s", *a.expectedTopicUUID, actualTopicUUID)
+			}
+			logger.Successf("✓ TopicResponse[0] TopicUUID: %s", actualTopicUUID)
+		}
+
+		// Check topic name
+		if a.expectedTopicName != nil {
+			actualTopicName := actualTopic.Name.Value
+			if actualTopicName != *a.expectedTopicName {
+				return fmt.Errorf("Expected TopicResponse[0] TopicName to be %s, got %s", *a.expectedTopicName, actualTopicName)
+			}
+			logger.Successf("✓ TopicResponse[0] TopicName: %s", actualTopicName)
 		}
-		logger.Successf("✓ TopicResponse[0] TopicUUID: %s", actualTopicUUID)
 
 		if err := a.assertPartitionResponses(actualTopic.PartitionResponses, logger); err != nil {
 			return err
@@ -205,6 +226,11 @@ func (a *FetchResponseAssertion) AssertSingleField(field field.Field) error {
 	}
 
 	if fieldPath == "FetchResponse.Body.Topics.Length" {
+		// Versions before 12 encode array lengths as INT32
+		if field.Value.GetType() == "INT32" {
+			return int32_assertions.IsEqualTo(int32(a.expectedTopicsLength.ActualLength()), field.Value)
+		}
+
 		return compact_array_length_assertions.IsEqualTo(a.expectedTopicsLength, field.Value)
 	}
 
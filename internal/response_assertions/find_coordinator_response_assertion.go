@@ -0,0 +1,120 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	compact_array_length_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/compact_array_length"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type ExpectedCoordinator struct {
+	Key       string
+	ErrorCode int16
+	NodeId    int32
+	// The host isn't asserted, since brokers advertise their canonical hostname when the listener doesn't specify one
+	Port int32
+}
+
+type FindCoordinatorResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedCoordinators  []ExpectedCoordinator
+}
+
+func NewFindCoordinatorResponseAssertion() *FindCoordinatorResponseAssertion {
+	return &FindCoordinatorResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedCoordinators:  []ExpectedCoordinator{},
+	}
+}
+
+func (a *FindCoordinatorResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *FindCoordinatorResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *FindCoordinatorResponseAssertion) ExpectCoordinators(expectedCoordinators []ExpectedCoordinator) *FindCoordinatorResponseAssertion {
+	a.expectedCoordinators = expectedCoordinators
+	return a
+}
+
+func (a *FindCoordinatorResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "FindCoordinatorResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "FindCoordinatorResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "FindCoordinatorResponse.Body.Coordinators.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedCoordinators), field.Value)
+	}
+
+	// Coordinators are validated in AssertAcrossFields, since they can be in any order
+	coordinatorFieldPattern := regexp.MustCompile(`^FindCoordinatorResponse\.Body\.Coordinators\.Coordinators\[\d+\]\.(Key|NodeId|Host|Port|ErrorCode|ErrorMessage)$`)
+	if coordinatorFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^FindCoordinatorResponse\.Body\.(Coordinators\.Coordinators\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *FindCoordinatorResponseAssertion) AssertAcrossFields(response generated_kafkaapi.FindCoordinatorResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ Coordinators array length: %d", len(a.expectedCoordinators))
+
+	for _, expectedCoordinator := range a.expectedCoordinators {
+		coordinatorIndex := slices.IndexFunc(response.Body.Coordinators, func(coordinator generated_kafkaapi.FindCoordinatorResponseCoordinator) bool {
+			return coordinator.Key.Value == expectedCoordinator.Key
+		})
+
+		if coordinatorIndex == -1 {
+			return fmt.Errorf("Expected coordinator for key %q to be present in Coordinators", expectedCoordinator.Key)
+		}
+
+		actualCoordinator := response.Body.Coordinators[coordinatorIndex]
+		coordinatorPath := fmt.Sprintf("Coordinators[%d]", coordinatorIndex)
+		logger.Successf("✓ %s.Key: %s", coordinatorPath, expectedCoordinator.Key)
+
+		if actualCoordinator.ErrorCode.Value != expectedCoordinator.ErrorCode {
+			return fmt.Errorf("Expected %s.ErrorCode to be %d (%s), got %d", coordinatorPath, expectedCoordinator.ErrorCode, utils.ErrorCodeToName(expectedCoordinator.ErrorCode), actualCoordinator.ErrorCode.Value)
+		}
+
+		logger.Successf("✓ %s.ErrorCode: %d (%s)", coordinatorPath, expectedCoordinator.ErrorCode, utils.ErrorCodeToName(expectedCoordinator.ErrorCode))
+
+		if actualCoordinator.NodeId.Value != expectedCoordinator.NodeId {
+			return fmt.Errorf("Expected %s.NodeId to be %d, got %d", coordinatorPath, expectedCoordinator.NodeId, actualCoordinator.NodeId.Value)
+		}
+
+		logger.Successf("✓ %s.NodeId: %d", coordinatorPath, expectedCoordinator.NodeId)
+
+		if actualCoordinator.Host.Value == "" {
+			return fmt.Errorf("Expected %s.Host to be non-empty", coordinatorPath)
+		}
+
+		logger.Successf("✓ %s.Host: %s", coordinatorPath, actualCoordinator.Host.Value)
+
+		if actualCoordinator.Port.Value != expectedCoordinator.Port {
+			return fmt.Errorf("Expected %s.Port to be %d, got %d", coordinatorPath, expectedCoordinator.Port, actualCoordinator.Port.Value)
+		}
+
+		logger.Successf("✓ %s.Port: %d", coordinatorPath, expectedCoordinator.Port)
+	}
+
+	return nil
+}
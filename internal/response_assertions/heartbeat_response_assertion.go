@@ -0,0 +1,65 @@
+package response_assertions
+
+import (
+	"regexp"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	int16_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int16"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type HeartbeatResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedErrorCode     int16
+}
+
+func NewHeartbeatResponseAssertion() *HeartbeatResponseAssertion {
+	return &HeartbeatResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedErrorCode:     0,
+	}
+}
+
+func (a *HeartbeatResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *HeartbeatResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *HeartbeatResponseAssertion) ExpectErrorCode(expectedErrorCode int16) *HeartbeatResponseAssertion {
+	a.expectedErrorCode = expectedErrorCode
+	return a
+}
+
+func (a *HeartbeatResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "HeartbeatResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "HeartbeatResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "HeartbeatResponse.Body.ErrorCode" {
+		return int16_assertions.IsEqualTo(a.expectedErrorCode, field.Value)
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^HeartbeatResponse\.Body\.TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *HeartbeatResponseAssertion) AssertAcrossFields(response generated_kafkaapi.HeartbeatResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ ErrorCode: %d (%s)", a.expectedErrorCode, utils.ErrorCodeToName(a.expectedErrorCode))
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package response_assertions
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	int16_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int16"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type ExpectedJoinGroupMember struct {
+	MemberId string
+	Metadata []byte
+}
+
+type JoinGroupResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedErrorCode     int16
+	expectedGenerationId  int32
+	// expectedMemberId is empty if the member ID is assigned in this response, it's only asserted to be non-empty then
+	expectedMemberId string
+	// The remaining fields are only asserted if expectedErrorCode is 0
+	expectedProtocolType string
+	expectedProtocolName string
+	expectedLeader       string
+	// expectedMembers is only sent to the leader, other members receive an empty array
+	expectedMembers []ExpectedJoinGroupMember
+}
+
+func NewJoinGroupResponseAssertion() *JoinGroupResponseAssertion {
+	return &JoinGroupResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedErrorCode:     0,
+		expectedGenerationId:  -1,
+		expectedMembers:       []ExpectedJoinGroupMember{},
+	}
+}
+
+func (a *JoinGroupResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *JoinGroupResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *JoinGroupResponseAssertion) ExpectErrorCode(expectedErrorCode int16) *JoinGroupResponseAssertion {
+	a.expectedErrorCode = expectedErrorCode
+	return a
+}
+
+func (a *JoinGroupResponseAssertion) ExpectGenerationId(expectedGenerationId int32) *JoinGroupResponseAssertion {
+	a.expectedGenerationId = expectedGenerationId
+	return a
+}
+
+func (a *JoinGroupResponseAssertion) ExpectMemberId(expectedMemberId string) *JoinGroupResponseAssertion {
+	a.expectedMemberId = expectedMemberId
+	return a
+}
+
+func (a *JoinGroupResponseAssertion) ExpectProtocol(expectedProtocolType string, expectedProtocolName string) *JoinGroupResponseAssertion {
+	a.expectedProtocolType = expectedProtocolType
+	a.expectedProtocolName = expectedProtocolName
+	return a
+}
+
+func (a *JoinGroupResponseAssertion) ExpectLeader(expectedLeader string) *JoinGroupResponseAssertion {
+	a.expectedLeader = expectedLeader
+	return a
+}
+
+func (a *JoinGroupResponseAssertion) ExpectMembers(expectedMembers []ExpectedJoinGroupMember) *JoinGroupResponseAssertion {
+	a.expectedMembers = expectedMembers
+	return a
+}
+
+func (a *JoinGroupResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "JoinGroupResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "JoinGroupResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "JoinGroupResponse.Body.ErrorCode" {
+		return int16_assertions.IsEqualTo(a.expectedErrorCode, field.Value)
+	}
+
+	if path == "JoinGroupResponse.Body.GenerationId" {
+		return int32_assertions.IsEqualTo(a.expectedGenerationId, field.Value)
+	}
+
+	// SkipAssignment is only set for consumer groups that use server-side assignors
+	if path == "JoinGroupResponse.Body.SkipAssignment" {
+		return nil
+	}
+
+	// These fields are validated in AssertAcrossFields, since they depend on the error code
+	bodyFieldPattern := regexp.MustCompile(`^JoinGroupResponse\.Body\.(MemberId|ProtocolType|ProtocolName|Leader|Members\..*)$`)
+	if bodyFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^JoinGroupResponse\.Body\.(Members\.Members\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *JoinGroupResponseAssertion) AssertAcrossFields(response generated_kafkaapi.JoinGroupResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ ErrorCode: %d (%s)", a.expectedErrorCode, utils.ErrorCodeToName(a.expectedErrorCode))
+	logger.Successf("✓ GenerationId: %d", a.expectedGenerationId)
+
+	if a.expectedMemberId == "" {
+		if response.Body.MemberId.Value == "" {
+			return fmt.Errorf("Expected MemberId to be assigned by the broker, got an empty string")
+		}
+	} else if response.Body.MemberId.Value != a.expectedMemberId {
+		return fmt.Errorf("Expected MemberId to be %q, got %q", a.expectedMemberId, response.Body.MemberId.Value)
+	}
+
+	logger.Successf("✓ MemberId: %s", response.Body.MemberId.Value)
+
+	// The remaining fields are only meaningful if the member joined the group
+	if a.expectedErrorCode != 0 {
+		return nil
+	}
+
+	if response.Body.ProtocolType.Value == nil || *response.Body.ProtocolType.Value != a.expectedProtocolType {
+		return fmt.Errorf("Expected ProtocolType to be %q, got %s", a.expectedProtocolType, response.Body.ProtocolType)
+	}
+
+	logger.Successf("✓ ProtocolType: %s", a.expectedProtocolType)
+
+	if response.Body.ProtocolName.Value == nil || *response.Body.ProtocolName.Value != a.expectedProtocolName {
+		return fmt.Errorf("Expected ProtocolName to be %q, got %s", a.expectedProtocolName, response.Body.ProtocolName)
+	}
+
+	logger.Successf("✓ ProtocolName: %s", a.expectedProtocolName)
+
+	if response.Body.Leader.Value != a.expectedLeader {
+		return fmt.Errorf("Expected Leader to be %q, got %q", a.expectedLeader, response.Body.Leader.Value)
+	}
+
+	logger.Successf("✓ Leader: %s", a.expectedLeader)
+
+	if len(response.Body.Members) != len(a.expectedMembers) {
+		return fmt.Errorf("Expected Members to have length %d, got %d", len(a.expectedMembers), len(response.Body.Members))
+	}
+
+	logger.Successf("✓ Members array length: %d", len(a.expectedMembers))
+
+	for _, expectedMember := range a.expectedMembers {
+		memberIndex := slices.IndexFunc(response.Body.Members, func(member generated_kafkaapi.JoinGroupResponseMember) bool {
+			return member.MemberId.Value == expectedMember.MemberId
+		})
+
+		if memberIndex == -1 {
+			return fmt.Errorf("Expected member %q to be present in Members", expectedMember.MemberId)
+		}
+
+		actualMember := response.Body.Members[memberIndex]
+		logger.Successf("✓ Members[%d].MemberId: %s", memberIndex, expectedMember.MemberId)
+
+		if !bytes.Equal(actualMember.Metadata.Value, expectedMember.Metadata) {
+			return fmt.Errorf("Expected Members[%d].Metadata to be the metadata sent by the member in its JoinGroup request, got %s", memberIndex, actualMember.Metadata)
+		}
+
+		logger.Successf("✓ Members[%d].Metadata: %s", memberIndex, actualMember.Metadata)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	compact_array_length_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/compact_array_length"
+	int16_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int16"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type ExpectedLeaveGroupMember struct {
+	MemberId  string
+	ErrorCode int16
+}
+
+type LeaveGroupResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedErrorCode     int16
+	expectedMembers       []ExpectedLeaveGroupMember
+}
+
+func NewLeaveGroupResponseAssertion() *LeaveGroupResponseAssertion {
+	return &LeaveGroupResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedErrorCode:     0,
+		expectedMembers:       []ExpectedLeaveGroupMember{},
+	}
+}
+
+func (a *LeaveGroupResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *LeaveGroupResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *LeaveGroupResponseAssertion) ExpectErrorCode(expectedErrorCode int16) *LeaveGroupResponseAssertion {
+	a.expectedErrorCode = expectedErrorCode
+	return a
+}
+
+func (a *LeaveGroupResponseAssertion) ExpectMembers(expectedMembers []ExpectedLeaveGroupMember) *LeaveGroupResponseAssertion {
+	a.expectedMembers = expectedMembers
+	return a
+}
+
+func (a *LeaveGroupResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "LeaveGroupResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "LeaveGroupResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "LeaveGroupResponse.Body.ErrorCode" {
+		return int16_assertions.IsEqualTo(a.expectedErrorCode, field.Value)
+	}
+
+	if path == "LeaveGroupResponse.Body.Members.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedMembers), field.Value)
+	}
+
+	// Members are validated in AssertAcrossFields, since they can be in any order
+	memberFieldPattern := regexp.MustCompile(`^LeaveGroupResponse\.Body\.Members\.Members\[\d+\]\.(MemberId|GroupInstanceId|ErrorCode)$`)
+	if memberFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^LeaveGroupResponse\.Body\.(Members\.Members\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *LeaveGroupResponseAssertion) AssertAcrossFields(response generated_kafkaapi.LeaveGroupResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ ErrorCode: %d (%s)", a.expectedErrorCode, utils.ErrorCodeToName(a.expectedErrorCode))
+	logger.Successf("✓ Members array length: %d", len(a.expectedMembers))
+
+	for _, expectedMember := range a.expectedMembers {
+		memberIndex := slices.IndexFunc(response.Body.Members, func(member generated_kafkaapi.LeaveGroupResponseMemberResponse) bool {
+			return member.MemberId.Value == expectedMember.MemberId
+		})
+
+		if memberIndex == -1 {
+			return fmt.Errorf("Expected member %q to be present in Members", expectedMember.MemberId)
+		}
+
+		actualMember := response.Body.Members[memberIndex]
+		logger.Successf("✓ Members[%d].MemberId: %s", memberIndex, expectedMember.MemberId)
+
+		if actualMember.ErrorCode.Value != expectedMember.ErrorCode {
+			return fmt.Errorf("Expected Members[%d].ErrorCode to be %d (%s), got %d", memberIndex, expectedMember.ErrorCode, utils.ErrorCodeToName(expectedMember.ErrorCode), actualMember.ErrorCode.Value)
+		}
+
+		logger.Successf("✓ Members[%d].ErrorCode: %d (%s)", memberIndex, expectedMember.ErrorCode, utils.ErrorCodeToName(expectedMember.ErrorCode))
+	}
+
+	return nil
+}
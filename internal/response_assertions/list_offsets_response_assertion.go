@@ -0,0 +1,188 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	compact_array_length_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/compact_array_length"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type ExpectedListOffsetsPartition struct {
+	PartitionIndex int32
+	ErrorCode      int16
+	Timestamp      int64
+	Offset         int64
+}
+
+type ExpectedListOffsetsTopic struct {
+	Name       string
+	Partitions []ExpectedListOffsetsPartition
+}
+
+type ListOffsetsResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedTopics        []ExpectedListOffsetsTopic
+}
+
+// GetExpectedListOffsetsPartition returns the offset and timestamp that a broker finds for timestamp in a partition with recordBatches
+func GetExpectedListOffsetsPartition(partitionIndex int32, recordBatches kafkaapi.RecordBatches, timestamp int64) ExpectedListOffsetsPartition {
+	expectedPartition := ExpectedListOffsetsPartition{
+		PartitionIndex: partitionIndex,
+		ErrorCode:      0,
+		// -1 is returned when no record matches the timestamp, and for EARLIEST and LATEST lookups
+		Timestamp: -1,
+		Offset:    -1,
+	}
+
+	switch timestamp {
+	case kafkaapi.ListOffsetsEarliestTimestamp:
+		expectedPartition.Offset = 0
+		if len(recordBatches) > 0 {
+			expectedPartition.Offset = recordBatches[0].BaseOffset.Value
+		}
+	case kafkaapi.ListOffsetsLatestTimestamp:
+		expectedPartition.Offset = 0
+		if len(recordBatches) > 0 {
+			lastRecordBatch := recordBatches[len(recordBatches)-1]
+			expectedPartition.Offset = lastRecordBatch.BaseOffset.Value + int64(lastRecordBatch.LastOffsetDelta.Value) + 1
+		}
+	case kafkaapi.ListOffsetsMaxTimestamp:
+		// If several records share the largest timestamp, the first one is returned
+		for _, recordBatch := range recordBatches {
+			for _, record := range recordBatch.Records {
+				recordTimestamp := recordBatch.FirstTimestamp.Value + record.TimestampDelta.Value
+				if recordTimestamp > expectedPartition.Timestamp {
+					expectedPartition.Timestamp = recordTimestamp
+					expectedPartition.Offset = recordBatch.BaseOffset.Value + record.OffsetDelta.Value
+				}
+			}
+		}
+	default:
+		// The first record with a timestamp greater than or equal to the requested timestamp is returned
+		for _, recordBatch := range recordBatches {
+			for _, record := range recordBatch.Records {
+				recordTimestamp := recordBatch.FirstTimestamp.Value + record.TimestampDelta.Value
+				if recordTimestamp >= timestamp {
+					expectedPartition.Timestamp = recordTimestamp
+					expectedPartition.Offset = recordBatch.BaseOffset.Value + record.OffsetDelta.Value
+					return expectedPartition
+				}
+			}
+		}
+	}
+
+	return expectedPartition
+}
+
+func NewListOffsetsResponseAssertion() *ListOffsetsResponseAssertion {
+	return &ListOffsetsResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedTopics:        []ExpectedListOffsetsTopic{},
+	}
+}
+
+func (a *ListOffsetsResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *ListOffsetsResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *ListOffsetsResponseAssertion) ExpectTopics(expectedTopics []ExpectedListOffsetsTopic) *ListOffsetsResponseAssertion {
+	a.expectedTopics = expectedTopics
+	return a
+}
+
+func (a *ListOffsetsResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "ListOffsetsResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "ListOffsetsResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "ListOffsetsResponse.Body.Topics.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedTopics), field.Value)
+	}
+
+	// Topics and partitions are validated in AssertAcrossFields, since they can be in any order
+	topicFieldPattern := regexp.MustCompile(`^ListOffsetsResponse\.Body\.Topics\.Topics\[\d+\]\.(Name|Partitions\..*)$`)
+	if topicFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^ListOffsetsResponse\.Body\.(Topics\.Topics\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *ListOffsetsResponseAssertion) AssertAcrossFields(response generated_kafkaapi.ListOffsetsResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ Topics array length: %d", len(a.expectedTopics))
+
+	for _, expectedTopic := range a.expectedTopics {
+		topicIndex := slices.IndexFunc(response.Body.Topics, func(topic generated_kafkaapi.ListOffsetsResponseListOffsetsTopicResponse) bool {
+			return topic.Name.Value == expectedTopic.Name
+		})
+
+		if topicIndex == -1 {
+			return fmt.Errorf("Expected topic %q to be present in Topics", expectedTopic.Name)
+		}
+
+		actualTopic := response.Body.Topics[topicIndex]
+		logger.Successf("✓ Topics[%d].Name: %s", topicIndex, expectedTopic.Name)
+
+		if len(actualTopic.Partitions) != len(expectedTopic.Partitions) {
+			return fmt.Errorf("Expected Topics[%d].Partitions to have length %d, got %d", topicIndex, len(expectedTopic.Partitions), len(actualTopic.Partitions))
+		}
+
+		logger.Successf("✓ Topics[%d].Partitions array length: %d", topicIndex, len(expectedTopic.Partitions))
+
+		for _, expectedPartition := range expectedTopic.Partitions {
+			partitionIndex := slices.IndexFunc(actualTopic.Partitions, func(partition generated_kafkaapi.ListOffsetsResponseListOffsetsPartitionResponse) bool {
+				return partition.PartitionIndex.Value == expectedPartition.PartitionIndex
+			})
+
+			if partitionIndex == -1 {
+				return fmt.Errorf("Expected partition %d to be present in Topics[%d].Partitions", expectedPartition.PartitionIndex, topicIndex)
+			}
+
+			actualPartition := actualTopic.Partitions[partitionIndex]
+			partitionPath := fmt.Sprintf("Topics[%d].Partitions[%d]", topicIndex, partitionIndex)
+			logger.Successf("✓ %s.PartitionIndex: %d", partitionPath, expectedPartition.PartitionIndex)
+
+			if actualPartition.ErrorCode.Value != expectedPartition.ErrorCode {
+				return fmt.Errorf("Expected %s.ErrorCode to be %d (%s), got %d", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode), actualPartition.ErrorCode.Value)
+			}
+
+			logger.Successf("✓ %s.ErrorCode: %d (%s)", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode))
+
+			if actualPartition.Offset.Value != expectedPartition.Offset {
+				return fmt.Errorf("Expected %s.Offset to be %d, got %d", partitionPath, expectedPartition.Offset, actualPartition.Offset.Value)
+			}
+
+			logger.Successf("✓ %s.Offset: %d", partitionPath, expectedPartition.Offset)
+
+			if actualPartition.Timestamp.Value != expectedPartition.Timestamp {
+				return fmt.Errorf("Expected %s.Timestamp to be %d, got %d", partitionPath, expectedPartition.Timestamp, actualPartition.Timestamp.Value)
+			}
+
+			logger.Successf("✓ %s.Timestamp: %d", partitionPath, expectedPartition.Timestamp)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	compact_array_length_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/compact_array_length"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type ExpectedMetadataBroker struct {
+	NodeId int32
+	// The host isn't asserted, since brokers advertise their canonical hostname when the listener doesn't specify one
+	Port int32
+}
+
+type ExpectedMetadataPartition struct {
+	PartitionIndex int32
+	ErrorCode      int16
+	LeaderId       int32
+	ReplicaNodes   []int32
+	IsrNodes       []int32
+}
+
+type ExpectedMetadataTopic struct {
+	Name       string
+	ErrorCode  int16
+	TopicId    string
+	Partitions []ExpectedMetadataPartition
+}
+
+type MetadataResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedBrokers       []ExpectedMetadataBroker
+	expectedControllerId  int32
+	expectedTopics        []ExpectedMetadataTopic
+}
+
+// GetExpectedMetadataBrokers returns the single broker that kafka_files_generator configures
+func GetExpectedMetadataBrokers(listenerAddress kafka_files_generator.ListenerAddress) []ExpectedMetadataBroker {
+	return []ExpectedMetadataBroker{
+		{
+			NodeId: kafka_files_generator.NODE_ID,
+			Port:   int32(listenerAddress.Port),
+		},
+	}
+}
+
+func GetExpectedMetadataTopicsFromGeneratedLogDirectoryData(generatedLogDirectoryData *kafka_files_generator.GeneratedLogDirectoryData) []ExpectedMetadataTopic {
+	var expectedTopics []ExpectedMetadataTopic
+
+	for _, topicData := range generatedLogDirectoryData.GeneratedTopicsData {
+		expectedPartitions := []ExpectedMetadataPartition{}
+
+		// The cluster metadata log assigns every partition to the only broker
+		for _, generatedRecordBatchesByPartition := range topicData.GeneratedRecordBatchesByPartition {
+			expectedPartitions = append(expectedPartitions, ExpectedMetadataPartition{
+				PartitionIndex: int32(generatedRecordBatchesByPartition.PartitionId),
+				ErrorCode:      0,
+				LeaderId:       kafka_files_generator.NODE_ID,
+				ReplicaNodes:   []int32{kafka_files_generator.NODE_ID},
+				IsrNodes:       []int32{kafka_files_generator.NODE_ID},
+			})
+		}
+
+		expectedTopics = append(expectedTopics, ExpectedMetadataTopic{
+			Name:       topicData.Name,
+			ErrorCode:  0,
+			TopicId:    topicData.UUID,
+			Partitions: expectedPartitions,
+		})
+	}
+
+	sort.Slice(expectedTopics, func(i, j int) bool {
+		return expectedTopics[i].Name < expectedTopics[j].Name
+	})
+
+	return expectedTopics
+}
+
+func NewMetadataResponseAssertion() *MetadataResponseAssertion {
+	return &MetadataResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedBrokers:       []ExpectedMetadataBroker{},
+		expectedControllerId:  kafka_files_generator.NODE_ID,
+		expectedTopics:        []ExpectedMetadataTopic{},
+	}
+}
+
+func (a *MetadataResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *MetadataResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *MetadataResponseAssertion) ExpectBrokers(expectedBrokers []ExpectedMetadataBroker) *MetadataResponseAssertion {
+	a.expectedBrokers = expectedBrokers
+	return a
+}
+
+func (a *MetadataResponseAssertion) ExpectControllerId(expectedControllerId int32) *MetadataResponseAssertion {
+	a.expectedControllerId = expectedControllerId
+	return a
+}
+
+func (a *MetadataResponseAssertion) ExpectTopics(expectedTopics []ExpectedMetadataTopic) *MetadataResponseAssertion {
+	a.expectedTopics = expectedTopics
+	return a
+}
+
+func (a *MetadataResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "MetadataResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "MetadataResponse.Body.ThrottleTimeMs" || path == "MetadataResponse.Body.ClusterId" {
+		return nil
+	}
+
+	if path == "MetadataResponse.Body.Brokers.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedBrokers), field.Value)
+	}
+
+	// Brokers are validated in AssertAcrossFields, since they can be in any order
+	brokerFieldPattern := regexp.MustCompile(`^MetadataResponse\.Body\.Brokers\.Brokers\[\d+\]\.(NodeId|Host|Port|Rack)$`)
+	if brokerFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	if path == "MetadataResponse.Body.ControllerId" {
+		return int32_assertions.IsEqualTo(a.expectedControllerId, field.Value)
+	}
+
+	if path == "MetadataResponse.Body.Topics.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedTopics), field.Value)
+	}
+
+	// Topics are validated in AssertAcrossFields, since they can be in any order
+	topicFieldPattern := regexp.MustCompile(`^MetadataResponse\.Body\.Topics\.Topics\[\d+\]\.(ErrorCode|Name|TopicId|IsInternal|TopicAuthorizedOperations)$`)
+	if topicFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	partitionFieldPattern := regexp.MustCompile(`^MetadataResponse\.Body\.Topics\.Topics\[\d+\]\.Partitions\..*$`)
+	if partitionFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^MetadataResponse\.Body\.((Brokers\.Brokers|Topics\.Topics)\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *MetadataResponseAssertion) AssertAcrossFields(response generated_kafkaapi.MetadataResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ Brokers array length: %d", len(a.expectedBrokers))
+
+	for _, expectedBroker := range a.expectedBrokers {
+		brokerIndex := slices.IndexFunc(response.Body.Brokers, func(broker generated_kafkaapi.MetadataResponseBroker) bool {
+			return broker.NodeId.Value == expectedBroker.NodeId
+		})
+
+		if brokerIndex == -1 {
+			return fmt.Errorf("Expected broker with NodeId %d to be present in Brokers", expectedBroker.NodeId)
+		}
+
+		actualBroker := response.Body.Brokers[brokerIndex]
+		logger.Successf("✓ Brokers[%d].NodeId: %d", brokerIndex, expectedBroker.NodeId)
+
+		if actualBroker.Host.Value == "" {
+			return fmt.Errorf("Expected Brokers[%d].Host to be non-empty", brokerIndex)
+		}
+
+		logger.Successf("✓ Brokers[%d].Host: %s", brokerIndex, actualBroker.Host.Value)
+
+		if actualBroker.Port.Value != expectedBroker.Port {
+			return fmt.Errorf("Expected Brokers[%d].Port to be %d, got %d", brokerIndex, expectedBroker.Port, actualBroker.Port.Value)
+		}
+
+		logger.Successf("✓ Brokers[%d].Port: %d", brokerIndex, expectedBroker.Port)
+	}
+
+	logger.Successf("✓ ControllerId: %d", a.expectedControllerId)
+	logger.Successf("✓ Topics array length: %d", len(a.expectedTopics))
+
+	for _, expectedTopic := range a.expectedTopics {
+		topicIndex := slices.IndexFunc(response.Body.Topics, func(topic generated_kafkaapi.MetadataResponseTopic) bool {
+			return topic.Name.Value != nil && *topic.Name.Value == expectedTopic.Name
+		})
+
+		if topicIndex == -1 {
+			return fmt.Errorf("Expected topic %q to be present in Topics", expectedTopic.Name)
+		}
+
+		if err := a.assertTopic(topicIndex, response.Body.Topics[topicIndex], expectedTopic, logger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *MetadataResponseAssertion) assertTopic(topicIndex int, actualTopic generated_kafkaapi.MetadataResponseTopic, expectedTopic ExpectedMetadataTopic, logger *logger.Logger) error {
+	logger.Successf("✓ Topics[%d].Name: %s", topicIndex, expectedTopic.Name)
+
+	if actualTopic.ErrorCode.Value != expectedTopic.ErrorCode {
+		return fmt.Errorf("Expected Topics[%d].ErrorCode to be %d (%s), got %d", topicIndex, expectedTopic.ErrorCode, utils.ErrorCodeToName(expectedTopic.ErrorCode), actualTopic.ErrorCode.Value)
+	}
+
+	logger.Successf("✓ Topics[%d].ErrorCode: %d (%s)", topicIndex, expectedTopic.ErrorCode, utils.ErrorCodeToName(expectedTopic.ErrorCode))
+
+	if actualTopic.TopicId.Value != expectedTopic.TopicId {
+		return fmt.Errorf("Expected Topics[%d].TopicId to be %s, got %s", topicIndex, expectedTopic.TopicId, actualTopic.TopicId.Value)
+	}
+
+	logger.Successf("✓ Topics[%d].TopicId: %s", topicIndex, expectedTopic.TopicId)
+
+	if len(actualTopic.Partitions) != len(expectedTopic.Partitions) {
+		return fmt.Errorf("Expected Topics[%d].Partitions to have length %d, got %d", topicIndex, len(expectedTopic.Partitions), len(actualTopic.Partitions))
+	}
+
+	logger.Successf("✓ Topics[%d].Partitions array length: %d", topicIndex, len(expectedTopic.Partitions))
+
+	for _, expectedPartition := range expectedTopic.Partitions {
+		partitionIndex := slices.IndexFunc(actualTopic.Partitions, func(partition generated_kafkaapi.MetadataResponsePartition) bool {
+			return partition.PartitionIndex.Value == expectedPartition.PartitionIndex
+		})
+
+		if partitionIndex == -1 {
+			return fmt.Errorf("Expected partition %d to be present in Topics[%d].Partitions", expectedPartition.PartitionIndex, topicIndex)
+		}
+
+		actualPartition := actualTopic.Partitions[partitionIndex]
+		partitionPath := fmt.Sprintf("Topics[%d].Partitions[%d]", topicIndex, partitionIndex)
+		logger.Successf("✓ %s.PartitionIndex: %d", partitionPath, expectedPartition.PartitionIndex)
+
+		if actualPartition.ErrorCode.Value != expectedPartition.ErrorCode {
+			return fmt.Errorf("Expected %s.ErrorCode to be %d (%s), got %d", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode), actualPartition.ErrorCode.Value)
+		}
+
+		logger.Successf("✓ %s.ErrorCode: %d (%s)", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode))
+
+		if actualPartition.LeaderId.Value != expectedPartition.LeaderId {
+			return fmt.Errorf("Expected %s.LeaderId to be %d, got %d", partitionPath, expectedPartition.LeaderId, actualPartition.LeaderId.Value)
+		}
+
+		logger.Successf("✓ %s.LeaderId: %d", partitionPath, expectedPartition.LeaderId)
+
+		if actualReplicaNodes := int32Values(actualPartition.ReplicaNodes); !slices.Equal(actualReplicaNodes, expectedPartition.ReplicaNodes) {
+			return fmt.Errorf("Expected %s.ReplicaNodes to be %v, got %v", partitionPath, expectedPartition.ReplicaNodes, actualReplicaNodes)
+		}
+
+		logger.Successf("✓ %s.ReplicaNodes: %v", partitionPath, expectedPartition.ReplicaNodes)
+
+		if actualIsrNodes := int32Values(actualPartition.IsrNodes); !slices.Equal(actualIsrNodes, expectedPartition.IsrNodes) {
+			return fmt.Errorf("Expected %s.IsrNodes to be %v, got %v", partitionPath, expectedPartition.IsrNodes, actualIsrNodes)
+		}
+
+		logger.Successf("✓ %s.IsrNodes: %v", partitionPath, expectedPartition.IsrNodes)
+	}
+
+	return nil
+}
+
+func int32Values(values []value.Int32) []int32 {
+	result := make([]int32, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.Value)
+	}
+
+	return result
+}
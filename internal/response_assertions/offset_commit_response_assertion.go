@@ -0,0 +1,123 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	compact_array_length_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/compact_array_length"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type ExpectedOffsetCommitPartition struct {
+	PartitionIndex int32
+	ErrorCode      int16
+}
+
+type ExpectedOffsetCommitTopic struct {
+	Name       string
+	Partitions []ExpectedOffsetCommitPartition
+}
+
+type OffsetCommitResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedTopics        []ExpectedOffsetCommitTopic
+}
+
+func NewOffsetCommitResponseAssertion() *OffsetCommitResponseAssertion {
+	return &OffsetCommitResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedTopics:        []ExpectedOffsetCommitTopic{},
+	}
+}
+
+func (a *OffsetCommitResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *OffsetCommitResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *OffsetCommitResponseAssertion) ExpectTopics(expectedTopics []ExpectedOffsetCommitTopic) *OffsetCommitResponseAssertion {
+	a.expectedTopics = expectedTopics
+	return a
+}
+
+func (a *OffsetCommitResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "OffsetCommitResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "OffsetCommitResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "OffsetCommitResponse.Body.Topics.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedTopics), field.Value)
+	}
+
+	// Topics and partitions are validated in AssertAcrossFields, since they can be in any order
+	topicFieldPattern := regexp.MustCompile(`^OffsetCommitResponse\.Body\.Topics\.Topics\[\d+\]\.(Name|Partitions\..*)$`)
+	if topicFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^OffsetCommitResponse\.Body\.(Topics\.Topics\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *OffsetCommitResponseAssertion) AssertAcrossFields(response generated_kafkaapi.OffsetCommitResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ Topics array length: %d", len(a.expectedTopics))
+
+	for _, expectedTopic := range a.expectedTopics {
+		topicIndex := slices.IndexFunc(response.Body.Topics, func(topic generated_kafkaapi.OffsetCommitResponseTopic) bool {
+			return topic.Name.Value == expectedTopic.Name
+		})
+
+		if topicIndex == -1 {
+			return fmt.Errorf("Expected topic %q to be present in Topics", expectedTopic.Name)
+		}
+
+		actualTopic := response.Body.Topics[topicIndex]
+		logger.Successf("✓ Topics[%d].Name: %s", topicIndex, expectedTopic.Name)
+
+		if len(actualTopic.Partitions) != len(expectedTopic.Partitions) {
+			return fmt.Errorf("Expected Topics[%d].Partitions to have length %d, got %d", topicIndex, len(expectedTopic.Partitions), len(actualTopic.Partitions))
+		}
+
+		logger.Successf("✓ Topics[%d].Partitions array length: %d", topicIndex, len(expectedTopic.Partitions))
+
+		for _, expectedPartition := range expectedTopic.Partitions {
+			partitionIndex := slices.IndexFunc(actualTopic.Partitions, func(partition generated_kafkaapi.OffsetCommitResponsePartition) bool {
+				return partition.PartitionIndex.Value == expectedPartition.PartitionIndex
+			})
+
+			if partitionIndex == -1 {
+				return fmt.Errorf("Expected partition %d to be present in Topics[%d].Partitions", expectedPartition.PartitionIndex, topicIndex)
+			}
+
+			actualPartition := actualTopic.Partitions[partitionIndex]
+			partitionPath := fmt.Sprintf("Topics[%d].Partitions[%d]", topicIndex, partitionIndex)
+			logger.Successf("✓ %s.PartitionIndex: %d", partitionPath, expectedPartition.PartitionIndex)
+
+			if actualPartition.ErrorCode.Value != expectedPartition.ErrorCode {
+				return fmt.Errorf("Expected %s.ErrorCode to be %d (%s), got %d", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode), actualPartition.ErrorCode.Value)
+			}
+
+			logger.Successf("✓ %s.ErrorCode: %d (%s)", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	compact_array_length_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/compact_array_length"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type ExpectedOffsetFetchPartition struct {
+	PartitionIndex int32
+	// CommittedOffset is -1 if no offset was committed for the partition
+	CommittedOffset int64
+	Metadata        string
+	ErrorCode       int16
+}
+
+type ExpectedOffsetFetchTopic struct {
+	Name       string
+	Partitions []ExpectedOffsetFetchPartition
+}
+
+type ExpectedOffsetFetchGroup struct {
+	GroupId   string
+	ErrorCode int16
+	Topics    []ExpectedOffsetFetchTopic
+}
+
+type OffsetFetchResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedGroups        []ExpectedOffsetFetchGroup
+}
+
+func NewOffsetFetchResponseAssertion() *OffsetFetchResponseAssertion {
+	return &OffsetFetchResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedGroups:        []ExpectedOffsetFetchGroup{},
+	}
+}
+
+func (a *OffsetFetchResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *OffsetFetchResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *OffsetFetchResponseAssertion) ExpectGroups(expectedGroups []ExpectedOffsetFetchGroup) *OffsetFetchResponseAssertion {
+	a.expectedGroups = expectedGroups
+	return a
+}
+
+func (a *OffsetFetchResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "OffsetFetchResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "OffsetFetchResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "OffsetFetchResponse.Body.Groups.Length" {
+		return compact_array_length_assertions.IsEqualTo(value.NewCompactArrayLength(a.expectedGroups), field.Value)
+	}
+
+	// Groups, topics and partitions are validated in AssertAcrossFields, since they can be in any order
+	groupFieldPattern := regexp.MustCompile(`^OffsetFetchResponse\.Body\.Groups\.Groups\[\d+\]\.(GroupId|ErrorCode|Topics\..*)$`)
+	if groupFieldPattern.MatchString(path) {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^OffsetFetchResponse\.Body\.(Groups\.Groups\[\d+\]\.)?TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *OffsetFetchResponseAssertion) AssertAcrossFields(response generated_kafkaapi.OffsetFetchResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ Groups array length: %d", len(a.expectedGroups))
+
+	for _, expectedGroup := range a.expectedGroups {
+		groupIndex := slices.IndexFunc(response.Body.Groups, func(group generated_kafkaapi.OffsetFetchResponseGroup) bool {
+			return group.GroupId.Value == expectedGroup.GroupId
+		})
+
+		if groupIndex == -1 {
+			return fmt.Errorf("Expected group %q to be present in Groups", expectedGroup.GroupId)
+		}
+
+		actualGroup := response.Body.Groups[groupIndex]
+		logger.Successf("✓ Groups[%d].GroupId: %s", groupIndex, expectedGroup.GroupId)
+
+		if actualGroup.ErrorCode.Value != expectedGroup.ErrorCode {
+			return fmt.Errorf("Expected Groups[%d].ErrorCode to be %d (%s), got %d", groupIndex, expectedGroup.ErrorCode, utils.ErrorCodeToName(expectedGroup.ErrorCode), actualGroup.ErrorCode.Value)
+		}
+
+		logger.Successf("✓ Groups[%d].ErrorCode: %d (%s)", groupIndex, expectedGroup.ErrorCode, utils.ErrorCodeToName(expectedGroup.ErrorCode))
+
+		if len(actualGroup.Topics) != len(expectedGroup.Topics) {
+			return fmt.Errorf("Expected Groups[%d].Topics to have length %d, got %d", groupIndex, len(expectedGroup.Topics), len(actualGroup.Topics))
+		}
+
+		logger.Successf("✓ Groups[%d].Topics array length: %d", groupIndex, len(expectedGroup.Topics))
+
+		for _, expectedTopic := range expectedGroup.Topics {
+			if err := assertOffsetFetchTopic(actualGroup.Topics, expectedTopic, fmt.Sprintf("Groups[%d]", groupIndex), logger); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func assertOffsetFetchTopic(actualTopics []generated_kafkaapi.OffsetFetchResponseTopics, expectedTopic ExpectedOffsetFetchTopic, groupPath string, logger *logger.Logger) error {
+	topicIndex := slices.IndexFunc(actualTopics, func(topic generated_kafkaapi.OffsetFetchResponseTopics) bool {
+		return topic.Name.Value == expectedTopic.Name
+	})
+
+	if topicIndex == -1 {
+		return fmt.Errorf("Expected topic %q to be present in %s.Topics", expectedTopic.Name, groupPath)
+	}
+
+	actualTopic := actualTopics[topicIndex]
+	topicPath := fmt.Sprintf("%s.Topics[%d]", groupPath, topicIndex)
+	logger.Successf("✓ %s.Name: %s", topicPath, expectedTopic.Name)
+
+	if len(actualTopic.Partitions) != len(expectedTopic.Partitions) {
+		return fmt.Errorf("Expected %s.Partitions to have length %d, got %d", topicPath, len(expectedTopic.Partitions), len(actualTopic.Partitions))
+	}
+
+	logger.Successf("✓ %s.Partitions array length: %d", topicPath, len(expectedTopic.Partitions))
+
+	for _, expectedPartition := range expectedTopic.Partitions {
+		partitionIndex := slices.IndexFunc(actualTopic.Partitions, func(partition generated_kafkaapi.OffsetFetchResponsePartitions) bool {
+			return partition.PartitionIndex.Value == expectedPartition.PartitionIndex
+		})
+
+		if partitionIndex == -1 {
+			return fmt.Errorf("Expected partition %d to be present in %s.Partitions", expectedPartition.PartitionIndex, topicPath)
+		}
+
+		actualPartition := actualTopic.Partitions[partitionIndex]
+		partitionPath := fmt.Sprintf("%s.Partitions[%d]", topicPath, partitionIndex)
+		logger.Successf("✓ %s.PartitionIndex: %d", partitionPath, expectedPartition.PartitionIndex)
+
+		if actualPartition.ErrorCode.Value != expectedPartition.ErrorCode {
+			return fmt.Errorf("Expected %s.ErrorCode to be %d (%s), got %d", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode), actualPartition.ErrorCode.Value)
+		}
+
+		logger.Successf("✓ %s.ErrorCode: %d (%s)", partitionPath, expectedPartition.ErrorCode, utils.ErrorCodeToName(expectedPartition.ErrorCode))
+
+		if actualPartition.CommittedOffset.Value != expectedPartition.CommittedOffset {
+			return fmt.Errorf("Expected %s.CommittedOffset to be %d, got %d", partitionPath, expectedPartition.CommittedOffset, actualPartition.CommittedOffset.Value)
+		}
+
+		logger.Successf("✓ %s.CommittedOffset: %d", partitionPath, expectedPartition.CommittedOffset)
+
+		if actualPartition.Metadata.Value == nil {
+			return fmt.Errorf("Expected %s.Metadata to be %q, got null", partitionPath, expectedPartition.Metadata)
+		}
+
+		if *actualPartition.Metadata.Value != expectedPartition.Metadata {
+			return fmt.Errorf("Expected %s.Metadata to be %q, got %q", partitionPath, expectedPartition.Metadata, *actualPartition.Metadata.Value)
+		}
+
+		logger.Successf("✓ %s.Metadata: %q", partitionPath, expectedPartition.Metadata)
+	}
+
+	return nil
+}
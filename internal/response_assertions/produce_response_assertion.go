@@ -218,55 +218,81 @@ func (a *ProduceResponseAssertion) AssertLogFilesOnDisk(topics []kafkaapi.Produc
 					len(partition.RecordBatches), topic.Name.Value, partition.Id.Value))
 			}
 
-			expectedRecordBatch := partition.RecordBatches[0]
-
 			// Read the actual log file content
 			logFilePath := fmt.Sprintf("/tmp/kraft-combined-logs/%s-%d/00000000000000000000.log",
 				topic.Name.Value, partition.Id.Value)
 
-			stageLogger.Infof("Checking file contents of the file %s", logFilePath)
-
-			bytesOnDisk, err := os.ReadFile(logFilePath)
-			if err != nil {
-				return fmt.Errorf("failed to read log file %s: %w", logFilePath, err)
+			if err := assertLogFileOnDisk(logFilePath, partition.RecordBatches[0], stageLogger); err != nil {
+				return err
 			}
+		}
+	}
 
-			// Decode the RecordBatch object in the file
-			decoder := field_decoder.NewFieldDecoder(bytesOnDisk)
-			decodedRecordBatch, decodeError := response_decoders.DecodeCompactRecordBatch(decoder, "RecordBatch")
+	return nil
+}
 
-			// DecodeError while decoding RecordBatch from the file
-			if decodeError != nil {
-				printFieldTreeForDecodeError(decodeError, stageLogger, decoder, bytesOnDisk)
-				return decodeError
-			}
+func assertLogFileOnDisk(logFilePath string, expectedRecordBatch kafkaapi.RecordBatch, stageLogger *logger.Logger) error {
+	stageLogger.Infof("Checking file contents of the file %s", logFilePath)
 
-			// Check CRC32 checksum
-			if err := int32_assertions.IsEqualTo(expectedRecordBatch.CRC.Value, decodedRecordBatch.CRC); err != nil {
-				logFileHexDump := inspectable_hex_dump.NewInspectableHexDump(bytesOnDisk)
-				stageLogger.Errorln("File contents of " + logFilePath)
-				crc32StartOffset := 8 + 4 + 4 + 1 // BaseOffset (8 bytes) + Batch Length (4 bytes) + Partition Leader Epoch (4 bytes) + Magic Byte (1 Byte)
-				stageLogger.Errorln(logFileHexDump.FormatWithHighlightedRange(
-					crc32StartOffset,
-					// End offset should be the last byte of CRC32
-					crc32StartOffset+3,
-				))
-				return fmt.Errorf("Expected CRC32 of Record Batch to be %d, got %d", expectedRecordBatch.CRC.Value, decodedRecordBatch.CRC.Value)
-			}
+	logFile, err := os.Open(logFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read log file %s: %w", logFilePath, err)
+	}
+	defer logFile.Close()
 
-			stageLogger.Successf("✓ Contents of the file %s matches the recordbatch format sent in request", logFilePath)
+	logFileInfo, err := logFile.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to read log file %s: %w", logFilePath, err)
+	}
 
-		}
+	// Log segments can be large, so we stream the file instead of reading all of it into memory.
+	// Only the first record batch is decoded, the rest of the segment is never read.
+	decoder := field_decoder.NewFieldDecoderFromReader(logFile, uint64(logFileInfo.Size()))
+	decodedRecordBatch, decodeError := response_decoders.DecodeCompactRecordBatch(decoder, "RecordBatch")
+
+	if err := decoder.ReadErr(); err != nil {
+		return fmt.Errorf("failed to read log file %s: %w", logFilePath, err)
+	}
+
+	// DecodeError while decoding RecordBatch from the file
+	if decodeError != nil {
+		printFieldTreeForDecodeError(decodeError, stageLogger, decoder, logFile)
+		return decodeError
+	}
+
+	// Check CRC32 checksum
+	if err := int32_assertions.IsEqualTo(expectedRecordBatch.CRC.Value, decodedRecordBatch.CRC); err != nil {
+		crc32StartOffset := 8 + 4 + 4 + 1 // BaseOffset (8 bytes) + Batch Length (4 bytes) + Partition Leader Epoch (4 bytes) + Magic Byte (1 Byte)
+		stageLogger.Errorln("File contents of " + logFilePath)
+		stageLogger.Errorln(readHexDumpAroundOffset(logFile, crc32StartOffset).FormatWithHighlightedRange(
+			crc32StartOffset,
+			// End offset should be the last byte of CRC32
+			crc32StartOffset+3,
+		))
+		return fmt.Errorf("Expected CRC32 of Record Batch to be %d, got %d", expectedRecordBatch.CRC.Value, decodedRecordBatch.CRC.Value)
 	}
 
+	stageLogger.Successf("✓ Contents of the file %s matches the recordbatch format sent in request", logFilePath)
+
 	return nil
 }
 
+// readHexDumpAroundOffset returns a hexdump of the bytes in the file around offset, enough to highlight a field that starts at offset
+func readHexDumpAroundOffset(file *os.File, offset int) inspectable_hex_dump.InspectableHexDump {
+	windowStartOffset := max(0, offset-16)
+	window := make([]byte, 48)
+
+	// io.EOF is expected when the window extends past the end of the file, we render whatever we could read
+	n, _ := file.ReadAt(window, int64(windowStartOffset))
+
+	return inspectable_hex_dump.NewInspectableHexDumpAtOffset(window[:n], windowStartOffset)
+}
+
 func printFieldTreeForDecodeError(
 	decodeError field_decoder.FieldDecoderError,
 	stageLogger *logger.Logger,
 	decoder *field_decoder.FieldDecoder,
-	bytesOnDisk []byte,
+	logFile *os.File,
 ) {
 	fieldTreePrinterLogger := stageLogger.Clone()
 	fieldTreePrinterLogger.PushSecondaryPrefix("Decoder")
@@ -276,7 +302,6 @@ func printFieldTreeForDecodeError(
 		Logger: fieldTreePrinterLogger,
 	}
 	fieldTreePrinter.PrintForDecodeError(decodeError.Path())
-	fileBytesHexDump := inspectable_hex_dump.NewInspectableHexDump(bytesOnDisk)
 	stageLogger.Errorln("Bytes in file:")
-	stageLogger.Errorln(fileBytesHexDump.FormatWithHighlightedRange(decodeError.StartOffset(), decodeError.EndOffset()))
+	stageLogger.Errorln(readHexDumpAroundOffset(logFile, decodeError.StartOffset()).FormatWithHighlightedRange(decodeError.StartOffset(), decodeError.EndOffset()))
 }
@@ -0,0 +1,67 @@
+package response_assertions
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	int16_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int16"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type SaslAuthenticateResponseAssertion struct {
+	expectedCorrelationID int32
+	expectedErrorCode     int16
+}
+
+func NewSaslAuthenticateResponseAssertion() *SaslAuthenticateResponseAssertion {
+	return &SaslAuthenticateResponseAssertion{
+		expectedCorrelationID: -1,
+		expectedErrorCode:     0,
+	}
+}
+
+func (a *SaslAuthenticateResponseAssertion) ExpectCorrelationId(expectedCorrelationID int32) *SaslAuthenticateResponseAssertion {
+	a.expectedCorrelationID = expectedCorrelationID
+	return a
+}
+
+func (a *SaslAuthenticateResponseAssertion) ExpectErrorCode(expectedErrorCode int16) *SaslAuthenticateResponseAssertion {
+	a.expectedErrorCode = expectedErrorCode
+	return a
+}
+
+func (a *SaslAuthenticateResponseAssertion) AssertSingleField(field field.Field) error {
+	if field.Path.String() == "SaslAuthenticateResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationID, field.Value)
+	}
+
+	if field.Path.String() == "SaslAuthenticateResponse.Body.ErrorCode" {
+		return int16_assertions.IsEqualTo(a.expectedErrorCode, field.Value)
+	}
+
+	if field.Path.String() == "SaslAuthenticateResponse.Body.ErrorMessage" {
+		// We don't validate the error message
+		return nil
+	}
+
+	if field.Path.String() == "SaslAuthenticateResponse.Body.AuthBytes" {
+		// AuthBytes are validated by the SASL mechanism
+		return nil
+	}
+
+	if field.Path.String() == "SaslAuthenticateResponse.Body.SessionLifetimeMs" {
+		// We don't validate SessionLifetimeMs
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + field.Path.String())
+}
+
+func (a *SaslAuthenticateResponseAssertion) AssertAcrossFields(response kafkaapi.SaslAuthenticateResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationID)
+	logger.Successf("✓ ErrorCode: %d (%s)", a.expectedErrorCode, utils.ErrorCodeToName(a.expectedErrorCode))
+
+	return nil
+}
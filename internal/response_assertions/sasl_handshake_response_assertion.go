@@ -0,0 +1,89 @@
+package response_assertions
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	int16_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int16"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type SaslHandshakeResponseAssertion struct {
+	expectedCorrelationID int32
+	expectedErrorCode     int16
+	expectedMechanisms    []string
+}
+
+func NewSaslHandshakeResponseAssertion() *SaslHandshakeResponseAssertion {
+	return &SaslHandshakeResponseAssertion{
+		expectedCorrelationID: -1,
+		expectedErrorCode:     0,
+		expectedMechanisms:    []string{},
+	}
+}
+
+func (a *SaslHandshakeResponseAssertion) ExpectCorrelationId(expectedCorrelationID int32) *SaslHandshakeResponseAssertion {
+	a.expectedCorrelationID = expectedCorrelationID
+	return a
+}
+
+func (a *SaslHandshakeResponseAssertion) ExpectErrorCode(expectedErrorCode int16) *SaslHandshakeResponseAssertion {
+	a.expectedErrorCode = expectedErrorCode
+	return a
+}
+
+// ExpectMechanism asserts that the mechanism is present in the Mechanisms array, other mechanisms are allowed too
+func (a *SaslHandshakeResponseAssertion) ExpectMechanism(expectedMechanism string) *SaslHandshakeResponseAssertion {
+	a.expectedMechanisms = append(a.expectedMechanisms, expectedMechanism)
+	return a
+}
+
+func (a *SaslHandshakeResponseAssertion) AssertSingleField(field field.Field) error {
+	if field.Path.String() == "SaslHandshakeResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationID, field.Value)
+	}
+
+	if field.Path.String() == "SaslHandshakeResponse.Body.ErrorCode" {
+		return int16_assertions.IsEqualTo(a.expectedErrorCode, field.Value)
+	}
+
+	if field.Path.String() == "SaslHandshakeResponse.Body.Mechanisms.Length" {
+		return nil
+	}
+
+	if regexp.MustCompile(`SaslHandshakeResponse\.Body\.Mechanisms\.Mechanisms\[\d+\]\.Mechanism`).MatchString(field.Path.String()) {
+		// Validated in AssertAcrossFields
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + field.Path.String())
+}
+
+func (a *SaslHandshakeResponseAssertion) AssertAcrossFields(response kafkaapi.SaslHandshakeResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationID)
+	logger.Successf("✓ ErrorCode: %d (%s)", a.expectedErrorCode, utils.ErrorCodeToName(a.expectedErrorCode))
+
+	for _, expectedMechanism := range a.expectedMechanisms {
+		found := false
+
+		for _, mechanism := range response.Body.Mechanisms {
+			if mechanism.Value == expectedMechanism {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Expected Mechanisms array to include %q", expectedMechanism)
+		}
+
+		logger.Successf("✓ Mechanisms includes %s", expectedMechanism)
+	}
+
+	return nil
+}
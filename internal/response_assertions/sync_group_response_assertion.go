@@ -0,0 +1,112 @@
+package response_assertions
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	int16_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int16"
+	int32_assertions "github.com/codecrafters-io/kafka-tester/internal/value_assertions/int32"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type SyncGroupResponseAssertion struct {
+	expectedCorrelationId int32
+	expectedErrorCode     int16
+	// The remaining fields are only asserted if expectedErrorCode is 0
+	expectedProtocolType string
+	expectedProtocolName string
+	expectedAssignment   []byte
+}
+
+func NewSyncGroupResponseAssertion() *SyncGroupResponseAssertion {
+	return &SyncGroupResponseAssertion{
+		expectedCorrelationId: -1,
+		expectedErrorCode:     0,
+	}
+}
+
+func (a *SyncGroupResponseAssertion) ExpectCorrelationId(expectedCorrelationId int32) *SyncGroupResponseAssertion {
+	a.expectedCorrelationId = expectedCorrelationId
+	return a
+}
+
+func (a *SyncGroupResponseAssertion) ExpectErrorCode(expectedErrorCode int16) *SyncGroupResponseAssertion {
+	a.expectedErrorCode = expectedErrorCode
+	return a
+}
+
+func (a *SyncGroupResponseAssertion) ExpectProtocol(expectedProtocolType string, expectedProtocolName string) *SyncGroupResponseAssertion {
+	a.expectedProtocolType = expectedProtocolType
+	a.expectedProtocolName = expectedProtocolName
+	return a
+}
+
+// ExpectAssignment asserts that the member receives the assignment that the leader sent for it
+func (a *SyncGroupResponseAssertion) ExpectAssignment(expectedAssignment []byte) *SyncGroupResponseAssertion {
+	a.expectedAssignment = expectedAssignment
+	return a
+}
+
+func (a *SyncGroupResponseAssertion) AssertSingleField(field field.Field) error {
+	path := field.Path.String()
+
+	if path == "SyncGroupResponse.Header.CorrelationID" {
+		return int32_assertions.IsEqualTo(a.expectedCorrelationId, field.Value)
+	}
+
+	if path == "SyncGroupResponse.Body.ThrottleTimeMs" {
+		return nil
+	}
+
+	if path == "SyncGroupResponse.Body.ErrorCode" {
+		return int16_assertions.IsEqualTo(a.expectedErrorCode, field.Value)
+	}
+
+	// These fields are validated in AssertAcrossFields, since they depend on the error code
+	if path == "SyncGroupResponse.Body.ProtocolType" || path == "SyncGroupResponse.Body.ProtocolName" || path == "SyncGroupResponse.Body.Assignment" {
+		return nil
+	}
+
+	tagBufferPattern := regexp.MustCompile(`^SyncGroupResponse\.Body\.TAG_BUFFER\..*$`)
+	if tagBufferPattern.MatchString(path) {
+		return nil
+	}
+
+	// This ensures that we're handling ALL possible fields
+	panic("CodeCrafters Internal Error: Unhandled field path: " + path)
+}
+
+func (a *SyncGroupResponseAssertion) AssertAcrossFields(response generated_kafkaapi.SyncGroupResponse, logger *logger.Logger) error {
+	logger.Successf("✓ CorrelationID: %d", a.expectedCorrelationId)
+	logger.Successf("✓ ErrorCode: %d (%s)", a.expectedErrorCode, utils.ErrorCodeToName(a.expectedErrorCode))
+
+	// The remaining fields are only meaningful if the group was synced
+	if a.expectedErrorCode != 0 {
+		return nil
+	}
+
+	if response.Body.ProtocolType.Value == nil || *response.Body.ProtocolType.Value != a.expectedProtocolType {
+		return fmt.Errorf("Expected ProtocolType to be %q, got %s", a.expectedProtocolType, response.Body.ProtocolType)
+	}
+
+	logger.Successf("✓ ProtocolType: %s", a.expectedProtocolType)
+
+	if response.Body.ProtocolName.Value == nil || *response.Body.ProtocolName.Value != a.expectedProtocolName {
+		return fmt.Errorf("Expected ProtocolName to be %q, got %s", a.expectedProtocolName, response.Body.ProtocolName)
+	}
+
+	logger.Successf("✓ ProtocolName: %s", a.expectedProtocolName)
+
+	if !bytes.Equal(response.Body.Assignment.Value, a.expectedAssignment) {
+		return fmt.Errorf("Expected Assignment to be %s (sent by the leader), got %s", value.Bytes{Value: a.expectedAssignment}, response.Body.Assignment)
+	}
+
+	logger.Successf("✓ Assignment: %s", response.Body.Assignment)
+
+	return nil
+}
@@ -46,6 +46,19 @@ func decodeCompactArray[T any](decoder *field_decoder.FieldDecoder, decodeFunc f
 	}
 
 	lengthValueAsCompactArrayLength := value.MustBeCompactArrayLength(lengthValue.Value)
+
+	// Null array
+	if lengthValueAsCompactArrayLength.Value == 0 {
+		return nil, nil
+	}
+
+	if lengthValueAsCompactArrayLength.ActualLength() > decoder.RemainingBytesCount() {
+		return nil, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected array length to be at most %d (the number of remaining bytes), got %d", decoder.RemainingBytesCount(), lengthValueAsCompactArrayLength.ActualLength()),
+			lengthValue,
+		)
+	}
+
 	elements := make([]T, lengthValueAsCompactArrayLength.ActualLength())
 
 	for i := 0; i < int(lengthValueAsCompactArrayLength.ActualLength()); i++ {
@@ -84,6 +97,13 @@ func decodeArray[T any](decoder *field_decoder.FieldDecoder, decodeFunc func(*fi
 		)
 	}
 
+	if uint64(lengthValueAsInt32.Value) > decoder.RemainingBytesCount() {
+		return nil, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected array length to be at most %d (the number of remaining bytes), got %d", decoder.RemainingBytesCount(), lengthValueAsInt32.Value),
+			lengthValue,
+		)
+	}
+
 	elements := make([]T, lengthValueAsInt32.Value)
 
 	for i := 0; i < int(lengthValueAsInt32.Value); i++ {
@@ -100,3 +120,67 @@ func decodeArray[T any](decoder *field_decoder.FieldDecoder, decodeFunc func(*fi
 
 	return elements, nil
 }
+
+func decodeHeaderForVersion(decoder *field_decoder.FieldDecoder, usesFlexibleHeader bool) (headers.ResponseHeader, field_decoder.FieldDecoderError) {
+	if usesFlexibleHeader {
+		return decodeV1Header(decoder)
+	}
+
+	return decodeV0Header(decoder)
+}
+
+// decodeArrayForVersion decodes a compact array in flexible versions, and an array with an INT32 length otherwise
+func decodeArrayForVersion[T any](decoder *field_decoder.FieldDecoder, decodeFunc func(*field_decoder.FieldDecoder) (T, field_decoder.FieldDecoderError), path string, isFlexible bool) ([]T, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		return decodeCompactArray(decoder, decodeFunc, path)
+	}
+
+	return decodeArray(decoder, decodeFunc, path)
+}
+
+// readStringForVersion reads a COMPACT_STRING in flexible versions, and a STRING otherwise
+func readStringForVersion(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (value.CompactString, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		compactString, err := decoder.ReadCompactStringField(path)
+		if err != nil {
+			return value.CompactString{}, err
+		}
+
+		return value.MustBeCompactString(compactString.Value), nil
+	}
+
+	stringField, err := decoder.ReadStringField(path)
+	if err != nil {
+		return value.CompactString{}, err
+	}
+
+	return value.CompactString{Value: value.MustBeString(stringField.Value).Value}, nil
+}
+
+// readNullableStringForVersion reads a COMPACT_NULLABLE_STRING in flexible versions, and a NULLABLE_STRING otherwise
+func readNullableStringForVersion(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (value.CompactNullableString, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		compactNullableString, err := decoder.ReadCompactNullableStringField(path)
+		if err != nil {
+			return value.CompactNullableString{}, err
+		}
+
+		return value.MustBeCompactNullableString(compactNullableString.Value), nil
+	}
+
+	nullableString, err := decoder.ReadNullableStringField(path)
+	if err != nil {
+		return value.CompactNullableString{}, err
+	}
+
+	return value.CompactNullableString{Value: value.MustBeNullableString(nullableString.Value).Value}, nil
+}
+
+// consumeTagBufferForVersion consumes a tag buffer, tag buffers are only present in flexible versions
+func consumeTagBufferForVersion(decoder *field_decoder.FieldDecoder, isFlexible bool) field_decoder.FieldDecoderError {
+	if !isFlexible {
+		return nil
+	}
+
+	return decoder.ConsumeTagBufferField()
+}
@@ -3,58 +3,102 @@ package response_decoders
 import (
 	"fmt"
 
+	"github.com/codecrafters-io/kafka-tester/internal/field"
 	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
 
-// decodeCompactRecordBatches decodes RecordBatch data structure in Kafka
-func decodeCompactRecordBatches(decoder *field_decoder.FieldDecoder, path string) (kafkaapi.RecordBatches, field_decoder.FieldDecoderError) {
+const (
+	// controlRecordKeyLength is the length of version 0 of a control record key (Version and Type)
+	controlRecordKeyLength = 4
+	// endTransactionMarkerLength is the length of version 0 of a transaction marker value (Version and CoordinatorEpoch)
+	endTransactionMarkerLength = 6
+)
+
+// decodeRecordBatches decodes RecordBatch data structure in Kafka
+// Flexible versions use COMPACT_RECORDS, older versions use RECORDS (with an INT32 size)
+// Logs written in the legacy format (magic 0 or 1) can be mixed with record batches, these are returned as a MessageSet
+func decodeRecordBatches(decoder *field_decoder.FieldDecoder, path string, isFlexible bool) (kafkaapi.RecordBatches, kafkaapi.MessageSet, field_decoder.FieldDecoderError) {
 	decoder.PushPathContext(path)
 	defer decoder.PopPathContext()
 
-	recordBatchesCompactSize, err := decoder.ReadCompactRecordSizeField("Size")
-
+	recordBatchesSizeField, recordBatchesSize, err := readRecordBatchesSize(decoder, isFlexible)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	recordBatchesSize := value.MustBeCompactRecordSize(recordBatchesCompactSize.Value)
-
-	if decoder.RemainingBytesCount() < recordBatchesSize.ActualSize() {
+	if decoder.RemainingBytesCount() < recordBatchesSize {
 		errorMessage := fmt.Errorf(
 			"RecordBatch byte count was decoded as %d bytes, got %d bytes remaining",
-			recordBatchesSize.ActualSize(),
+			recordBatchesSize,
 			decoder.RemainingBytesCount(),
 		)
-		return kafkaapi.RecordBatches{}, decoder.GetDecoderErrorForField(errorMessage, recordBatchesCompactSize)
+		return kafkaapi.RecordBatches{}, nil, decoder.GetDecoderErrorForField(errorMessage, recordBatchesSizeField)
 	}
 
 	recordBatchesStartOffset := decoder.ReadBytesCount()
 
 	allRecordBatches := kafkaapi.RecordBatches{}
+	var legacyMessages kafkaapi.MessageSet
+
+	for decoder.ReadBytesCount() < (recordBatchesStartOffset + recordBatchesSize) {
+		if isLegacyMessageAhead(decoder) {
+			legacyMessage, err := DecodeLegacyMessage(decoder, fmt.Sprintf("MessageSet[%d]", len(legacyMessages)))
+			if err != nil {
+				return nil, nil, err
+			}
+			legacyMessages = append(legacyMessages, legacyMessage)
+			continue
+		}
 
-	index := 0
-	for decoder.ReadBytesCount() < (recordBatchesStartOffset + recordBatchesSize.ActualSize()) {
-		recordBatch, err := DecodeCompactRecordBatch(decoder, fmt.Sprintf("RecordBatches[%d]", index))
+		recordBatch, err := DecodeCompactRecordBatch(decoder, fmt.Sprintf("RecordBatches[%d]", len(allRecordBatches)))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		allRecordBatches = append(allRecordBatches, recordBatch)
-		index += 1
 	}
 
 	// verify record batch size
-	if decoder.ReadBytesCount() != (recordBatchesStartOffset + recordBatchesSize.ActualSize()) {
+	if decoder.ReadBytesCount() != (recordBatchesStartOffset + recordBatchesSize) {
 		errorMessage := fmt.Errorf(
 			"Expected RecordBatch byte count to be %d, got %d instead",
 			(decoder.ReadBytesCount() - recordBatchesStartOffset),
-			recordBatchesSize.ActualSize(),
+			recordBatchesSize,
+		)
+		return nil, nil, decoder.GetDecoderErrorForField(errorMessage, recordBatchesSizeField)
+	}
+
+	return allRecordBatches, legacyMessages, nil
+}
+
+func readRecordBatchesSize(decoder *field_decoder.FieldDecoder, isFlexible bool) (field.Field, uint64, field_decoder.FieldDecoderError) {
+	if isFlexible {
+		recordBatchesCompactSize, err := decoder.ReadCompactRecordSizeField("Size")
+		if err != nil {
+			return field.Field{}, 0, err
+		}
+
+		return recordBatchesCompactSize, value.MustBeCompactRecordSize(recordBatchesCompactSize.Value).ActualSize(), nil
+	}
+
+	recordBatchesSize, err := decoder.ReadInt32Field("Size")
+	if err != nil {
+		return field.Field{}, 0, err
+	}
+
+	// A size of -1 means null records, which we treat the same as no records
+	recordBatchesSizeAsInt32 := value.MustBeInt32(recordBatchesSize.Value).Value
+	if recordBatchesSizeAsInt32 < -1 {
+		return field.Field{}, 0, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected RecordBatches size to be -1 or a non-negative number, got %d", recordBatchesSizeAsInt32),
+			recordBatchesSize,
 		)
-		return nil, decoder.GetDecoderErrorForField(errorMessage, recordBatchesCompactSize)
 	}
 
-	return allRecordBatches, nil
+	return recordBatchesSize, uint64(max(recordBatchesSizeAsInt32, 0)), nil
 }
 
 func DecodeCompactRecordBatch(decoder *field_decoder.FieldDecoder, path string) (kafkaapi.RecordBatch, field_decoder.FieldDecoderError) {
@@ -92,6 +136,14 @@ func DecodeCompactRecordBatch(decoder *field_decoder.FieldDecoder, path string)
 		return kafkaapi.RecordBatch{}, err
 	}
 
+	// Legacy messages (magic 0 and 1) are decoded by DecodeLegacyMessage
+	if magicByteAsInt8 := value.MustBeInt8(magicByte.Value).Value; magicByteAsInt8 != 2 {
+		return kafkaapi.RecordBatch{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected MagicByte of a record batch to be 2, got %d", magicByteAsInt8),
+			magicByte,
+		)
+	}
+
 	crc, err := decoder.ReadInt32Field("CRC")
 	if err != nil {
 		return kafkaapi.RecordBatch{}, err
@@ -132,7 +184,23 @@ func DecodeCompactRecordBatch(decoder *field_decoder.FieldDecoder, path string)
 		return kafkaapi.RecordBatch{}, err
 	}
 
-	records, err := decodeArray(decoder, decodeRecord, "Records")
+	var records []kafkaapi.Record
+	compressedRecords := value.RawBytes{}
+	codec := compression.CodecFromAttributes(value.MustBeInt16(attributes.Value).Value)
+
+	// Control batches hold control records, like the markers that commit or abort a transaction
+	decodeRecordFunc := decodeRecord
+	if value.MustBeInt16(attributes.Value).Value&kafkaapi.RecordBatchIsControlAttribute != 0 {
+		decodeRecordFunc = decodeControlRecord
+	}
+
+	if codec == compression.None {
+		records, err = decodeArray(decoder, decodeRecordFunc, "Records")
+	} else {
+		recordsEndOffset := recordBatchStartOffset + uint64(batchLengthAsInt32.Value)
+		records, compressedRecords, err = decodeCompressedRecords(decoder, codec, recordsEndOffset, batchLength, decodeRecordFunc)
+	}
+
 	if err != nil {
 		return kafkaapi.RecordBatch{}, err
 	}
@@ -153,6 +221,7 @@ func DecodeCompactRecordBatch(decoder *field_decoder.FieldDecoder, path string)
 		ProducerEpoch:        value.MustBeInt16(producerEpoch.Value),
 		BaseSequence:         value.MustBeInt32(baseSequence.Value),
 		Records:              records,
+		CompressedRecords:    compressedRecords,
 	}
 
 	// verify crc
@@ -180,7 +249,92 @@ func DecodeCompactRecordBatch(decoder *field_decoder.FieldDecoder, path string)
 	return decodedRecordBatch, nil
 }
 
+// decodeCompressedRecords decodes the records of a compressed batch, which span until recordsEndOffset
+//
+// The record count is written before the compressed records. The decompressed records show up in the field tree
+// under the same paths as uncompressed records.
+func decodeCompressedRecords(decoder *field_decoder.FieldDecoder, codec compression.Codec, recordsEndOffset uint64, batchLength field.Field, decodeRecordFunc func(*field_decoder.FieldDecoder) (kafkaapi.Record, field_decoder.FieldDecoderError)) ([]kafkaapi.Record, value.RawBytes, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Records")
+	defer decoder.PopPathContext()
+
+	recordsCount, err := decoder.ReadInt32Field("Length")
+	if err != nil {
+		return nil, value.RawBytes{}, err
+	}
+
+	recordsCountAsInt32 := value.MustBeInt32(recordsCount.Value).Value
+	if recordsCountAsInt32 < 0 {
+		return nil, value.RawBytes{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected record count of a compressed record batch to be non-negative, got %d", recordsCountAsInt32),
+			recordsCount,
+		)
+	}
+
+	if recordsEndOffset < decoder.ReadBytesCount() {
+		return nil, value.RawBytes{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected RecordBatch length to include the compressed records, it ends %d bytes before them", decoder.ReadBytesCount()-recordsEndOffset),
+			batchLength,
+		)
+	}
+
+	compressedRecordsField, err := decoder.ReadRawBytes("Compressed", int(recordsEndOffset-decoder.ReadBytesCount()))
+	if err != nil {
+		return nil, value.RawBytes{}, err
+	}
+
+	compressedRecords := value.MustBeRawBytes(compressedRecordsField.Value)
+
+	decompressedRecords, decompressErr := compression.Decompress(codec, compressedRecords.Value)
+	if decompressErr != nil {
+		return nil, value.RawBytes{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected records to be compressed with %s, failed to decompress them: %v", codec, decompressErr),
+			compressedRecordsField,
+		)
+	}
+
+	// Every record takes up at least one byte, this stops a corrupt count from allocating a huge array
+	if int(recordsCountAsInt32) > len(decompressedRecords) {
+		return nil, value.RawBytes{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected %d records, decompressed records are only %d bytes long", recordsCountAsInt32, len(decompressedRecords)),
+			recordsCount,
+		)
+	}
+
+	records := make([]kafkaapi.Record, 0, recordsCountAsInt32)
+
+	err = decoder.DecodeEmbeddedBytes(decompressedRecords, compressedRecordsField, func(recordsDecoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {
+		for i := 0; i < int(recordsCountAsInt32); i++ {
+			recordsDecoder.PushPathContext(fmt.Sprintf("Records[%d]", i))
+			record, err := decodeRecordFunc(recordsDecoder)
+			recordsDecoder.PopPathContext()
+
+			if err != nil {
+				return err
+			}
+
+			records = append(records, record)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, value.RawBytes{}, err
+	}
+
+	return records, compressedRecords, nil
+}
+
 func decodeRecord(decoder *field_decoder.FieldDecoder) (kafkaapi.Record, field_decoder.FieldDecoderError) {
+	return decodeRecordOfBatch(decoder, false)
+}
+
+func decodeControlRecord(decoder *field_decoder.FieldDecoder) (kafkaapi.Record, field_decoder.FieldDecoderError) {
+	return decodeRecordOfBatch(decoder, true)
+}
+
+// decodeRecordOfBatch decodes a record, the key and value of control records are decoded into named fields
+func decodeRecordOfBatch(decoder *field_decoder.FieldDecoder, isControlBatch bool) (kafkaapi.Record, field_decoder.FieldDecoderError) {
 	decoder.PushPathContext("Record")
 	defer decoder.PopPathContext()
 
@@ -233,8 +387,14 @@ func decodeRecord(decoder *field_decoder.FieldDecoder) (kafkaapi.Record, field_d
 
 	// Null key
 	recordKey := value.RawBytes{}
+	var controlRecordType kafkaapi.ControlRecordType
 
-	if keyLengthAsVarint.Value == 0 {
+	if isControlBatch {
+		recordKey, controlRecordType, err = decodeControlRecordKey(decoder, keyLength)
+		if err != nil {
+			return kafkaapi.Record{}, err
+		}
+	} else if keyLengthAsVarint.Value == 0 {
 		// Empty key
 		recordKey = value.NewEmptyRawBytes()
 	} else if keyLengthAsVarint.Value > 0 {
@@ -267,7 +427,12 @@ func decodeRecord(decoder *field_decoder.FieldDecoder) (kafkaapi.Record, field_d
 	recordValue := value.RawBytes{}
 
 	// Value is empty for 0 length and non-empty for positive length
-	if valueLengthAsVarint.Value == 0 {
+	if isControlBatch && controlRecordType.IsTransactionMarker() {
+		recordValue, err = decodeEndTransactionMarker(decoder, valueLength)
+		if err != nil {
+			return kafkaapi.Record{}, err
+		}
+	} else if valueLengthAsVarint.Value == 0 {
 		recordValue = value.NewEmptyRawBytes()
 	} else if valueLengthAsVarint.Value > 0 {
 		recordValueField, err := decoder.ReadRawBytes("Value", int(valueLengthAsVarint.Value))
@@ -300,9 +465,20 @@ func decodeRecord(decoder *field_decoder.FieldDecoder) (kafkaapi.Record, field_d
 		// Empty array in case of 0 ength
 		headers = make([]kafkaapi.RecordHeader, 0)
 	} else if headersArrayLengthAsVarint.Value > 0 {
+		// Every header takes up at least two bytes, this stops a corrupt length from allocating a huge array
+		if uint64(headersArrayLengthAsVarint.Value) > decoder.RemainingBytesCount() {
+			return kafkaapi.Record{}, decoder.GetDecoderErrorForField(
+				fmt.Errorf("Expected length of record headers array to be at most %d (the number of remaining bytes), got %d", decoder.RemainingBytesCount(), headersArrayLengthAsVarint.Value),
+				headersArrayLength,
+			)
+		}
+
 		headers = make([]kafkaapi.RecordHeader, headersArrayLengthAsVarint.Value)
 		for i := 0; i < int(headersArrayLengthAsVarint.Value); i++ {
+			decoder.PushPathContext(fmt.Sprintf("Headers[%d]", i))
 			header, err := decodeRecordHeader(decoder)
+			decoder.PopPathContext()
+
 			if err != nil {
 				return kafkaapi.Record{}, err
 			}
@@ -332,6 +508,96 @@ func decodeRecord(decoder *field_decoder.FieldDecoder) (kafkaapi.Record, field_d
 	}, nil
 }
 
+// decodeControlRecordKey decodes the key of a control record in place, and returns it as raw bytes along with its type
+func decodeControlRecordKey(decoder *field_decoder.FieldDecoder, keyLength field.Field) (value.RawBytes, kafkaapi.ControlRecordType, field_decoder.FieldDecoderError) {
+	keyLengthAsInt := int(value.MustBeVarint(keyLength.Value).Value)
+
+	if keyLengthAsInt < controlRecordKeyLength {
+		return value.RawBytes{}, 0, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected the key of a control record to be at least %d bytes long, got %d", controlRecordKeyLength, keyLengthAsInt),
+			keyLength,
+		)
+	}
+
+	decoder.PushPathContext("Key")
+	defer decoder.PopPathContext()
+
+	version, err := decoder.ReadInt16Field("Version")
+	if err != nil {
+		return value.RawBytes{}, 0, err
+	}
+
+	controlRecordType, err := decoder.ReadInt16Field("Type")
+	if err != nil {
+		return value.RawBytes{}, 0, err
+	}
+
+	controlRecordKey := kafkaapi.ControlRecordKey{
+		Version: value.MustBeInt16(version.Value),
+		Type:    value.MustBeInt16(controlRecordType.Value),
+	}
+
+	keyEncoder := encoder.NewEncoder()
+	controlRecordKey.Encode(keyEncoder)
+
+	key, err := appendUnknownControlRecordFields(decoder, keyEncoder.Bytes(), keyLengthAsInt)
+	if err != nil {
+		return value.RawBytes{}, 0, err
+	}
+
+	return key, kafkaapi.ControlRecordType(controlRecordKey.Type.Value), nil
+}
+
+// decodeEndTransactionMarker decodes the value of a COMMIT or ABORT control record in place, and returns it as raw bytes
+func decodeEndTransactionMarker(decoder *field_decoder.FieldDecoder, valueLength field.Field) (value.RawBytes, field_decoder.FieldDecoderError) {
+	valueLengthAsInt := int(value.MustBeVarint(valueLength.Value).Value)
+
+	if valueLengthAsInt < endTransactionMarkerLength {
+		return value.RawBytes{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected the value of a transaction marker to be at least %d bytes long, got %d", endTransactionMarkerLength, valueLengthAsInt),
+			valueLength,
+		)
+	}
+
+	decoder.PushPathContext("Value")
+	defer decoder.PopPathContext()
+
+	version, err := decoder.ReadInt16Field("Version")
+	if err != nil {
+		return value.RawBytes{}, err
+	}
+
+	coordinatorEpoch, err := decoder.ReadInt32Field("CoordinatorEpoch")
+	if err != nil {
+		return value.RawBytes{}, err
+	}
+
+	endTransactionMarker := kafkaapi.EndTransactionMarker{
+		Version:          value.MustBeInt16(version.Value),
+		CoordinatorEpoch: value.MustBeInt32(coordinatorEpoch.Value),
+	}
+
+	valueEncoder := encoder.NewEncoder()
+	endTransactionMarker.Encode(valueEncoder)
+
+	return appendUnknownControlRecordFields(decoder, valueEncoder.Bytes(), valueLengthAsInt)
+}
+
+// appendUnknownControlRecordFields reads the fields that newer versions of a control record key or value have added,
+// these are kept as raw bytes so that the key or value can be encoded as-is
+func appendUnknownControlRecordFields(decoder *field_decoder.FieldDecoder, knownFieldsBytes []byte, length int) (value.RawBytes, field_decoder.FieldDecoderError) {
+	if len(knownFieldsBytes) == length {
+		return value.RawBytes{Value: knownFieldsBytes}, nil
+	}
+
+	unknownFields, err := decoder.ReadRawBytes("UnknownFields", length-len(knownFieldsBytes))
+	if err != nil {
+		return value.RawBytes{}, err
+	}
+
+	return value.RawBytes{Value: append(knownFieldsBytes, value.MustBeRawBytes(unknownFields.Value).Value...)}, nil
+}
+
 func decodeRecordHeader(decoder *field_decoder.FieldDecoder) (kafkaapi.RecordHeader, field_decoder.FieldDecoderError) {
 	decoder.PushPathContext("RecordHeader")
 	defer decoder.PopPathContext()
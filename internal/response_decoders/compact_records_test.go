@@ -0,0 +1,122 @@
+package response_decoders
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeRecordsWithSize(encodeFunc func(*encoder.Encoder)) []byte {
+	recordsEncoder := encoder.NewEncoder()
+	encodeFunc(recordsEncoder)
+
+	sizeEncoder := encoder.NewEncoder()
+	sizeEncoder.WriteInt32(int32(len(recordsEncoder.Bytes())))
+	sizeEncoder.WriteRawBytes(recordsEncoder.Bytes())
+
+	return sizeEncoder.Bytes()
+}
+
+func TestDecodeRecordBatchesWithTransactionMarker(t *testing.T) {
+	recordBatches := kafkaapi.RecordBatches{
+		{
+			BaseOffset: value.Int64{Value: 0},
+			Magic:      value.Int8{Value: 2},
+			Attributes: value.Int16{Value: kafkaapi.RecordBatchIsTransactionalAttribute},
+			ProducerId: value.Int64{Value: 1000},
+			Records:    []kafkaapi.Record{{Value: value.RawBytes{Value: []byte("foo")}, Headers: []kafkaapi.RecordHeader{}}},
+		},
+		{
+			BaseOffset:   value.Int64{Value: 1},
+			Magic:        value.Int8{Value: 2},
+			Attributes:   value.Int16{Value: kafkaapi.RecordBatchIsTransactionalAttribute | kafkaapi.RecordBatchIsControlAttribute},
+			ProducerId:   value.Int64{Value: 1000},
+			BaseSequence: value.Int32{Value: -1},
+			Records:      []kafkaapi.Record{kafkaapi.NewTransactionMarkerRecord(kafkaapi.ControlRecordTypeCommit, 7)},
+		},
+	}
+
+	encodedBytes := encodeRecordsWithSize(recordBatches.Encode)
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes)
+	decodedRecordBatches, _, err := decodeRecordBatches(decoder, "RecordBatches", false)
+
+	assert.Nil(t, err)
+	assert.Len(t, decodedRecordBatches, 2)
+	assert.True(t, decodedRecordBatches[0].IsTransactional())
+	assert.False(t, decodedRecordBatches[0].IsControl())
+	assert.True(t, decodedRecordBatches[1].IsControl())
+
+	decodedFieldValues := map[string]string{}
+	for _, decodedField := range decoder.DecodedFields() {
+		decodedFieldValues[decodedField.Path.String()] = decodedField.Value.String()
+	}
+
+	markerPath := "RecordBatches.RecordBatches[1].Records.Records[0].Record"
+	assert.Equal(t, value.Int16{Value: 1}.String(), decodedFieldValues[markerPath+".Key.Type"])
+	assert.Equal(t, value.Int32{Value: 7}.String(), decodedFieldValues[markerPath+".Value.CoordinatorEpoch"])
+
+	reencodedBytes := encodeRecordsWithSize(func(pe *encoder.Encoder) {
+		kafkaapi.RecordBatches(decodedRecordBatches).Encode(pe)
+	})
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestDecodeCompactRecordBatchFromReader(t *testing.T) {
+	records := []kafkaapi.Record{}
+	for i := range 10 {
+		// Large values make the batch span multiple chunks of the streaming buffer
+		records = append(records, kafkaapi.Record{
+			OffsetDelta: value.Varint{Value: int64(i)},
+			Value:       value.RawBytes{Value: bytes.Repeat([]byte{byte('a' + i)}, 10*1024)},
+			Headers:     []kafkaapi.RecordHeader{},
+		})
+	}
+
+	recordBatch := kafkaapi.RecordBatch{
+		Magic:   value.Int8{Value: 2},
+		Records: records,
+	}
+
+	pe := encoder.NewEncoder()
+	recordBatch.Encode(pe)
+	encodedBytes := pe.Bytes()
+
+	expectedRecordBatch, err := DecodeCompactRecordBatch(field_decoder.NewFieldDecoder(encodedBytes), "RecordBatch")
+	assert.Nil(t, err)
+
+	decoder := field_decoder.NewFieldDecoderFromReader(iotest.HalfReader(bytes.NewReader(encodedBytes)), uint64(len(encodedBytes)))
+	decodedRecordBatch, err := DecodeCompactRecordBatch(decoder, "RecordBatch")
+
+	assert.Nil(t, err)
+	assert.Nil(t, decoder.ReadErr())
+	assert.Equal(t, expectedRecordBatch, decodedRecordBatch)
+	assert.Equal(t, uint64(len(encodedBytes)), decoder.ReadBytesCount())
+
+	// A file that's shorter than expected should be reported as a read error, not a panic
+	truncatedDecoder := field_decoder.NewFieldDecoderFromReader(bytes.NewReader(encodedBytes[:len(encodedBytes)-100]), uint64(len(encodedBytes)))
+	_, err = DecodeCompactRecordBatch(truncatedDecoder, "RecordBatch")
+
+	assert.NotNil(t, err)
+	assert.NotNil(t, truncatedDecoder.ReadErr())
+}
+
+func TestDecodeFromReaderStopsAtReadFailure(t *testing.T) {
+	// The reader fails after 4 of the 8 bytes that an INT64 needs
+	reader := io.MultiReader(bytes.NewReader([]byte{0, 0, 0, 1}), iotest.ErrReader(errors.New("disk failure")))
+
+	decoder := field_decoder.NewFieldDecoderFromReader(reader, 8)
+	_, err := decoder.ReadInt64Field("BaseOffset")
+
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, decoder.ReadErr(), "disk failure")
+	assert.Equal(t, uint64(0), decoder.ReadBytesCount())
+}
@@ -3,61 +3,79 @@ package response_decoders
 import (
 	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
 
+// DecodeApiVersionsResponse decodes a response to an ApiVersions v4 request, the version ApiVersionsRequestBuilder uses by default
 func DecodeApiVersionsResponse(decoder *field_decoder.FieldDecoder) (kafkaapi.ApiVersionsResponse, field_decoder.FieldDecoderError) {
-	decoder.PushPathContext("ApiVersionsResponse")
-	defer decoder.PopPathContext()
-
-	header, err := decodeV0Header(decoder)
-	if err != nil {
-		return kafkaapi.ApiVersionsResponse{}, err
-	}
+	return DecodeApiVersionsResponseForVersion(4)(decoder)
+}
 
-	body, err := decodeApiVersionsResponseBody(decoder)
-	if err != nil {
-		return kafkaapi.ApiVersionsResponse{}, err
+// DecodeApiVersionsResponseForVersion returns a function that decodes a response to an ApiVersions request with the given version
+func DecodeApiVersionsResponseForVersion(version int16) func(*field_decoder.FieldDecoder) (kafkaapi.ApiVersionsResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (kafkaapi.ApiVersionsResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("ApiVersionsResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeV0Header(decoder)
+		if err != nil {
+			return kafkaapi.ApiVersionsResponse{}, err
+		}
+
+		body, err := decodeApiVersionsResponseBody(decoder, version)
+		if err != nil {
+			return kafkaapi.ApiVersionsResponse{}, err
+		}
+
+		return kafkaapi.ApiVersionsResponse{
+			Header: header,
+			Body:   body,
+		}, nil
 	}
-
-	return kafkaapi.ApiVersionsResponse{
-		Header: header,
-		Body:   body,
-	}, nil
 }
 
-func decodeApiVersionsResponseBody(decoder *field_decoder.FieldDecoder) (kafkaapi.ApiVersionsResponseBody, field_decoder.FieldDecoderError) {
+func decodeApiVersionsResponseBody(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.ApiVersionsResponseBody, field_decoder.FieldDecoderError) {
 	decoder.PushPathContext("Body")
 	defer decoder.PopPathContext()
 
+	isFlexible := utils.IsFlexibleVersion(18, version)
+
 	errorCode, err := decoder.ReadInt16Field("ErrorCode")
 	if err != nil {
 		return kafkaapi.ApiVersionsResponseBody{}, err
 	}
 
-	apiKeyEntries, err := decodeCompactArray(decoder, decodeApiVersionsResponseApiKeyEntry, "ApiKeys")
+	apiKeyEntries, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.ApiKeyEntry, field_decoder.FieldDecoderError) {
+		return decodeApiVersionsResponseApiKeyEntry(decoder, isFlexible)
+	}, "ApiKeys", isFlexible)
 	if err != nil {
 		return kafkaapi.ApiVersionsResponseBody{}, err
 	}
 
-	throttleTimeMs, err := decoder.ReadInt32Field("ThrottleTimeMs")
-	if err != nil {
-		return kafkaapi.ApiVersionsResponseBody{}, err
+	body := kafkaapi.ApiVersionsResponseBody{
+		Version:   version,
+		ErrorCode: value.MustBeInt16(errorCode.Value),
+		ApiKeys:   apiKeyEntries,
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	if version >= 1 {
+		throttleTimeMs, err := decoder.ReadInt32Field("ThrottleTimeMs")
+		if err != nil {
+			return kafkaapi.ApiVersionsResponseBody{}, err
+		}
+
+		body.ThrottleTimeMs = value.MustBeInt32(throttleTimeMs.Value)
+	}
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.ApiVersionsResponseBody{}, err
 	}
 
-	return kafkaapi.ApiVersionsResponseBody{
-		Version:        4,
-		ErrorCode:      value.MustBeInt16(errorCode.Value),
-		ApiKeys:        apiKeyEntries,
-		ThrottleTimeMs: value.MustBeInt32(throttleTimeMs.Value),
-	}, nil
+	return body, nil
 }
 
-func decodeApiVersionsResponseApiKeyEntry(decoder *field_decoder.FieldDecoder) (kafkaapi.ApiKeyEntry, field_decoder.FieldDecoderError) {
+func decodeApiVersionsResponseApiKeyEntry(decoder *field_decoder.FieldDecoder, isFlexible bool) (kafkaapi.ApiKeyEntry, field_decoder.FieldDecoderError) {
 	apiKey, err := decoder.ReadInt16Field("APIKey")
 	if err != nil {
 		return kafkaapi.ApiKeyEntry{}, err
@@ -73,7 +91,7 @@ func decodeApiVersionsResponseApiKeyEntry(decoder *field_decoder.FieldDecoder) (
 		return kafkaapi.ApiKeyEntry{}, err
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.ApiKeyEntry{}, err
 	}
 
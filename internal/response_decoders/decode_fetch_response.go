@@ -3,90 +3,127 @@ package response_decoders
 import (
 	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
 
+// DecodeFetchResponse decodes a response to a Fetch v16 request, the version FetchRequestBuilder uses by default
 func DecodeFetchResponse(decoder *field_decoder.FieldDecoder) (
 	kafkaapi.FetchResponse,
 	field_decoder.FieldDecoderError,
 ) {
-	decoder.PushPathContext("FetchResponse")
-	defer decoder.PopPathContext()
+	return DecodeFetchResponseForVersion(16)(decoder)
+}
 
-	header, err := decodeV1Header(decoder)
-	if err != nil {
-		return kafkaapi.FetchResponse{}, err
-	}
+// DecodeFetchResponseForVersion returns a function that decodes a response to a Fetch request with the given version
+func DecodeFetchResponseForVersion(version int16) func(*field_decoder.FieldDecoder) (kafkaapi.FetchResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (kafkaapi.FetchResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("FetchResponse")
+		defer decoder.PopPathContext()
 
-	body, err := decodeFetchResponseBody(decoder)
-	if err != nil {
-		return kafkaapi.FetchResponse{}, err
-	}
-	return kafkaapi.FetchResponse{
-		Header: header,
-		Body:   body,
-	}, nil
+		header, err := decodeHeaderForVersion(decoder, utils.UsesFlexibleResponseHeader(1, version))
+		if err != nil {
+			return kafkaapi.FetchResponse{}, err
+		}
+
+		body, err := decodeFetchResponseBody(decoder, version)
+		if err != nil {
+			return kafkaapi.FetchResponse{}, err
+		}
 
+		return kafkaapi.FetchResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
 }
 
-func decodeFetchResponseBody(decoder *field_decoder.FieldDecoder) (kafkaapi.FetchResponseBody, field_decoder.FieldDecoderError) {
+func decodeFetchResponseBody(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.FetchResponseBody, field_decoder.FieldDecoderError) {
 	decoder.PushPathContext("Body")
 	defer decoder.PopPathContext()
 
-	throttleTimeMs, err := decoder.ReadInt32Field("ThrottleTimeMS")
-	if err != nil {
-		return kafkaapi.FetchResponseBody{}, err
-	}
+	isFlexible := utils.IsFlexibleVersion(1, version)
+	body := kafkaapi.FetchResponseBody{}
 
-	errorCode, err := decoder.ReadInt16Field("ErrorCode")
-	if err != nil {
-		return kafkaapi.FetchResponseBody{}, err
+	if version >= 1 {
+		throttleTimeMs, err := decoder.ReadInt32Field("ThrottleTimeMS")
+		if err != nil {
+			return kafkaapi.FetchResponseBody{}, err
+		}
+
+		body.ThrottleTimeMs = value.MustBeInt32(throttleTimeMs.Value)
 	}
 
-	sessionId, err := decoder.ReadInt32Field("SessionID")
-	if err != nil {
-		return kafkaapi.FetchResponseBody{}, err
+	if version >= 7 {
+		errorCode, err := decoder.ReadInt16Field("ErrorCode")
+		if err != nil {
+			return kafkaapi.FetchResponseBody{}, err
+		}
+
+		sessionId, err := decoder.ReadInt32Field("SessionID")
+		if err != nil {
+			return kafkaapi.FetchResponseBody{}, err
+		}
+
+		body.ErrorCode = value.MustBeInt16(errorCode.Value)
+		body.SessionId = value.MustBeInt32(sessionId.Value)
 	}
 
-	topicResponses, err := decodeCompactArray(decoder, decodeFetchTopic, "Topics")
+	topicResponses, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.TopicResponse, field_decoder.FieldDecoderError) {
+		return decodeFetchTopic(decoder, version)
+	}, "Topics", isFlexible)
 	if err != nil {
 		return kafkaapi.FetchResponseBody{}, err
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	body.TopicResponses = topicResponses
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.FetchResponseBody{}, err
 	}
 
-	return kafkaapi.FetchResponseBody{
-		ThrottleTimeMs: value.MustBeInt32(throttleTimeMs.Value),
-		ErrorCode:      value.MustBeInt16(errorCode.Value),
-		SessionId:      value.MustBeInt32(sessionId.Value),
-		TopicResponses: topicResponses,
-	}, nil
+	return body, nil
 }
 
-func decodeFetchTopic(decoder *field_decoder.FieldDecoder) (kafkaapi.TopicResponse, field_decoder.FieldDecoderError) {
-	topicUUID, err := decoder.ReadUUIDField("UUID")
-	if err != nil {
-		return kafkaapi.TopicResponse{}, err
+func decodeFetchTopic(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.TopicResponse, field_decoder.FieldDecoderError) {
+	isFlexible := utils.IsFlexibleVersion(1, version)
+	topicResponse := kafkaapi.TopicResponse{}
+
+	if version >= 13 {
+		topicUUID, err := decoder.ReadUUIDField("UUID")
+		if err != nil {
+			return kafkaapi.TopicResponse{}, err
+		}
+
+		topicResponse.UUID = value.MustBeUUID(topicUUID.Value)
+	} else {
+		topicName, err := readStringForVersion(decoder, "Name", isFlexible)
+		if err != nil {
+			return kafkaapi.TopicResponse{}, err
+		}
+
+		topicResponse.Name = topicName
 	}
 
-	partitions, err := decodeCompactArray(decoder, decodeFetchPartition, "Partitions")
+	partitions, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.PartitionResponse, field_decoder.FieldDecoderError) {
+		return decodeFetchPartition(decoder, version)
+	}, "Partitions", isFlexible)
 	if err != nil {
 		return kafkaapi.TopicResponse{}, err
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	topicResponse.PartitionResponses = partitions
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.TopicResponse{}, err
 	}
 
-	return kafkaapi.TopicResponse{
-		UUID:               value.MustBeUUID(topicUUID.Value),
-		PartitionResponses: partitions,
-	}, nil
+	return topicResponse, nil
 }
 
-func decodeFetchPartition(decoder *field_decoder.FieldDecoder) (kafkaapi.PartitionResponse, field_decoder.FieldDecoderError) {
+func decodeFetchPartition(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.PartitionResponse, field_decoder.FieldDecoderError) {
+	isFlexible := utils.IsFlexibleVersion(1, version)
+
 	id, err := decoder.ReadInt32Field("Id")
 	if err != nil {
 		return kafkaapi.PartitionResponse{}, err
@@ -102,48 +139,68 @@ func decodeFetchPartition(decoder *field_decoder.FieldDecoder) (kafkaapi.Partiti
 		return kafkaapi.PartitionResponse{}, err
 	}
 
-	lastStableOffset, err := decoder.ReadInt64Field("LastStableOffset")
-	if err != nil {
-		return kafkaapi.PartitionResponse{}, err
+	partitionResponse := kafkaapi.PartitionResponse{
+		Id:            value.MustBeInt32(id.Value),
+		ErrorCode:     value.MustBeInt16(errorCode.Value),
+		HighWatermark: value.MustBeInt64(highWaterMark.Value),
 	}
 
-	logStartOffset, err := decoder.ReadInt64Field("LogStartOffset")
-	if err != nil {
-		return kafkaapi.PartitionResponse{}, err
+	if version >= 4 {
+		lastStableOffset, err := decoder.ReadInt64Field("LastStableOffset")
+		if err != nil {
+			return kafkaapi.PartitionResponse{}, err
+		}
+
+		partitionResponse.LastStableOffset = value.MustBeInt64(lastStableOffset.Value)
 	}
 
-	abortedTransactions, err := decodeCompactArray(decoder, decodeAbortedTransaction, "AbortedTransactions")
-	if err != nil {
-		return kafkaapi.PartitionResponse{}, err
+	if version >= 5 {
+		logStartOffset, err := decoder.ReadInt64Field("LogStartOffset")
+		if err != nil {
+			return kafkaapi.PartitionResponse{}, err
+		}
+
+		partitionResponse.LogStartOffset = value.MustBeInt64(logStartOffset.Value)
 	}
 
-	preferredReadReplica, err := decoder.ReadInt32Field("PreferredReadReplica")
-	if err != nil {
-		return kafkaapi.PartitionResponse{}, err
+	if version >= 4 {
+		abortedTransactions, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.AbortedTransaction, field_decoder.FieldDecoderError) {
+			return decodeAbortedTransaction(decoder, isFlexible)
+		}, "AbortedTransactions", isFlexible)
+		if err != nil {
+			return kafkaapi.PartitionResponse{}, err
+		}
+
+		partitionResponse.AbortedTransactions = abortedTransactions
+	}
+
+	if version >= 11 {
+		preferredReadReplica, err := decoder.ReadInt32Field("PreferredReadReplica")
+		if err != nil {
+			return kafkaapi.PartitionResponse{}, err
+		}
+
+		partitionResponse.PreferredReadReplica = value.MustBeInt32(preferredReadReplica.Value)
 	}
 
-	recordBatches, err := decodeCompactRecordBatches(decoder, "RecordBatches")
+	recordBatches, legacyMessages, err := decodeRecordBatches(decoder, "RecordBatches", isFlexible)
 	if err != nil {
 		return kafkaapi.PartitionResponse{}, err
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
-		return kafkaapi.PartitionResponse{}, err
+	partitionResponse.RecordBatches = recordBatches
+	partitionResponse.MessageSet = legacyMessages
+
+	if isFlexible {
+		if _, err := decoder.ReadTaggedFieldsField(fetchPartitionKnownTaggedFields); err != nil {
+			return kafkaapi.PartitionResponse{}, err
+		}
 	}
 
-	return kafkaapi.PartitionResponse{
-		Id:                   value.MustBeInt32(id.Value),
-		ErrorCode:            value.MustBeInt16(errorCode.Value),
-		HighWatermark:        value.MustBeInt64(highWaterMark.Value),
-		LastStableOffset:     value.MustBeInt64(lastStableOffset.Value),
-		LogStartOffset:       value.MustBeInt64(logStartOffset.Value),
-		AbortedTransactions:  abortedTransactions,
-		PreferredReadReplica: value.MustBeInt32(preferredReadReplica.Value),
-		RecordBatches:        recordBatches,
-	}, nil
+	return partitionResponse, nil
 }
 
-func decodeAbortedTransaction(decoder *field_decoder.FieldDecoder) (kafkaapi.AbortedTransaction, field_decoder.FieldDecoderError) {
+func decodeAbortedTransaction(decoder *field_decoder.FieldDecoder, isFlexible bool) (kafkaapi.AbortedTransaction, field_decoder.FieldDecoderError) {
 	producerID, err := decoder.ReadInt64Field("ProducerID")
 	if err != nil {
 		return kafkaapi.AbortedTransaction{}, err
@@ -154,8 +211,65 @@ func decodeAbortedTransaction(decoder *field_decoder.FieldDecoder) (kafkaapi.Abo
 		return kafkaapi.AbortedTransaction{}, err
 	}
 
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
+		return kafkaapi.AbortedTransaction{}, err
+	}
+
 	return kafkaapi.AbortedTransaction{
 		ProducerID:  value.MustBeInt64(producerID.Value),
 		FirstOffset: value.MustBeInt64(firstOffset.Value),
 	}, nil
 }
+
+// fetchPartitionKnownTaggedFields are the tagged fields a broker can include in a partition response
+// We don't assert on these, they're decoded so that they show up in the field tree
+var fetchPartitionKnownTaggedFields = map[uint64]field_decoder.KnownTaggedField{
+	0: {Name: "DivergingEpoch", DecodeFunc: decodeFetchDivergingEpoch},
+	1: {Name: "CurrentLeader", DecodeFunc: decodeFetchCurrentLeader},
+	2: {Name: "SnapshotId", DecodeFunc: decodeFetchSnapshotId},
+}
+
+func decodeFetchDivergingEpoch(decoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {
+	decoder.PushPathContext("DivergingEpoch")
+	defer decoder.PopPathContext()
+
+	if _, err := decoder.ReadInt32Field("Epoch"); err != nil {
+		return err
+	}
+
+	if _, err := decoder.ReadInt64Field("EndOffset"); err != nil {
+		return err
+	}
+
+	return decoder.ConsumeTagBufferField()
+}
+
+func decodeFetchCurrentLeader(decoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {
+	decoder.PushPathContext("CurrentLeader")
+	defer decoder.PopPathContext()
+
+	if _, err := decoder.ReadInt32Field("LeaderId"); err != nil {
+		return err
+	}
+
+	if _, err := decoder.ReadInt32Field("LeaderEpoch"); err != nil {
+		return err
+	}
+
+	return decoder.ConsumeTagBufferField()
+}
+
+func decodeFetchSnapshotId(decoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {
+	decoder.PushPathContext("SnapshotId")
+	defer decoder.PopPathContext()
+
+	if _, err := decoder.ReadInt64Field("EndOffset"); err != nil {
+		return err
+	}
+
+	if _, err := decoder.ReadInt32Field("Epoch"); err != nil {
+		return err
+	}
+
+	return decoder.ConsumeTagBufferField()
+}
@@ -3,78 +3,102 @@ package response_decoders
 import (
 	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
 
+// DecodeProduceResponse decodes a response to a Produce v11 request, the version ProduceRequestBuilder uses by default
 func DecodeProduceResponse(decoder *field_decoder.FieldDecoder) (
 	kafkaapi.ProduceResponse,
 	field_decoder.FieldDecoderError,
 ) {
-	decoder.PushPathContext("ProduceResponse")
-	defer decoder.PopPathContext()
+	return DecodeProduceResponseForVersion(11)(decoder)
+}
 
-	header, err := decodeV1Header(decoder)
-	if err != nil {
-		return kafkaapi.ProduceResponse{}, err
-	}
+// DecodeProduceResponseForVersion returns a function that decodes a response to a Produce request with the given version
+func DecodeProduceResponseForVersion(version int16) func(*field_decoder.FieldDecoder) (kafkaapi.ProduceResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("ProduceResponse")
+		defer decoder.PopPathContext()
 
-	body, err := decodeProduceResponseBody(decoder)
-	if err != nil {
-		return kafkaapi.ProduceResponse{}, err
-	}
-	return kafkaapi.ProduceResponse{
-		Header: header,
-		Body:   body,
-	}, nil
+		header, err := decodeHeaderForVersion(decoder, utils.UsesFlexibleResponseHeader(0, version))
+		if err != nil {
+			return kafkaapi.ProduceResponse{}, err
+		}
+
+		body, err := decodeProduceResponseBody(decoder, version)
+		if err != nil {
+			return kafkaapi.ProduceResponse{}, err
+		}
 
+		return kafkaapi.ProduceResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
 }
 
-func decodeProduceResponseBody(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponseBody, field_decoder.FieldDecoderError) {
+func decodeProduceResponseBody(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.ProduceResponseBody, field_decoder.FieldDecoderError) {
 	decoder.PushPathContext("Body")
 	defer decoder.PopPathContext()
 
-	topics, err := decodeCompactArray(decoder, decodeProduceResponseTopicData, "Topics")
+	isFlexible := utils.IsFlexibleVersion(0, version)
+
+	topics, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponseTopicData, field_decoder.FieldDecoderError) {
+		return decodeProduceResponseTopicData(decoder, version)
+	}, "Topics", isFlexible)
 	if err != nil {
 		return kafkaapi.ProduceResponseBody{}, err
 	}
 
-	throttleTimeMs, err := decoder.ReadInt32Field("ThrottleTimeMS")
-	if err != nil {
-		return kafkaapi.ProduceResponseBody{}, err
+	body := kafkaapi.ProduceResponseBody{
+		Topics: topics,
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	if version >= 1 {
+		throttleTimeMs, err := decoder.ReadInt32Field("ThrottleTimeMS")
+		if err != nil {
+			return kafkaapi.ProduceResponseBody{}, err
+		}
+
+		body.ThrottleTimeMs = value.MustBeInt32(throttleTimeMs.Value)
+	}
+
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.ProduceResponseBody{}, err
 	}
 
-	return kafkaapi.ProduceResponseBody{
-		Topics:         topics,
-		ThrottleTimeMs: value.MustBeInt32(throttleTimeMs.Value),
-	}, nil
+	return body, nil
 }
 
-func decodeProduceResponseTopicData(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponseTopicData, field_decoder.FieldDecoderError) {
-	name, err := decoder.ReadCompactStringField("Name")
+func decodeProduceResponseTopicData(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.ProduceResponseTopicData, field_decoder.FieldDecoderError) {
+	isFlexible := utils.IsFlexibleVersion(0, version)
+
+	name, err := readStringForVersion(decoder, "Name", isFlexible)
 	if err != nil {
 		return kafkaapi.ProduceResponseTopicData{}, err
 	}
 
-	partitions, err := decodeCompactArray(decoder, decodeProduceResponsePartitionData, "Partitions")
+	partitions, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponsePartitionData, field_decoder.FieldDecoderError) {
+		return decodeProduceResponsePartitionData(decoder, version)
+	}, "Partitions", isFlexible)
 	if err != nil {
 		return kafkaapi.ProduceResponseTopicData{}, err
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.ProduceResponseTopicData{}, err
 	}
 
 	return kafkaapi.ProduceResponseTopicData{
-		Name:       value.MustBeCompactString(name.Value),
+		Name:       name,
 		Partitions: partitions,
 	}, nil
 }
 
-func decodeProduceResponsePartitionData(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponsePartitionData, field_decoder.FieldDecoderError) {
+func decodeProduceResponsePartitionData(decoder *field_decoder.FieldDecoder, version int16) (kafkaapi.ProduceResponsePartitionData, field_decoder.FieldDecoderError) {
+	isFlexible := utils.IsFlexibleVersion(0, version)
+
 	id, err := decoder.ReadInt32Field("ID")
 	if err != nil {
 		return kafkaapi.ProduceResponsePartitionData{}, err
@@ -90,58 +114,71 @@ func decodeProduceResponsePartitionData(decoder *field_decoder.FieldDecoder) (ka
 		return kafkaapi.ProduceResponsePartitionData{}, err
 	}
 
-	logAppendTimeMs, err := decoder.ReadInt64Field("LogAppendTimeMs")
-	if err != nil {
-		return kafkaapi.ProduceResponsePartitionData{}, err
+	partitionData := kafkaapi.ProduceResponsePartitionData{
+		Id:         value.MustBeInt32(id.Value),
+		ErrorCode:  value.MustBeInt16(errorCode.Value),
+		BaseOffset: value.MustBeInt64(baseOffset.Value),
 	}
 
-	logStartOffset, err := decoder.ReadInt64Field("LogStartOffset")
-	if err != nil {
-		return kafkaapi.ProduceResponsePartitionData{}, err
+	if version >= 2 {
+		logAppendTimeMs, err := decoder.ReadInt64Field("LogAppendTimeMs")
+		if err != nil {
+			return kafkaapi.ProduceResponsePartitionData{}, err
+		}
+
+		partitionData.LogAppendTimeMs = value.MustBeInt64(logAppendTimeMs.Value)
 	}
 
-	recordErrors, err := decodeCompactArray(decoder, decodeProduceResponseRecordErrorsData, "RecordErrors")
-	if err != nil {
-		return kafkaapi.ProduceResponsePartitionData{}, err
+	if version >= 5 {
+		logStartOffset, err := decoder.ReadInt64Field("LogStartOffset")
+		if err != nil {
+			return kafkaapi.ProduceResponsePartitionData{}, err
+		}
+
+		partitionData.LogStartOffset = value.MustBeInt64(logStartOffset.Value)
 	}
 
-	errorMessage, err := decoder.ReadCompactNullableStringField("ErrorMessage")
-	if err != nil {
-		return kafkaapi.ProduceResponsePartitionData{}, err
+	if version >= 8 {
+		recordErrors, err := decodeArrayForVersion(decoder, func(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponseRecordErrorData, field_decoder.FieldDecoderError) {
+			return decodeProduceResponseRecordErrorsData(decoder, isFlexible)
+		}, "RecordErrors", isFlexible)
+		if err != nil {
+			return kafkaapi.ProduceResponsePartitionData{}, err
+		}
+
+		errorMessage, err := readNullableStringForVersion(decoder, "ErrorMessage", isFlexible)
+		if err != nil {
+			return kafkaapi.ProduceResponsePartitionData{}, err
+		}
+
+		partitionData.RecordErrors = recordErrors
+		partitionData.ErrorMessage = errorMessage
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.ProduceResponsePartitionData{}, err
 	}
 
-	return kafkaapi.ProduceResponsePartitionData{
-		Id:              value.MustBeInt32(id.Value),
-		ErrorCode:       value.MustBeInt16(errorCode.Value),
-		BaseOffset:      value.MustBeInt64(baseOffset.Value),
-		LogAppendTimeMs: value.MustBeInt64(logAppendTimeMs.Value),
-		LogStartOffset:  value.MustBeInt64(logStartOffset.Value),
-		RecordErrors:    recordErrors,
-		ErrorMessage:    value.MustBeCompactNullableString(errorMessage.Value),
-	}, nil
+	return partitionData, nil
 }
 
-func decodeProduceResponseRecordErrorsData(decoder *field_decoder.FieldDecoder) (kafkaapi.ProduceResponseRecordErrorData, field_decoder.FieldDecoderError) {
+func decodeProduceResponseRecordErrorsData(decoder *field_decoder.FieldDecoder, isFlexible bool) (kafkaapi.ProduceResponseRecordErrorData, field_decoder.FieldDecoderError) {
 	batchIndex, err := decoder.ReadInt32Field("BatchIndex")
 	if err != nil {
 		return kafkaapi.ProduceResponseRecordErrorData{}, err
 	}
 
-	errorMessage, err := decoder.ReadCompactNullableStringField("ErrorMessage")
+	errorMessage, err := readNullableStringForVersion(decoder, "ErrorMessage", isFlexible)
 	if err != nil {
 		return kafkaapi.ProduceResponseRecordErrorData{}, err
 	}
 
-	if err := decoder.ConsumeTagBufferField(); err != nil {
+	if err := consumeTagBufferForVersion(decoder, isFlexible); err != nil {
 		return kafkaapi.ProduceResponseRecordErrorData{}, err
 	}
 
 	return kafkaapi.ProduceResponseRecordErrorData{
 		BatchIndex:   value.MustBeInt32(batchIndex.Value),
-		ErrorMessage: value.MustBeCompactNullableString(errorMessage.Value),
+		ErrorMessage: errorMessage,
 	}, nil
 }
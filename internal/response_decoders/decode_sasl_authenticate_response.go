@@ -0,0 +1,64 @@
+package response_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func DecodeSaslAuthenticateResponse(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslAuthenticateResponse, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("SaslAuthenticateResponse")
+	defer decoder.PopPathContext()
+
+	header, err := decodeV1Header(decoder)
+	if err != nil {
+		return kafkaapi.SaslAuthenticateResponse{}, err
+	}
+
+	body, err := decodeSaslAuthenticateResponseBody(decoder)
+	if err != nil {
+		return kafkaapi.SaslAuthenticateResponse{}, err
+	}
+
+	return kafkaapi.SaslAuthenticateResponse{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeSaslAuthenticateResponseBody(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslAuthenticateResponseBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+
+	errorCode, err := decoder.ReadInt16Field("ErrorCode")
+	if err != nil {
+		return kafkaapi.SaslAuthenticateResponseBody{}, err
+	}
+
+	errorMessage, err := decoder.ReadCompactNullableStringField("ErrorMessage")
+	if err != nil {
+		return kafkaapi.SaslAuthenticateResponseBody{}, err
+	}
+
+	authBytes, err := decoder.ReadCompactBytesField("AuthBytes")
+	if err != nil {
+		return kafkaapi.SaslAuthenticateResponseBody{}, err
+	}
+
+	sessionLifetimeMs, err := decoder.ReadInt64Field("SessionLifetimeMs")
+	if err != nil {
+		return kafkaapi.SaslAuthenticateResponseBody{}, err
+	}
+
+	if err := decoder.ConsumeTagBufferField(); err != nil {
+		return kafkaapi.SaslAuthenticateResponseBody{}, err
+	}
+
+	return kafkaapi.SaslAuthenticateResponseBody{
+		Version:           2,
+		ErrorCode:         value.MustBeInt16(errorCode.Value),
+		ErrorMessage:      value.MustBeCompactNullableString(errorMessage.Value),
+		AuthBytes:         value.MustBeCompactBytes(authBytes.Value),
+		SessionLifetimeMs: value.MustBeInt64(sessionLifetimeMs.Value),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package response_decoders
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func DecodeSaslHandshakeResponse(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslHandshakeResponse, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("SaslHandshakeResponse")
+	defer decoder.PopPathContext()
+
+	header, err := decodeV0Header(decoder)
+	if err != nil {
+		return kafkaapi.SaslHandshakeResponse{}, err
+	}
+
+	body, err := decodeSaslHandshakeResponseBody(decoder)
+	if err != nil {
+		return kafkaapi.SaslHandshakeResponse{}, err
+	}
+
+	return kafkaapi.SaslHandshakeResponse{
+		Header: header,
+		Body:   body,
+	}, nil
+}
+
+func decodeSaslHandshakeResponseBody(decoder *field_decoder.FieldDecoder) (kafkaapi.SaslHandshakeResponseBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+
+	errorCode, err := decoder.ReadInt16Field("ErrorCode")
+	if err != nil {
+		return kafkaapi.SaslHandshakeResponseBody{}, err
+	}
+
+	mechanisms, err := decodeArray(decoder, decodeSaslHandshakeResponseMechanism, "Mechanisms")
+	if err != nil {
+		return kafkaapi.SaslHandshakeResponseBody{}, err
+	}
+
+	return kafkaapi.SaslHandshakeResponseBody{
+		Version:    1,
+		ErrorCode:  value.MustBeInt16(errorCode.Value),
+		Mechanisms: mechanisms,
+	}, nil
+}
+
+func decodeSaslHandshakeResponseMechanism(decoder *field_decoder.FieldDecoder) (value.String, field_decoder.FieldDecoderError) {
+	mechanism, err := decoder.ReadStringField("Mechanism")
+	if err != nil {
+		return value.String{}, err
+	}
+
+	return value.MustBeString(mechanism.Value), nil
+}
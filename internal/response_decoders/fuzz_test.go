@@ -0,0 +1,162 @@
+package response_decoders
+
+import (
+	"testing"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_tree_printer"
+	"github.com/codecrafters-io/kafka-tester/internal/inspectable_hex_dump"
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+// fuzzDecodeFunc checks that decodeFunc returns an error instead of panicking on malformed input, and that the error
+// can be rendered the same way ResponseAsserter renders it
+func fuzzDecodeFunc[T any](f *testing.F, decodeFunc func(*field_decoder.FieldDecoder) (T, field_decoder.FieldDecoderError), seeds ...[]byte) {
+	f.Add([]byte{})
+	f.Add([]byte{0x00, 0x00, 0x00, 0x07, 0x00, 0x00})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		decoder := field_decoder.NewFieldDecoder(data)
+		_, err := decodeFunc(decoder)
+		if err == nil {
+			return
+		}
+
+		if err.StartOffset() < 0 || err.StartOffset() > len(data) || err.EndOffset() > len(data) {
+			t.Fatalf("error %q has offsets [%d, %d] outside of the %d decoded bytes", err, err.StartOffset(), err.EndOffset(), len(data))
+		}
+
+		fieldTreePrinter := field_tree_printer.FieldTreePrinter{
+			Fields: decoder.DecodedFields(),
+			Logger: logger.GetQuietLogger(""),
+		}
+		fieldTreePrinter.PrintForDecodeError(err.Path())
+
+		inspectable_hex_dump.NewInspectableHexDump(data).FormatWithHighlightedRange(err.StartOffset(), err.EndOffset())
+	})
+}
+
+func encodedRecordBatchSeeds() [][]byte {
+	seeds := [][]byte{}
+
+	for _, codec := range []compression.Codec{compression.None, compression.Gzip, compression.Snappy, compression.LZ4, compression.Zstd} {
+		recordBatch := kafkaapi.RecordBatch{
+			Magic:      value.Int8{Value: 2},
+			Attributes: value.Int16{Value: int16(codec)},
+			Records: []kafkaapi.Record{
+				{
+					Key:     value.RawBytes{Value: []byte("foo")},
+					Value:   value.RawBytes{Value: []byte("bar")},
+					Headers: []kafkaapi.RecordHeader{{Key: value.RawBytes{Value: []byte("baz")}}},
+				},
+			},
+		}
+
+		recordBatchEncoder := encoder.NewEncoder()
+		recordBatch.Encode(recordBatchEncoder)
+		seeds = append(seeds, recordBatchEncoder.Bytes())
+	}
+
+	return seeds
+}
+
+func FuzzDecodeCompactRecordBatch(f *testing.F) {
+	fuzzDecodeFunc(f, func(decoder *field_decoder.FieldDecoder) (kafkaapi.RecordBatch, field_decoder.FieldDecoderError) {
+		return DecodeCompactRecordBatch(decoder, "RecordBatch")
+	}, encodedRecordBatchSeeds()...)
+}
+
+func FuzzDecodeLegacyMessage(f *testing.F) {
+	messageSetEncoder := encoder.NewEncoder()
+	kafkaapi.MessageSet{{Magic: value.Int8{Value: 1}, Value: value.RawBytes{Value: []byte("foo")}}}.Encode(messageSetEncoder)
+
+	fuzzDecodeFunc(f, func(decoder *field_decoder.FieldDecoder) (kafkaapi.LegacyMessage, field_decoder.FieldDecoderError) {
+		return DecodeLegacyMessage(decoder, "MessageSet[0]")
+	}, messageSetEncoder.Bytes())
+}
+
+func FuzzDecodeApiVersionsResponse(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeApiVersionsResponse)
+}
+
+func FuzzDecodeApiVersionsResponseV0(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeApiVersionsResponseForVersion(0))
+}
+
+func FuzzDecodeDescribeTopicPartitionsResponse(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeDescribeTopicPartitionsResponse)
+}
+
+func FuzzDecodeFetchResponse(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeFetchResponse)
+}
+
+func FuzzDecodeFetchResponseV4(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeFetchResponseForVersion(4))
+}
+
+func FuzzDecodeProduceResponse(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeProduceResponse)
+}
+
+func FuzzDecodeProduceResponseV3(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeProduceResponseForVersion(3))
+}
+
+func FuzzDecodeSaslAuthenticateResponse(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeSaslAuthenticateResponse)
+}
+
+func FuzzDecodeSaslHandshakeResponse(f *testing.F) {
+	fuzzDecodeFunc(f, DecodeSaslHandshakeResponse)
+}
+
+func FuzzDecodeCreateTopicsResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.CreateTopicsResponseDecoder(7))
+}
+
+func FuzzDecodeMetadataResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.MetadataResponseDecoder(12))
+}
+
+func FuzzDecodeListOffsetsResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.ListOffsetsResponseDecoder(8))
+}
+
+func FuzzDecodeOffsetCommitResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.OffsetCommitResponseDecoder(9))
+}
+
+func FuzzDecodeOffsetFetchResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.OffsetFetchResponseDecoder(9))
+}
+
+func FuzzDecodeFindCoordinatorResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.FindCoordinatorResponseDecoder(4))
+}
+
+func FuzzDecodeJoinGroupResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.JoinGroupResponseDecoder(9))
+}
+
+func FuzzDecodeHeartbeatResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.HeartbeatResponseDecoder(4))
+}
+
+func FuzzDecodeLeaveGroupResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.LeaveGroupResponseDecoder(5))
+}
+
+func FuzzDecodeSyncGroupResponse(f *testing.F) {
+	fuzzDecodeFunc(f, generated_kafkaapi.SyncGroupResponseDecoder(5))
+}
@@ -0,0 +1,204 @@
+package response_decoders
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field"
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// magicByteOffset is the position of the magic byte in both record batches and legacy messages. It comes after the
+// offset (8 bytes), the length (4 bytes) and the partition leader epoch or CRC (4 bytes).
+const magicByteOffset = 16
+
+// isLegacyMessageAhead returns true if the next entry in the records is a legacy message (magic 0 or 1)
+//
+// If there aren't enough bytes to tell, it's assumed to be a record batch so that the error is reported from there.
+func isLegacyMessageAhead(decoder *field_decoder.FieldDecoder) bool {
+	magic, ok := decoder.PeekInt8(magicByteOffset)
+	return ok && (magic == 0 || magic == 1)
+}
+
+// DecodeLegacyMessage decodes a message in the format used before record batches (ref. https://kafka.apache.org/documentation/#messageset)
+func DecodeLegacyMessage(decoder *field_decoder.FieldDecoder, path string) (kafkaapi.LegacyMessage, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext(path)
+	defer decoder.PopPathContext()
+
+	offset, err := decoder.ReadInt64Field("Offset")
+	if err != nil {
+		return kafkaapi.LegacyMessage{}, err
+	}
+
+	messageSize, err := decoder.ReadInt32Field("MessageSize")
+	if err != nil {
+		return kafkaapi.LegacyMessage{}, err
+	}
+
+	messageSizeAsInt32 := value.MustBeInt32(messageSize.Value)
+
+	if messageSizeAsInt32.Value <= 0 {
+		return kafkaapi.LegacyMessage{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected MessageSize to be positive, got %d", messageSizeAsInt32.Value),
+			messageSize,
+		)
+	}
+
+	messageStartOffset := decoder.ReadBytesCount()
+
+	crc, err := decoder.ReadInt32Field("CRC")
+	if err != nil {
+		return kafkaapi.LegacyMessage{}, err
+	}
+
+	magicByte, err := decoder.ReadInt8Field("MagicByte")
+	if err != nil {
+		return kafkaapi.LegacyMessage{}, err
+	}
+
+	magicByteAsInt8 := value.MustBeInt8(magicByte.Value)
+
+	if magicByteAsInt8.Value != 0 && magicByteAsInt8.Value != 1 {
+		return kafkaapi.LegacyMessage{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected MagicByte of a legacy message to be 0 or 1, got %d", magicByteAsInt8.Value),
+			magicByte,
+		)
+	}
+
+	attributes, err := decoder.ReadInt8Field("Attributes")
+	if err != nil {
+		return kafkaapi.LegacyMessage{}, err
+	}
+
+	// Timestamps were added in magic 1
+	timestamp := value.Int64{}
+
+	if magicByteAsInt8.Value == 1 {
+		timestampField, err := decoder.ReadInt64Field("Timestamp")
+		if err != nil {
+			return kafkaapi.LegacyMessage{}, err
+		}
+
+		timestamp = value.MustBeInt64(timestampField.Value)
+	}
+
+	key, _, err := readLegacyMessageBytes(decoder, "Key")
+	if err != nil {
+		return kafkaapi.LegacyMessage{}, err
+	}
+
+	messageValue, messageValueField, err := readLegacyMessageBytes(decoder, "Value")
+	if err != nil {
+		return kafkaapi.LegacyMessage{}, err
+	}
+
+	messageEndOffset := decoder.ReadBytesCount()
+
+	decodedMessage := kafkaapi.LegacyMessage{
+		Offset:      value.MustBeInt64(offset.Value),
+		MessageSize: messageSizeAsInt32,
+		CRC:         value.MustBeInt32(crc.Value),
+		Magic:       magicByteAsInt8,
+		Attributes:  value.MustBeInt8(attributes.Value),
+		Timestamp:   timestamp,
+		Key:         key,
+		Value:       messageValue,
+	}
+
+	// Legacy messages use CRC32 (IEEE), record batches use CRC32C
+	if !decodedMessage.IsCRCValueOk() {
+		errorMessage := fmt.Errorf(
+			"Expected CRC value for the legacy message to be %s, got %s instead",
+			decodedMessage.GetComputedCRCValue(),
+			decodedMessage.CRC,
+		)
+		return kafkaapi.LegacyMessage{}, decoder.GetDecoderErrorForField(errorMessage, crc)
+	}
+
+	if messageEndOffset-messageStartOffset != uint64(messageSizeAsInt32.Value) {
+		errorMessage := fmt.Errorf(
+			"Expected MessageSize to be %d (actual message size), got %d",
+			(messageEndOffset - messageStartOffset),
+			messageSizeAsInt32.Value,
+		)
+		return kafkaapi.LegacyMessage{}, decoder.GetDecoderErrorForField(errorMessage, messageSize)
+	}
+
+	// A compressed message is a wrapper, its value is a compressed message set
+	if codec := decodedMessage.GetCompressionCodec(); codec != compression.None {
+		innerMessages, err := decodeCompressedMessageSet(decoder, codec, messageValue, messageValueField)
+		if err != nil {
+			return kafkaapi.LegacyMessage{}, err
+		}
+
+		decodedMessage.InnerMessages = innerMessages
+	}
+
+	return decodedMessage, nil
+}
+
+// readLegacyMessageBytes reads the key or value of a legacy message, which are prefixed by an INT32 length (-1 for null)
+func readLegacyMessageBytes(decoder *field_decoder.FieldDecoder, path string) (value.RawBytes, field.Field, field_decoder.FieldDecoderError) {
+	length, err := decoder.ReadInt32Field(path + "Length")
+	if err != nil {
+		return value.RawBytes{}, field.Field{}, err
+	}
+
+	lengthAsInt32 := value.MustBeInt32(length.Value)
+
+	if lengthAsInt32.Value < -1 {
+		return value.RawBytes{}, field.Field{}, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected %sLength to be greater than or equal to -1, got %d", path, lengthAsInt32.Value),
+			length,
+		)
+	}
+
+	// Null
+	if lengthAsInt32.Value == -1 {
+		return value.RawBytes{}, length, nil
+	}
+
+	if lengthAsInt32.Value == 0 {
+		return value.NewEmptyRawBytes(), length, nil
+	}
+
+	bytesField, err := decoder.ReadRawBytes(path, int(lengthAsInt32.Value))
+	if err != nil {
+		return value.RawBytes{}, field.Field{}, err
+	}
+
+	return value.MustBeRawBytes(bytesField.Value), bytesField, nil
+}
+
+func decodeCompressedMessageSet(decoder *field_decoder.FieldDecoder, codec compression.Codec, compressedMessageSet value.RawBytes, compressedMessageSetField field.Field) (kafkaapi.MessageSet, field_decoder.FieldDecoderError) {
+	decompressedMessageSet, decompressErr := compression.Decompress(codec, compressedMessageSet.Value)
+	if decompressErr != nil {
+		return nil, decoder.GetDecoderErrorForField(
+			fmt.Errorf("Expected message value to be compressed with %s, failed to decompress it: %v", codec, decompressErr),
+			compressedMessageSetField,
+		)
+	}
+
+	innerMessages := kafkaapi.MessageSet{}
+
+	err := decoder.DecodeEmbeddedBytes(decompressedMessageSet, compressedMessageSetField, func(innerDecoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {
+		for innerDecoder.RemainingBytesCount() > 0 {
+			innerMessage, err := DecodeLegacyMessage(innerDecoder, fmt.Sprintf("InnerMessages[%d]", len(innerMessages)))
+			if err != nil {
+				return err
+			}
+
+			innerMessages = append(innerMessages, innerMessage)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return innerMessages, nil
+}
@@ -0,0 +1,82 @@
+package response_decoders
+
+import (
+	"testing"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRecordBatchesWithLegacyMessages(t *testing.T) {
+	innerMessages := kafkaapi.MessageSet{
+		{Offset: value.Int64{Value: 0}, Magic: value.Int8{Value: 1}, Timestamp: value.Int64{Value: 1726045973899}, Value: value.RawBytes{Value: []byte("baz")}},
+		{Offset: value.Int64{Value: 1}, Magic: value.Int8{Value: 1}, Timestamp: value.Int64{Value: 1726045973899}, Value: value.RawBytes{Value: []byte("qux")}},
+	}
+
+	innerMessagesEncoder := encoder.NewEncoder()
+	innerMessages.Encode(innerMessagesEncoder)
+
+	messageSet := kafkaapi.MessageSet{
+		{Offset: value.Int64{Value: 0}, Magic: value.Int8{Value: 0}, Key: value.RawBytes{Value: []byte("foo")}, Value: value.RawBytes{Value: []byte("bar")}},
+		{
+			Offset:     value.Int64{Value: 2},
+			Magic:      value.Int8{Value: 1},
+			Attributes: value.Int8{Value: int8(compression.Gzip)},
+			Timestamp:  value.Int64{Value: 1726045973899},
+			Value:      value.RawBytes{Value: compression.Compress(compression.Gzip, innerMessagesEncoder.Bytes())},
+		},
+	}
+
+	recordBatches := kafkaapi.RecordBatches{
+		{
+			BaseOffset: value.Int64{Value: 3},
+			Magic:      value.Int8{Value: 2},
+			Records:    []kafkaapi.Record{{Value: value.RawBytes{Value: []byte("quux")}, Headers: []kafkaapi.RecordHeader{}}},
+		},
+	}
+
+	encodedBytes := encodeRecordsWithSize(func(pe *encoder.Encoder) {
+		messageSet.Encode(pe)
+		recordBatches.Encode(pe)
+	})
+
+	decoder := field_decoder.NewFieldDecoder(encodedBytes)
+	decodedRecordBatches, decodedMessageSet, err := decodeRecordBatches(decoder, "RecordBatches", false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Len(t, decodedRecordBatches, 1)
+	assert.Len(t, decodedMessageSet, 2)
+
+	assert.Equal(t, "foo", string(decodedMessageSet[0].Key.Value))
+	assert.Nil(t, decodedMessageSet[1].Key.Value)
+	assert.Len(t, decodedMessageSet[1].InnerMessages, 2)
+	assert.Equal(t, "qux", string(decodedMessageSet[1].InnerMessages[1].Value.Value))
+
+	reencodedBytes := encodeRecordsWithSize(func(pe *encoder.Encoder) {
+		decodedMessageSet.Encode(pe)
+		decodedRecordBatches.Encode(pe)
+	})
+	assert.Equal(t, encodedBytes, reencodedBytes)
+}
+
+func TestDecodeLegacyMessageRejectsInvalidCRC(t *testing.T) {
+	messageSet := kafkaapi.MessageSet{
+		{Magic: value.Int8{Value: 1}, Value: value.RawBytes{Value: []byte("bar")}},
+	}
+
+	messageSetEncoder := encoder.NewEncoder()
+	messageSet.Encode(messageSetEncoder)
+
+	encodedBytes := messageSetEncoder.Bytes()
+	encodedBytes[len(encodedBytes)-1] ^= 0xFF
+
+	_, err := DecodeLegacyMessage(field_decoder.NewFieldDecoder(encodedBytes), "MessageSet[0]")
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "MessageSet[0].CRC", err.Path().String())
+}
@@ -11,7 +11,9 @@ import (
 func testBindToPort(stageHarness *test_case_harness.TestCaseHarness) error {
 	b := kafka_executable.NewKafkaExecutable(stageHarness)
 
-	if err := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).GenerateServerConfiguration(); err != nil {
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
 		return err
 	}
 
@@ -21,7 +23,7 @@ func testBindToPort(stageHarness *test_case_harness.TestCaseHarness) error {
 	}
 
 	bindTestCase := test_cases.BindTestCase{
-		Port:    9092,
+		Port:    files_handler.GetListenerAddress().Port,
 		Retries: 15,
 	}
 
@@ -17,7 +17,9 @@ import (
 func testHardcodedCorrelationId(stageHarness *test_case_harness.TestCaseHarness) error {
 	stageLogger := stageHarness.Logger
 
-	if err := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).GenerateServerConfiguration(); err != nil {
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
 		return err
 	}
 
@@ -27,7 +29,10 @@ func testHardcodedCorrelationId(stageHarness *test_case_harness.TestCaseHarness)
 		return err
 	}
 
-	client := instrumented_kafka_client.NewFromAddr("localhost:9092", stageLogger, "client")
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	// message_size can be any value in this stage, so we can't use it to frame the response
+	client.IgnoreMessageSize = true
 
 	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
 		return err
@@ -18,7 +18,9 @@ import (
 func testAPIVersionErrorCase(stageHarness *test_case_harness.TestCaseHarness) error {
 	stageLogger := stageHarness.Logger
 
-	if err := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).GenerateServerConfiguration(); err != nil {
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
 		return err
 	}
 
@@ -28,14 +30,17 @@ func testAPIVersionErrorCase(stageHarness *test_case_harness.TestCaseHarness) er
 		return err
 	}
 
-	client := instrumented_kafka_client.NewFromAddr("localhost:9092", stageLogger, "client")
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	// message_size can be any value in this stage, so we can't use it to frame the response
+	client.IgnoreMessageSize = true
 
 	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
 		return err
 	}
 
 	defer client.Close()
-	correlationId := getRandomCorrelationId()
+	correlationId := utils.GetRandomCorrelationId()
 	apiVersion := getInvalidAPIVersion()
 	expectedErrorCode := 35
 
@@ -10,6 +10,7 @@ import (
 	"github.com/codecrafters-io/kafka-tester/protocol/builder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/tester-utils/logger"
 	"github.com/codecrafters-io/tester-utils/test_case_harness"
 )
@@ -17,7 +18,9 @@ import (
 func testAPIVersion(stageHarness *test_case_harness.TestCaseHarness) error {
 	stageLogger := stageHarness.Logger
 
-	if err := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).GenerateServerConfiguration(); err != nil {
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
 		return err
 	}
 
@@ -27,7 +30,7 @@ func testAPIVersion(stageHarness *test_case_harness.TestCaseHarness) error {
 		return err
 	}
 
-	client := instrumented_kafka_client.NewFromAddr("localhost:9092", stageLogger, "client")
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
 
 	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
 		return err
@@ -35,7 +38,7 @@ func testAPIVersion(stageHarness *test_case_harness.TestCaseHarness) error {
 
 	defer client.Close()
 
-	correlationId := getRandomCorrelationId()
+	correlationId := utils.GetRandomCorrelationId()
 	request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(correlationId).Build()
 
 	rawResponse, err := client.SendAndReceive(
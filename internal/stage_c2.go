@@ -20,7 +20,9 @@ func testConcurrentRequests(stageHarness *test_case_harness.TestCaseHarness) err
 	b := kafka_executable.NewKafkaExecutable(stageHarness)
 	stageLogger := stageHarness.Logger
 
-	if err := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).GenerateServerConfiguration(); err != nil {
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
 		return err
 	}
 
@@ -29,7 +31,7 @@ func testConcurrentRequests(stageHarness *test_case_harness.TestCaseHarness) err
 	}
 
 	clientCount := random.RandomInt(2, 4)
-	clients := instrumented_kafka_client.SpawnMultipleClients(clientCount, "localhost:9092", stageLogger)
+	clients := instrumented_kafka_client.SpawnMultipleClients(clientCount, files_handler.GetListenerAddress(), stageLogger)
 	correlationIds := make([]int32, clientCount)
 	apiName := utils.APIKeyToName(18)
 
@@ -45,7 +47,7 @@ func testConcurrentRequests(stageHarness *test_case_harness.TestCaseHarness) err
 	}
 
 	for i, client := range clients {
-		correlationIds[i] = getRandomCorrelationId()
+		correlationIds[i] = utils.GetRandomCorrelationId()
 		request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(correlationIds[i]).Build()
 
 		err := client.Send(
@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testPipelinedRequests(stageHarness *test_case_harness.TestCaseHarness) error {
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
+		return err
+	}
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	clientCount := random.RandomInt(2, 4)
+	clients := instrumented_kafka_client.SpawnMultipleClients(clientCount, files_handler.GetListenerAddress(), stageLogger)
+	correlationIdsByClient := make([][]int32, clientCount)
+	apiName := utils.APIKeyToName(18)
+
+	// Connect from all clients
+	for i := range clientCount {
+		if err := clients[i].ConnectWithRetries(b, stageLogger); err != nil {
+			return err
+		}
+	}
+
+	for _, client := range clients {
+		defer client.Close()
+	}
+
+	// Each client sends all of its requests before any response is read
+	for i, client := range clients {
+		requestCount := random.RandomInt(2, 5)
+
+		for range requestCount {
+			correlationId := utils.GetRandomCorrelationId()
+			correlationIdsByClient[i] = append(correlationIdsByClient[i], correlationId)
+			request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(correlationId).Build()
+
+			err := client.Send(
+				request_encoders.Encode(request, stageLogger),
+				apiName,
+				stageLogger,
+			)
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for testIndex := range clients {
+		clientIdx := len(clients) - testIndex - 1
+		client := clients[clientIdx]
+
+		responses, err := client.ReceiveInFlight(stageLogger)
+
+		if err != nil {
+			return err
+		}
+
+		for i, response := range responses {
+			assertion := response_assertions.NewApiVersionsResponseAssertion().
+				ExpectCorrelationId(correlationIdsByClient[clientIdx][i]).
+				ExpectErrorCode(0).
+				ExpectApiKeyEntry(18, 0, 4)
+
+			_, err = response_asserter.ResponseAsserter[kafkaapi.ApiVersionsResponse]{
+				DecodeFunc: response_decoders.DecodeApiVersionsResponse,
+				Assertion:  assertion,
+				Logger:     stageLogger,
+			}.DecodeAndAssert(response)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		stageLogger.Successf("✓ Test %v of %v: Received %v responses in order", testIndex+1, clientCount, len(responses))
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_client"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testCoalescedRequests(stageHarness *test_case_harness.TestCaseHarness) error {
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
+		return err
+	}
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+	requestCount := random.RandomInt(2, 4)
+	correlationIds := make([]int32, requestCount)
+	requests := make([]kafka_client.PipelinedRequest, requestCount)
+
+	for i := range requestCount {
+		correlationIds[i] = utils.GetRandomCorrelationId()
+		request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(correlationIds[i]).Build()
+
+		requests[i] = kafka_client.PipelinedRequest{
+			Message: request_encoders.Encode(request, stageLogger),
+			ApiKey:  request.Header.ApiKey.Value,
+		}
+	}
+
+	// All requests are written in a single write, so the broker is likely to receive them in a single read()
+	stageLogger.Infof("Sending %d requests in a single write", requestCount)
+
+	if err := client.SendCoalesced(requests, stageLogger); err != nil {
+		return err
+	}
+
+	responses, err := client.ReceiveInFlight(stageLogger)
+
+	if err != nil {
+		return err
+	}
+
+	for i, response := range responses {
+		assertion := response_assertions.NewApiVersionsResponseAssertion().
+			ExpectCorrelationId(correlationIds[i]).
+			ExpectErrorCode(0).
+			ExpectApiKeyEntry(18, 0, 4)
+
+		_, err = response_asserter.ResponseAsserter[kafkaapi.ApiVersionsResponse]{
+			DecodeFunc: response_decoders.DecodeApiVersionsResponse,
+			Assertion:  assertion,
+			Logger:     stageLogger,
+		}.DecodeAndAssert(response)
+
+		if err != nil {
+			return err
+		}
+
+		stageLogger.Successf("✓ Test %v of %v: Passed", i+1, requestCount)
+	}
+
+	return nil
+}
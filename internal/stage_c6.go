@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testPersistentConnection(stageHarness *test_case_harness.TestCaseHarness) error {
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
+		return err
+	}
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+	requestCount := random.RandomInt(5, 10)
+
+	stageLogger.Infof("Sending %d requests over the same connection", requestCount)
+
+	for i := range requestCount {
+		// Idle periods between requests catch brokers that close connections as soon as there's nothing to read
+		if i > 0 {
+			time.Sleep(time.Duration(random.RandomInt(50, 300)) * time.Millisecond)
+		}
+
+		correlationId := utils.GetRandomCorrelationId()
+		request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(correlationId).Build()
+
+		rawResponse, err := client.SendAndReceive(
+			request_encoders.Encode(request, stageLogger),
+			request.Header.ApiKey.Value,
+			stageLogger,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		assertion := response_assertions.NewApiVersionsResponseAssertion().
+			ExpectCorrelationId(correlationId).
+			ExpectErrorCode(0).
+			ExpectApiKeyEntry(18, 0, 4)
+
+		_, err = response_asserter.ResponseAsserter[kafkaapi.ApiVersionsResponse]{
+			DecodeFunc: response_decoders.DecodeApiVersionsResponse,
+			Assertion:  assertion,
+			Logger:     stageLogger,
+		}.DecodeAndAssert(rawResponse)
+
+		if err != nil {
+			return err
+		}
+
+		stageLogger.Successf("✓ Test %v of %v: Passed", i+1, requestCount)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testFindGroupCoordinator(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			getConsumerOffsetsTopicGenerationConfig(),
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	groupIds := getRandomGroupIds(random.RandomInt(1, 4))
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewFindCoordinatorRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithCoordinatorKeys(groupIds).
+		Build()
+
+	stageLogger.Infof("Finding the coordinator for %d group(s)", len(groupIds))
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	// There's a single broker, so it coordinates every group
+	expectedCoordinators := []response_assertions.ExpectedCoordinator{}
+	for _, groupId := range groupIds {
+		expectedCoordinators = append(expectedCoordinators, response_assertions.ExpectedCoordinator{
+			Key:       groupId,
+			ErrorCode: 0,
+			NodeId:    kafka_files_generator.NODE_ID,
+			Port:      int32(files_handler.GetListenerAddress().Port),
+		})
+	}
+
+	assertion := response_assertions.NewFindCoordinatorResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectCoordinators(expectedCoordinators)
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.FindCoordinatorResponse]{
+		DecodeFunc: generated_kafkaapi.FindCoordinatorResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
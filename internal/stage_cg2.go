@@ -0,0 +1,322 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_client"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+// consumerGroupAssignorName is the only protocol that members of a group support
+const consumerGroupAssignorName = "range"
+
+func testJoinAndSyncSingleMemberGroup(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	topicName := random.RandomWord()
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         topicName,
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generatePartitionConfigsWithRandomLogs(random.RandomInt(2, 5)),
+			},
+			getConsumerOffsetsTopicGenerationConfig(),
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	groupId := getRandomGroupIds(1)[0]
+	metadata := getConsumerProtocolMetadata([]string{topicName})
+
+	stageLogger.Infof("Joining group %q without a member ID", groupId)
+
+	memberId, err := assertMemberIdRequired(client, groupId, metadata, stageLogger)
+	if err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Rejoining group %q with member ID %q", groupId, memberId)
+
+	// The first member to join an empty group is elected leader, and receives the metadata of every member
+	expectedMembers := []response_assertions.ExpectedJoinGroupMember{
+		{MemberId: memberId, Metadata: metadata},
+	}
+
+	if err := assertGroupJoined(client, groupId, memberId, metadata, 1, memberId, expectedMembers, stageLogger); err != nil {
+		return err
+	}
+
+	partitionCount := len(files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData[0].GeneratedRecordBatchesByPartition)
+	assignments := getRangeAssignments(topicName, partitionCount, []string{memberId})
+
+	stageLogger.Infof("Syncing group %q as the leader", groupId)
+
+	if err := assertGroupSynced(client, groupId, 1, memberId, assignments, assignments[0].Assignment, stageLogger); err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Sending a heartbeat for member %q", memberId)
+
+	return assertHeartbeat(client, groupId, 1, memberId, 0, stageLogger)
+}
+
+// getConsumerProtocolMetadata returns the subscription that a member sends for topics when joining a group
+func getConsumerProtocolMetadata(topics []string) []byte {
+	pe := encoder.NewEncoder()
+	kafkaapi.ConsumerProtocolSubscription{Topics: topics}.Encode(pe)
+
+	return pe.Bytes()
+}
+
+// getRangeAssignments splits the partitions of topicName into contiguous ranges, one for each member in memberIds.
+// The broker doesn't inspect assignments, so any split works as long as each member receives its own.
+func getRangeAssignments(topicName string, partitionCount int, memberIds []string) []builder.SyncGroupRequestAssignmentData {
+	assignments := []builder.SyncGroupRequestAssignmentData{}
+
+	for i, memberId := range memberIds {
+		partitions := []int32{}
+		for partition := i * partitionCount / len(memberIds); partition < (i+1)*partitionCount/len(memberIds); partition++ {
+			partitions = append(partitions, int32(partition))
+		}
+
+		pe := encoder.NewEncoder()
+		kafkaapi.ConsumerProtocolAssignment{
+			AssignedPartitions: []kafkaapi.ConsumerProtocolTopicPartitions{
+				{Topic: topicName, Partitions: partitions},
+			},
+		}.Encode(pe)
+
+		assignments = append(assignments, builder.SyncGroupRequestAssignmentData{
+			MemberId:   memberId,
+			Assignment: pe.Bytes(),
+		})
+	}
+
+	return assignments
+}
+
+// getJoinGroupRequest returns a JoinGroup request for a consumer that supports the range assignor
+func getJoinGroupRequest(correlationId int32, groupId string, memberId string, metadata []byte) generated_kafkaapi.JoinGroupRequest {
+	return builder.NewJoinGroupRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithGroupId(groupId).
+		WithMemberId(memberId).
+		WithProtocolType(kafkaapi.ConsumerProtocolType).
+		WithProtocols([]builder.JoinGroupRequestProtocolData{
+			{Name: consumerGroupAssignorName, Metadata: metadata},
+		}).
+		Build()
+}
+
+// assertMemberIdRequired joins groupId without a member ID, and returns the member ID that the broker assigns
+func assertMemberIdRequired(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	groupId string,
+	metadata []byte,
+	stageLogger *logger.Logger,
+) (string, error) {
+	correlationId := utils.GetRandomCorrelationId()
+	request := getJoinGroupRequest(correlationId, groupId, "", metadata)
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Since v4, new members have to rejoin with the member ID assigned by the broker before they're added to the group
+	assertion := response_assertions.NewJoinGroupResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCode(79).
+		ExpectGenerationId(-1)
+
+	response, err := response_asserter.ResponseAsserter[generated_kafkaapi.JoinGroupResponse]{
+		DecodeFunc: generated_kafkaapi.JoinGroupResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	if err != nil {
+		return "", err
+	}
+
+	return response.Body.MemberId.Value, nil
+}
+
+// assertGroupJoined rejoins groupId with memberId, and asserts that the member is part of generationId.
+// expectedMembers is only sent to the leader, other members should expect an empty array.
+func assertGroupJoined(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	groupId string,
+	memberId string,
+	metadata []byte,
+	generationId int32,
+	leaderId string,
+	expectedMembers []response_assertions.ExpectedJoinGroupMember,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+	request := getJoinGroupRequest(correlationId, groupId, memberId, metadata)
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return assertJoinGroupResponse(request, rawResponse, generationId, leaderId, expectedMembers, stageLogger)
+}
+
+// assertJoinGroupResponse asserts that the response to a JoinGroup request completes the join of generationId
+func assertJoinGroupResponse(
+	request generated_kafkaapi.JoinGroupRequest,
+	rawResponse kafka_client.Response,
+	generationId int32,
+	leaderId string,
+	expectedMembers []response_assertions.ExpectedJoinGroupMember,
+	stageLogger *logger.Logger,
+) error {
+	assertion := response_assertions.NewJoinGroupResponseAssertion().
+		ExpectCorrelationId(request.Header.CorrelationId.Value).
+		ExpectErrorCode(0).
+		ExpectGenerationId(generationId).
+		ExpectMemberId(request.Body.MemberId.Value).
+		ExpectProtocol(kafkaapi.ConsumerProtocolType, consumerGroupAssignorName).
+		ExpectLeader(leaderId).
+		ExpectMembers(expectedMembers)
+
+	_, err := response_asserter.ResponseAsserter[generated_kafkaapi.JoinGroupResponse]{
+		DecodeFunc: generated_kafkaapi.JoinGroupResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
+
+// assertGroupSynced sends a SyncGroup request for memberId, and asserts that expectedAssignment is received.
+// Only the leader sends assignments, other members send an empty array.
+func assertGroupSynced(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	groupId string,
+	generationId int32,
+	memberId string,
+	assignments []builder.SyncGroupRequestAssignmentData,
+	expectedAssignment []byte,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewSyncGroupRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithGroupId(groupId).
+		WithGenerationId(generationId).
+		WithMemberId(memberId).
+		WithProtocol(kafkaapi.ConsumerProtocolType, consumerGroupAssignorName).
+		WithAssignments(assignments).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewSyncGroupResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCode(0).
+		ExpectProtocol(kafkaapi.ConsumerProtocolType, consumerGroupAssignorName).
+		ExpectAssignment(expectedAssignment)
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.SyncGroupResponse]{
+		DecodeFunc: generated_kafkaapi.SyncGroupResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
+
+// assertHeartbeat sends a Heartbeat request for memberId, and asserts that expectedErrorCode is returned
+func assertHeartbeat(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	groupId string,
+	generationId int32,
+	memberId string,
+	expectedErrorCode int16,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewHeartbeatRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithGroupId(groupId).
+		WithGenerationId(generationId).
+		WithMemberId(memberId).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewHeartbeatResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCode(expectedErrorCode)
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.HeartbeatResponse]{
+		DecodeFunc: generated_kafkaapi.HeartbeatResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
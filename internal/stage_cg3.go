@@ -0,0 +1,285 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+const (
+	rebalanceTimeout      = 5 * time.Second
+	rebalancePollInterval = 100 * time.Millisecond
+)
+
+func testRebalanceMultiMemberGroup(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	topicName := random.RandomWord()
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         topicName,
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generatePartitionConfigsWithRandomLogs(random.RandomInt(4, 7)),
+			},
+			getConsumerOffsetsTopicGenerationConfig(),
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	clientCount := random.RandomInt(2, 5)
+	clients := instrumented_kafka_client.SpawnMultipleClients(clientCount, files_handler.GetListenerAddress(), stageLogger)
+
+	for _, client := range clients {
+		if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+			return err
+		}
+	}
+
+	for _, client := range clients {
+		defer client.Close()
+	}
+
+	groupId := getRandomGroupIds(1)[0]
+	partitionCount := len(files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData[0].GeneratedRecordBatchesByPartition)
+	metadata := getConsumerProtocolMetadata([]string{topicName})
+	leaderClient := clients[0]
+	followerClients := clients[1:]
+
+	stageLogger.Infof("Joining group %q with client-1", groupId)
+
+	leaderId, err := assertMemberIdRequired(leaderClient, groupId, metadata, stageLogger)
+	if err != nil {
+		return err
+	}
+
+	leaderOnlyMembers := []response_assertions.ExpectedJoinGroupMember{
+		{MemberId: leaderId, Metadata: metadata},
+	}
+
+	if err := assertGroupJoined(leaderClient, groupId, leaderId, metadata, 1, leaderId, leaderOnlyMembers, stageLogger); err != nil {
+		return err
+	}
+
+	leaderOnlyAssignments := getRangeAssignments(topicName, partitionCount, []string{leaderId})
+
+	if err := assertGroupSynced(leaderClient, groupId, 1, leaderId, leaderOnlyAssignments, leaderOnlyAssignments[0].Assignment, stageLogger); err != nil {
+		return err
+	}
+
+	memberIds := []string{leaderId}
+	followerJoinRequests := []generated_kafkaapi.JoinGroupRequest{}
+
+	// New members can't complete their join until the existing leader rejoins, so responses are read later
+	for i, followerClient := range followerClients {
+		stageLogger.Infof("Joining group %q with client-%d", groupId, i+2)
+
+		followerId, err := assertMemberIdRequired(followerClient, groupId, metadata, stageLogger)
+		if err != nil {
+			return err
+		}
+
+		request := getJoinGroupRequest(utils.GetRandomCorrelationId(), groupId, followerId, metadata)
+
+		err = followerClient.Send(
+			request_encoders.Encode(request, stageLogger),
+			utils.APIKeyToName(request.Header.ApiKey.Value),
+			stageLogger,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		memberIds = append(memberIds, followerId)
+		followerJoinRequests = append(followerJoinRequests, request)
+	}
+
+	if err := waitForRebalance(files_handler.GetListenerAddress(), b, groupId, 1, leaderId); err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Sending a heartbeat for the leader, a rebalance should be in progress")
+
+	if err := assertHeartbeat(leaderClient, groupId, 1, leaderId, 27, stageLogger); err != nil {
+		return err
+	}
+
+	allMembers := []response_assertions.ExpectedJoinGroupMember{}
+	for _, memberId := range memberIds {
+		allMembers = append(allMembers, response_assertions.ExpectedJoinGroupMember{MemberId: memberId, Metadata: metadata})
+	}
+
+	stageLogger.Infof("Rejoining group %q with client-1", groupId)
+
+	// The leader keeps its role when it rejoins, and the rebalance completes once every member has joined
+	if err := assertGroupJoined(leaderClient, groupId, leaderId, metadata, 2, leaderId, allMembers, stageLogger); err != nil {
+		return err
+	}
+
+	for i, followerClient := range followerClients {
+		request := followerJoinRequests[i]
+
+		rawResponse, err := followerClient.Receive(utils.APIKeyToName(request.Header.ApiKey.Value), stageLogger)
+		if err != nil {
+			return err
+		}
+
+		// Members other than the leader don't receive the metadata of the group's members
+		if err := assertJoinGroupResponse(request, rawResponse, 2, leaderId, []response_assertions.ExpectedJoinGroupMember{}, stageLogger); err != nil {
+			return err
+		}
+	}
+
+	assignments := getRangeAssignments(topicName, partitionCount, memberIds)
+
+	stageLogger.Infof("Syncing group %q with assignments for %d members", groupId, len(memberIds))
+
+	if err := assertGroupSynced(leaderClient, groupId, 2, leaderId, assignments, assignments[0].Assignment, stageLogger); err != nil {
+		return err
+	}
+
+	for i, followerClient := range followerClients {
+		if err := assertGroupSynced(followerClient, groupId, 2, memberIds[i+1], nil, assignments[i+1].Assignment, stageLogger); err != nil {
+			return err
+		}
+	}
+
+	for i, client := range clients {
+		if err := assertHeartbeat(client, groupId, 2, memberIds[i], 0, stageLogger); err != nil {
+			return err
+		}
+	}
+
+	leavingClient := clients[clientCount-1]
+	leavingMemberId := memberIds[clientCount-1]
+
+	stageLogger.Infof("Leaving group %q with client-%d", groupId, clientCount)
+
+	if err := assertGroupLeft(leavingClient, groupId, leavingMemberId, stageLogger); err != nil {
+		return err
+	}
+
+	// Members that left the group are forgotten by the broker
+	return assertHeartbeat(leavingClient, groupId, 2, leavingMemberId, 25, stageLogger)
+}
+
+// assertGroupLeft sends a LeaveGroup request for memberId, and asserts that the member was removed
+func assertGroupLeft(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	groupId string,
+	memberId string,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewLeaveGroupRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithGroupId(groupId).
+		WithMemberIds([]string{memberId}).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewLeaveGroupResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCode(0).
+		ExpectMembers([]response_assertions.ExpectedLeaveGroupMember{
+			{MemberId: memberId, ErrorCode: 0},
+		})
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.LeaveGroupResponse]{
+		DecodeFunc: generated_kafkaapi.LeaveGroupResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
+
+// waitForRebalance sends heartbeats for memberId until the broker responds with REBALANCE_IN_PROGRESS (27), or rebalanceTimeout passes.
+//
+// Requests on different connections aren't ordered, so the broker may handle a heartbeat before the pending joins. The heartbeats are
+// sent quietly on a separate connection, so that the output doesn't depend on how many were needed. Any other error code is left to
+// the heartbeat that's asserted afterwards, which also reports a broker that never starts the rebalance.
+func waitForRebalance(
+	addr kafka_files_generator.ListenerAddress,
+	executable *kafka_executable.KafkaExecutable,
+	groupId string,
+	generationId int32,
+	memberId string,
+) error {
+	quietLogger := logger.GetQuietLogger("")
+
+	client := instrumented_kafka_client.NewFromAddr(addr, quietLogger, "heartbeat")
+	if err := client.ConnectWithRetries(executable, quietLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	request := builder.NewHeartbeatRequestBuilder().
+		WithCorrelationId(utils.GetRandomCorrelationId()).
+		WithGroupId(groupId).
+		WithGenerationId(generationId).
+		WithMemberId(memberId).
+		Build()
+
+	deadline := time.Now().Add(rebalanceTimeout)
+
+	for time.Now().Before(deadline) {
+		rawResponse, err := client.SendAndReceive(
+			request_encoders.Encode(request, quietLogger),
+			request.Header.ApiKey.Value,
+			quietLogger,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		response, decodeError := generated_kafkaapi.HeartbeatResponseDecoder(request.Header.ApiVersion.Value)(field_decoder.NewFieldDecoder(rawResponse.Payload))
+		if decodeError != nil {
+			return decodeError
+		}
+
+		if response.Body.ErrorCode.Value == 27 {
+			return nil
+		}
+
+		time.Sleep(rebalancePollInterval)
+	}
+
+	return nil
+}
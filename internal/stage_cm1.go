@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testFetchCompressedRecordBatches(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	topicUUID := getRandomTopicUUID()
+	partitionId := 0
+	compressionCodec := getRandomCompressionCodec()
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name: random.RandomWord(),
+				UUID: topicUUID,
+				PartitonGenerationConfigList: []kafka_files_generator.PartitionGenerationConfig{
+					{
+						PartitionId:      partitionId,
+						Logs:             random.RandomWords(random.RandomInt(2, 4)),
+						CompressionCodec: compressionCodec,
+					},
+				},
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	generatedTopicsData := files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData
+	expectedRecordBatches := generatedTopicsData[0].GeneratedRecordBatchesByPartition[0].RecordBatches
+
+	stageLogger.Infof("Generated log files with record batches compressed using %s", compressionCodec)
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewFetchRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithTopicUUID(topicUUID).
+		WithPartitionID(int32(partitionId)).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	// Compressed records are compared as part of the record batch bytes, so the batches must be returned as-is
+	assertion := response_assertions.NewFetchResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCodeInBody(0).
+		ExpectTopicUUID(topicUUID).
+		ExpectPartitionID(int32(partitionId)).
+		ExpectErrorCodeInPartition(0).
+		ExpectThrottleTimeMs(0).
+		ExpectRecordBatches(expectedRecordBatches)
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.FetchResponse]{
+		DecodeFunc: response_decoders.DecodeFetchResponse,
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testProduceCompressedRecordBatches(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	topicName := random.RandomWord()
+	topicUUID := getRandomTopicUUID()
+	partitionId := 0
+	compressionCodec := getRandomCompressionCodec()
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name: topicName,
+				UUID: topicUUID,
+				PartitonGenerationConfigList: []kafka_files_generator.PartitionGenerationConfig{
+					{
+						PartitionId: partitionId,
+					},
+				},
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	produceCorrelationId := utils.GetRandomCorrelationId()
+
+	produceRequest := builder.NewProduceRequestBuilder().
+		WithCorrelationId(produceCorrelationId).
+		WithCompressionCodec(compressionCodec).
+		WithTopicRequestData([]builder.ProduceRequestTopicData{
+			{
+				TopicName: topicName,
+				PartitionsCreationData: []builder.ProduceRequestPartitionData{
+					{
+						PartitionId: int32(partitionId),
+						Logs:        random.RandomWords(random.RandomInt(2, 4)),
+					},
+				},
+			},
+		}).
+		Build()
+
+	produceResponse, err := client.SendAndReceive(
+		request_encoders.Encode(produceRequest, stageLogger),
+		produceRequest.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	produceAssertion := response_assertions.NewProduceResponseAssertion().
+		ExpectCorrelationId(produceCorrelationId).
+		ExpectThrottleTimeMs(0).
+		ExpectTopicProperties(response_assertions.GetTopicExpectationData(produceRequest.Body.Topics))
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.ProduceResponse]{
+		DecodeFunc: response_decoders.DecodeProduceResponse,
+		Assertion:  produceAssertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(produceResponse)
+
+	if err != nil {
+		return err
+	}
+
+	// The CRC of the record batch covers the compressed records, so this checks that they weren't recompressed
+	if err := produceAssertion.AssertLogFilesOnDisk(produceRequest.Body.Topics, stageLogger); err != nil {
+		return err
+	}
+
+	fetchCorrelationId := utils.GetRandomCorrelationId()
+
+	fetchRequest := builder.NewFetchRequestBuilder().
+		WithCorrelationId(fetchCorrelationId).
+		WithTopicUUID(topicUUID).
+		WithPartitionID(int32(partitionId)).
+		Build()
+
+	fetchResponse, err := client.SendAndReceive(
+		request_encoders.Encode(fetchRequest, stageLogger),
+		fetchRequest.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	fetchAssertion := response_assertions.NewFetchResponseAssertion().
+		ExpectCorrelationId(fetchCorrelationId).
+		ExpectErrorCodeInBody(0).
+		ExpectTopicUUID(topicUUID).
+		ExpectPartitionID(int32(partitionId)).
+		ExpectErrorCodeInPartition(0).
+		ExpectThrottleTimeMs(0).
+		ExpectRecordBatches(produceRequest.Body.Topics[0].Partitions[0].RecordBatches)
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.FetchResponse]{
+		DecodeFunc: response_decoders.DecodeFetchResponse,
+		Assertion:  fetchAssertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(fetchResponse)
+
+	return err
+}
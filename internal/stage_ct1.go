@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testCreateTopic(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generateEmptyPartitionConfigs(1),
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	topic := builder.CreateTopicsRequestTopicData{
+		Name:              getNewTopicName(),
+		NumPartitions:     int32(random.RandomInt(1, 4)),
+		ReplicationFactor: 1,
+	}
+
+	response, err := createTopics(client, []builder.CreateTopicsRequestTopicData{topic}, []response_assertions.ExpectedCreateTopicsResponseTopic{
+		{
+			Name:              topic.Name,
+			ErrorCode:         0,
+			NumPartitions:     topic.NumPartitions,
+			ReplicationFactor: topic.ReplicationFactor,
+		},
+	}, stageLogger)
+
+	if err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Checking that topic %s was created", topic.Name)
+
+	expectedPartitions := []response_assertions.ExpectedPartition{}
+	for partitionId := range topic.NumPartitions {
+		expectedPartitions = append(expectedPartitions, response_assertions.ExpectedPartition{
+			PartitionId: partitionId,
+			ErrorCode:   0,
+		})
+	}
+
+	return assertTopicsDescribed(client, []response_assertions.ExpectedTopic{
+		{
+			Name:               topic.Name,
+			ErrorCode:          0,
+			UUID:               response.Body.Topics[0].TopicId.Value,
+			ExpectedPartitions: expectedPartitions,
+		},
+	}, stageLogger)
+}
+
+// createTopics sends a CreateTopics request and asserts that the response has the expected results for each topic
+func createTopics(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	topics []builder.CreateTopicsRequestTopicData,
+	expectedTopics []response_assertions.ExpectedCreateTopicsResponseTopic,
+	stageLogger *logger.Logger,
+) (generated_kafkaapi.CreateTopicsResponse, error) {
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewCreateTopicsRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithTopics(topics).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return generated_kafkaapi.CreateTopicsResponse{}, err
+	}
+
+	assertion := response_assertions.NewCreateTopicsResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectTopics(expectedTopics)
+
+	return response_asserter.ResponseAsserter[generated_kafkaapi.CreateTopicsResponse]{
+		DecodeFunc: generated_kafkaapi.CreateTopicsResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+}
+
+// assertTopicsDescribed sends a DescribeTopicPartitions request for expectedTopics, expectedTopics must be sorted by name
+func assertTopicsDescribed(client *instrumented_kafka_client.InstrumentedKafkaClient, expectedTopics []response_assertions.ExpectedTopic, stageLogger *logger.Logger) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	topicNames := []string{}
+	totalPartitionsCount := 0
+	for _, expectedTopic := range expectedTopics {
+		topicNames = append(topicNames, expectedTopic.Name)
+		totalPartitionsCount += len(expectedTopic.ExpectedPartitions)
+	}
+
+	request := builder.NewDescribeTopicPartitionsRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithTopicNames(topicNames).
+		WithResponsePartitionLimit(int32(max(totalPartitionsCount, 1))).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewDescribeTopicPartitionsResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectTopics(expectedTopics).
+		ExpectCursorAbsence()
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.DescribeTopicPartitionsResponse]{
+		DecodeFunc: response_decoders.DecodeDescribeTopicPartitionsResponse,
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
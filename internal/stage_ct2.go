@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testCreateExistingTopic(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generateEmptyPartitionConfigs(random.RandomInt(1, 3)),
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	generatedLogDirectoryData := files_handler.GetGeneratedLogDirectoryData()
+	existingTopicName := generatedLogDirectoryData.GeneratedTopicsData[0].Name
+
+	_, err := createTopics(client, []builder.CreateTopicsRequestTopicData{
+		{
+			Name:              existingTopicName,
+			NumPartitions:     int32(random.RandomInt(1, 4)),
+			ReplicationFactor: 1,
+		},
+	}, []response_assertions.ExpectedCreateTopicsResponseTopic{
+		{
+			Name: existingTopicName,
+			// ERROR CODE FOR TOPIC_ALREADY_EXISTS
+			ErrorCode: 36,
+		},
+	}, stageLogger)
+
+	if err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Checking that topic %s is unchanged", existingTopicName)
+
+	return assertTopicsDescribed(client, response_assertions.GetExpectedTopicsFromGeneratedLogDirectoryData(generatedLogDirectoryData), stageLogger)
+}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testCreateInvalidTopics(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generateEmptyPartitionConfigs(1),
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	topicWithoutPartitions := builder.CreateTopicsRequestTopicData{
+		Name:              getNewTopicName(),
+		NumPartitions:     0,
+		ReplicationFactor: 1,
+	}
+
+	// Topic names can only contain ASCII alphanumerics, '.', '_' and '-'
+	topicWithInvalidName := builder.CreateTopicsRequestTopicData{
+		Name:              fmt.Sprintf("%s/%s", random.RandomWord(), random.RandomWord()),
+		NumPartitions:     1,
+		ReplicationFactor: 1,
+	}
+
+	_, err := createTopics(client, []builder.CreateTopicsRequestTopicData{topicWithoutPartitions, topicWithInvalidName}, []response_assertions.ExpectedCreateTopicsResponseTopic{
+		{
+			Name: topicWithoutPartitions.Name,
+			// ERROR CODE FOR INVALID_PARTITIONS
+			ErrorCode: 37,
+		},
+		{
+			Name: topicWithInvalidName.Name,
+			// ERROR CODE FOR INVALID_TOPIC_EXCEPTION
+			ErrorCode: 17,
+		},
+	}, stageLogger)
+
+	if err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Checking that topic %s wasn't created", topicWithoutPartitions.Name)
+
+	return assertTopicsDescribed(client, []response_assertions.ExpectedTopic{
+		{
+			Name: topicWithoutPartitions.Name,
+			// ERROR CODE FOR UNKNOWN_TOPIC_OR_PARTITION
+			ErrorCode:          3,
+			UUID:               getEmptyTopicUUID(),
+			ExpectedPartitions: []response_assertions.ExpectedPartition{},
+		},
+	}, stageLogger)
+}
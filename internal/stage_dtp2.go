@@ -12,6 +12,7 @@ import (
 	"github.com/codecrafters-io/kafka-tester/protocol/builder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/tester-utils/logger"
 	"github.com/codecrafters-io/tester-utils/random"
 	"github.com/codecrafters-io/tester-utils/test_case_harness"
@@ -47,14 +48,14 @@ func testDTPartitionWithUnknownTopic(stageHarness *test_case_harness.TestCaseHar
 		return err
 	}
 
-	client := instrumented_kafka_client.NewFromAddr("localhost:9092", stageLogger, "client")
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
 
 	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
 		return err
 	}
 
 	defer client.Close()
-	correlationId := getRandomCorrelationId()
+	correlationId := utils.GetRandomCorrelationId()
 	unknownTopicName := fmt.Sprintf("UNKNOWN_TOPIC_%d", random.RandomInt(1, 100))
 
 	request := builder.NewDescribeTopicPartitionsRequestBuilder().
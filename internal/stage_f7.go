@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+// maxWaitTolerance allows for the broker measuring MaxWaitMS with a coarser clock than ours
+const maxWaitTolerance = 10 * time.Millisecond
+
+func testFetchWaitsForMaxWait(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	topicUUID := getRandomTopicUUID()
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name: random.RandomWord(),
+				UUID: topicUUID,
+				PartitonGenerationConfigList: []kafka_files_generator.PartitionGenerationConfig{
+					{
+						// No messages in this stage, so the broker has to wait for MaxWaitMS before responding
+						PartitionId: 0,
+					},
+				},
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	correlationId := utils.GetRandomCorrelationId()
+	partitionId := 0
+	maxWaitMS := int32(random.RandomInt(500, 1000))
+
+	request := builder.NewFetchRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithTopicUUID(topicUUID).
+		WithPartitionID(int32(partitionId)).
+		WithMaxWaitMS(maxWaitMS).
+		Build()
+
+	stageLogger.Infof("Sending Fetch request with MaxWaitMS: %d for a topic with no messages", maxWaitMS)
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewFetchResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCodeInBody(0).
+		ExpectTopicUUID(topicUUID).
+		ExpectPartitionID(int32(partitionId)).
+		ExpectErrorCodeInPartition(0).
+		ExpectThrottleTimeMs(0)
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.FetchResponse]{
+		DecodeFunc: response_decoders.DecodeFetchResponse,
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	if err != nil {
+		return err
+	}
+
+	return instrumented_kafka_client.AssertResponseLatency(
+		rawResponse,
+		utils.APIKeyToName(request.Header.ApiKey.Value),
+		instrumented_kafka_client.LatencyBounds{
+			Min: time.Duration(maxWaitMS)*time.Millisecond - maxWaitTolerance,
+		},
+		stageLogger,
+	)
+}
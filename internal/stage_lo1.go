@@ -0,0 +1,136 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testListEarliestAndLatestOffsets(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generatePartitionConfigsWithRandomLogs(random.RandomInt(2, 4)),
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	topicData := files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData[0]
+
+	stageLogger.Infof("Listing EARLIEST offsets")
+
+	if err := assertOffsetsListed(client, topicData, getSameTimestampForAllPartitions(topicData, kafkaapi.ListOffsetsEarliestTimestamp), stageLogger); err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Listing LATEST offsets")
+
+	return assertOffsetsListed(client, topicData, getSameTimestampForAllPartitions(topicData, kafkaapi.ListOffsetsLatestTimestamp), stageLogger)
+}
+
+func getSameTimestampForAllPartitions(topicData *kafka_files_generator.GeneratedTopicData, timestamp int64) []int64 {
+	timestamps := make([]int64, len(topicData.GeneratedRecordBatchesByPartition))
+	for i := range timestamps {
+		timestamps[i] = timestamp
+	}
+	return timestamps
+}
+
+// assertOffsetsListed sends a ListOffsets request that looks up timestamps[i] in the i-th partition of topicData, and asserts the response
+func assertOffsetsListed(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	topicData *kafka_files_generator.GeneratedTopicData,
+	timestamps []int64,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	partitions := []builder.ListOffsetsRequestPartitionData{}
+	expectedPartitions := []response_assertions.ExpectedListOffsetsPartition{}
+
+	for i, generatedRecordBatchesByPartition := range topicData.GeneratedRecordBatchesByPartition {
+		partitionIndex := int32(generatedRecordBatchesByPartition.PartitionId)
+
+		partitions = append(partitions, builder.ListOffsetsRequestPartitionData{
+			PartitionIndex: partitionIndex,
+			Timestamp:      timestamps[i],
+		})
+
+		expectedPartitions = append(expectedPartitions, response_assertions.GetExpectedListOffsetsPartition(
+			partitionIndex,
+			generatedRecordBatchesByPartition.RecordBatches,
+			timestamps[i],
+		))
+	}
+
+	request := builder.NewListOffsetsRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithTopics([]builder.ListOffsetsRequestTopicData{
+			{
+				Name:       topicData.Name,
+				Partitions: partitions,
+			},
+		}).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewListOffsetsResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectTopics([]response_assertions.ExpectedListOffsetsTopic{
+			{
+				Name:       topicData.Name,
+				Partitions: expectedPartitions,
+			},
+		})
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.ListOffsetsResponse]{
+		DecodeFunc: generated_kafkaapi.ListOffsetsResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testListOffsetsForTimestamp(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generatePartitionConfigsWithRandomLogs(random.RandomInt(2, 4)),
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	topicData := files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData[0]
+
+	// Look up a timestamp between two records, which should return the later of the two
+	timestampsBetweenRecords := []int64{}
+	for _, generatedRecordBatchesByPartition := range topicData.GeneratedRecordBatchesByPartition {
+		recordBatches := generatedRecordBatchesByPartition.RecordBatches
+		recordBatchIndex := random.RandomInt(1, len(recordBatches))
+
+		previousTimestamp := recordBatches[recordBatchIndex-1].MaxTimestamp.Value
+		timestampsBetweenRecords = append(timestampsBetweenRecords, previousTimestamp+1)
+	}
+
+	stageLogger.Infof("Listing offsets for timestamps between records")
+
+	if err := assertOffsetsListed(client, topicData, timestampsBetweenRecords, stageLogger); err != nil {
+		return err
+	}
+
+	// Look up a timestamp after the last record, which shouldn't match any record
+	timestampsAfterLastRecord := []int64{}
+	for _, generatedRecordBatchesByPartition := range topicData.GeneratedRecordBatchesByPartition {
+		recordBatches := generatedRecordBatchesByPartition.RecordBatches
+		timestampsAfterLastRecord = append(timestampsAfterLastRecord, recordBatches[len(recordBatches)-1].MaxTimestamp.Value+1)
+	}
+
+	stageLogger.Infof("Listing offsets for timestamps after the last record")
+
+	return assertOffsetsListed(client, topicData, timestampsAfterLastRecord, stageLogger)
+}
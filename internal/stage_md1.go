@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testMetadataForTopic(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generateEmptyPartitionConfigs(random.RandomInt(1, 4)),
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	expectedTopics := response_assertions.GetExpectedMetadataTopicsFromGeneratedLogDirectoryData(files_handler.GetGeneratedLogDirectoryData())
+
+	return assertMetadata(
+		client,
+		[]string{expectedTopics[0].Name},
+		response_assertions.GetExpectedMetadataBrokers(files_handler.GetListenerAddress()),
+		expectedTopics,
+		stageLogger,
+	)
+}
+
+// assertMetadata sends a Metadata request for topicNames (or all topics if topicNames is nil) and asserts the response
+func assertMetadata(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	topicNames []string,
+	expectedBrokers []response_assertions.ExpectedMetadataBroker,
+	expectedTopics []response_assertions.ExpectedMetadataTopic,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	requestBuilder := builder.NewMetadataRequestBuilder().WithCorrelationId(correlationId)
+
+	if topicNames == nil {
+		requestBuilder.WithAllTopics()
+	} else {
+		requestBuilder.WithTopicNames(topicNames)
+	}
+
+	request := requestBuilder.Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewMetadataResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectBrokers(expectedBrokers).
+		ExpectControllerId(kafka_files_generator.NODE_ID).
+		ExpectTopics(expectedTopics)
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.MetadataResponse]{
+		DecodeFunc: generated_kafkaapi.MetadataResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testMetadataForUnknownTopic(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generateEmptyPartitionConfigs(1),
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	unknownTopicName := fmt.Sprintf("UNKNOWN_TOPIC_%d", random.RandomInt(1, 100))
+
+	return assertMetadata(
+		client,
+		[]string{unknownTopicName},
+		response_assertions.GetExpectedMetadataBrokers(files_handler.GetListenerAddress()),
+		[]response_assertions.ExpectedMetadataTopic{
+			{
+				Name: unknownTopicName,
+				// ERROR CODE FOR UNKNOWN_TOPIC_OR_PARTITION
+				ErrorCode:  3,
+				TopicId:    getEmptyTopicUUID(),
+				Partitions: []response_assertions.ExpectedMetadataPartition{},
+			},
+		},
+		stageLogger,
+	)
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testMetadataForAllTopics(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	topicsCount := random.RandomInt(2, 4)
+	topicNames := getRandomTopicNames(topicsCount)
+	topicUUIDs := getRandomTopicUUIDs(topicsCount)
+
+	topicGenerationConfigList := []kafka_files_generator.TopicGenerationConfig{}
+	for i := range topicsCount {
+		topicGenerationConfigList = append(topicGenerationConfigList, kafka_files_generator.TopicGenerationConfig{
+			Name:                         topicNames[i],
+			UUID:                         topicUUIDs[i],
+			PartitonGenerationConfigList: generateEmptyPartitionConfigs(random.RandomInt(1, 4)),
+		})
+	}
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: topicGenerationConfigList,
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	stageLogger.Infof("Requesting metadata for all topics")
+
+	// A null topics array requests all topics, the cluster metadata topic is internal and isn't included
+	return assertMetadata(
+		client,
+		nil,
+		response_assertions.GetExpectedMetadataBrokers(files_handler.GetListenerAddress()),
+		response_assertions.GetExpectedMetadataTopicsFromGeneratedLogDirectoryData(files_handler.GetGeneratedLogDirectoryData()),
+		stageLogger,
+	)
+}
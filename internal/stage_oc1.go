@@ -0,0 +1,237 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testCommitAndFetchOffsets(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generatePartitionConfigsWithRandomLogs(random.RandomInt(2, 5)),
+			},
+			getConsumerOffsetsTopicGenerationConfig(),
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	groupId := getRandomGroupIds(1)[0]
+	committedTopics := []builder.OffsetCommitRequestTopicData{
+		getRandomOffsetCommitTopicData(files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData[0]),
+	}
+
+	stageLogger.Infof("Committing offsets for group %q", groupId)
+
+	if err := assertOffsetsCommitted(client, groupId, committedTopics, stageLogger); err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Fetching committed offsets for group %q", groupId)
+
+	return assertOffsetsFetched(
+		client,
+		[]builder.OffsetFetchRequestGroupData{
+			{GroupId: groupId, Topics: getOffsetFetchTopics(committedTopics)},
+		},
+		[]response_assertions.ExpectedOffsetFetchGroup{
+			{GroupId: groupId, ErrorCode: 0, Topics: getExpectedOffsetFetchTopics(committedTopics)},
+		},
+		stageLogger,
+	)
+}
+
+// getRandomOffsetCommitTopicData commits a random offset (up to the LATEST offset) with random metadata for every partition of topicData
+func getRandomOffsetCommitTopicData(topicData *kafka_files_generator.GeneratedTopicData) builder.OffsetCommitRequestTopicData {
+	partitions := []builder.OffsetCommitRequestPartitionData{}
+
+	for _, generatedRecordBatchesByPartition := range topicData.GeneratedRecordBatchesByPartition {
+		partitionIndex := int32(generatedRecordBatchesByPartition.PartitionId)
+		latestOffset := response_assertions.GetExpectedListOffsetsPartition(
+			partitionIndex,
+			generatedRecordBatchesByPartition.RecordBatches,
+			kafkaapi.ListOffsetsLatestTimestamp,
+		).Offset
+
+		partitions = append(partitions, builder.OffsetCommitRequestPartitionData{
+			PartitionIndex: partitionIndex,
+			// Consumers commit the offset of the next record they'll read
+			CommittedOffset:   int64(random.RandomInt(1, int(latestOffset)+1)),
+			CommittedMetadata: random.RandomWord(),
+		})
+	}
+
+	return builder.OffsetCommitRequestTopicData{
+		Name:       topicData.Name,
+		Partitions: partitions,
+	}
+}
+
+// getOffsetFetchTopics returns the topics and partitions to fetch committed offsets for
+func getOffsetFetchTopics(committedTopics []builder.OffsetCommitRequestTopicData) []builder.OffsetFetchRequestTopicData {
+	topics := []builder.OffsetFetchRequestTopicData{}
+
+	for _, committedTopic := range committedTopics {
+		partitionIndexes := []int32{}
+		for _, committedPartition := range committedTopic.Partitions {
+			partitionIndexes = append(partitionIndexes, committedPartition.PartitionIndex)
+		}
+
+		topics = append(topics, builder.OffsetFetchRequestTopicData{
+			Name:             committedTopic.Name,
+			PartitionIndexes: partitionIndexes,
+		})
+	}
+
+	return topics
+}
+
+// getExpectedOffsetFetchTopics returns the offsets and metadata that were committed in committedTopics
+func getExpectedOffsetFetchTopics(committedTopics []builder.OffsetCommitRequestTopicData) []response_assertions.ExpectedOffsetFetchTopic {
+	expectedTopics := []response_assertions.ExpectedOffsetFetchTopic{}
+
+	for _, committedTopic := range committedTopics {
+		expectedPartitions := []response_assertions.ExpectedOffsetFetchPartition{}
+		for _, committedPartition := range committedTopic.Partitions {
+			expectedPartitions = append(expectedPartitions, response_assertions.ExpectedOffsetFetchPartition{
+				PartitionIndex:  committedPartition.PartitionIndex,
+				CommittedOffset: committedPartition.CommittedOffset,
+				Metadata:        committedPartition.CommittedMetadata,
+				ErrorCode:       0,
+			})
+		}
+
+		expectedTopics = append(expectedTopics, response_assertions.ExpectedOffsetFetchTopic{
+			Name:       committedTopic.Name,
+			Partitions: expectedPartitions,
+		})
+	}
+
+	return expectedTopics
+}
+
+// assertOffsetsCommitted sends an OffsetCommit request for groupId, and asserts that every partition in topics was committed
+func assertOffsetsCommitted(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	groupId string,
+	topics []builder.OffsetCommitRequestTopicData,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewOffsetCommitRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithGroupId(groupId).
+		WithTopics(topics).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	expectedTopics := []response_assertions.ExpectedOffsetCommitTopic{}
+
+	for _, topic := range topics {
+		expectedPartitions := []response_assertions.ExpectedOffsetCommitPartition{}
+		for _, partition := range topic.Partitions {
+			expectedPartitions = append(expectedPartitions, response_assertions.ExpectedOffsetCommitPartition{
+				PartitionIndex: partition.PartitionIndex,
+				ErrorCode:      0,
+			})
+		}
+
+		expectedTopics = append(expectedTopics, response_assertions.ExpectedOffsetCommitTopic{
+			Name:       topic.Name,
+			Partitions: expectedPartitions,
+		})
+	}
+
+	assertion := response_assertions.NewOffsetCommitResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectTopics(expectedTopics)
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.OffsetCommitResponse]{
+		DecodeFunc: generated_kafkaapi.OffsetCommitResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
+
+// assertOffsetsFetched sends an OffsetFetch request for groups, and asserts the response
+func assertOffsetsFetched(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	groups []builder.OffsetFetchRequestGroupData,
+	expectedGroups []response_assertions.ExpectedOffsetFetchGroup,
+	stageLogger *logger.Logger,
+) error {
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewOffsetFetchRequestBuilder().
+		WithCorrelationId(correlationId).
+		WithGroups(groups).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewOffsetFetchResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectGroups(expectedGroups)
+
+	_, err = response_asserter.ResponseAsserter[generated_kafkaapi.OffsetFetchResponse]{
+		DecodeFunc: generated_kafkaapi.OffsetFetchResponseDecoder(request.Header.ApiVersion.Value),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
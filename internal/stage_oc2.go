@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testFetchOffsetsForMultipleGroups(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generatePartitionConfigsWithRandomLogs(random.RandomInt(2, 5)),
+			},
+			getConsumerOffsetsTopicGenerationConfig(),
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	topicData := files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData[0]
+	groupIds := getRandomGroupIds(3)
+	allPartitionsGroupId, somePartitionsGroupId, uncommittedGroupId := groupIds[0], groupIds[1], groupIds[2]
+
+	allPartitionsCommittedTopics := []builder.OffsetCommitRequestTopicData{getRandomOffsetCommitTopicData(topicData)}
+
+	// The second group only commits offsets for some partitions, so fetching all of its topics shouldn't return the others
+	somePartitionsCommittedTopic := getRandomOffsetCommitTopicData(topicData)
+	somePartitionsCommittedTopic.Partitions = random.RandomElementsFromArray(
+		somePartitionsCommittedTopic.Partitions,
+		random.RandomInt(1, len(somePartitionsCommittedTopic.Partitions)),
+	)
+	somePartitionsCommittedTopics := []builder.OffsetCommitRequestTopicData{somePartitionsCommittedTopic}
+
+	stageLogger.Infof("Committing offsets for group %q", allPartitionsGroupId)
+
+	if err := assertOffsetsCommitted(client, allPartitionsGroupId, allPartitionsCommittedTopics, stageLogger); err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Committing offsets for group %q", somePartitionsGroupId)
+
+	if err := assertOffsetsCommitted(client, somePartitionsGroupId, somePartitionsCommittedTopics, stageLogger); err != nil {
+		return err
+	}
+
+	// Offsets that were never committed are returned as -1, with empty metadata
+	uncommittedTopics := getExpectedOffsetFetchTopics(allPartitionsCommittedTopics)
+	for i := range uncommittedTopics[0].Partitions {
+		uncommittedTopics[0].Partitions[i].CommittedOffset = -1
+		uncommittedTopics[0].Partitions[i].Metadata = ""
+	}
+
+	stageLogger.Infof("Fetching committed offsets for groups %q, %q and %q", allPartitionsGroupId, somePartitionsGroupId, uncommittedGroupId)
+
+	return assertOffsetsFetched(
+		client,
+		[]builder.OffsetFetchRequestGroupData{
+			{GroupId: allPartitionsGroupId, Topics: getOffsetFetchTopics(allPartitionsCommittedTopics)},
+			// nil fetches offsets for all topics that have committed offsets in the group
+			{GroupId: somePartitionsGroupId, Topics: nil},
+			{GroupId: uncommittedGroupId, Topics: getOffsetFetchTopics(allPartitionsCommittedTopics)},
+		},
+		[]response_assertions.ExpectedOffsetFetchGroup{
+			{GroupId: allPartitionsGroupId, ErrorCode: 0, Topics: getExpectedOffsetFetchTopics(allPartitionsCommittedTopics)},
+			{GroupId: somePartitionsGroupId, ErrorCode: 0, Topics: getExpectedOffsetFetchTopics(somePartitionsCommittedTopics)},
+			{GroupId: uncommittedGroupId, ErrorCode: 0, Topics: uncommittedTopics},
+		},
+		stageLogger,
+	)
+}
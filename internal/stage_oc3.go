@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testCommittedOffsetsPersistAcrossRestarts(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         random.RandomWord(),
+				UUID:                         getRandomTopicUUID(),
+				PartitonGenerationConfigList: generatePartitionConfigsWithRandomLogs(random.RandomInt(2, 4)),
+			},
+			getConsumerOffsetsTopicGenerationConfig(),
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client-1")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	groupId := getRandomGroupIds(1)[0]
+	committedTopics := []builder.OffsetCommitRequestTopicData{
+		getRandomOffsetCommitTopicData(files_handler.GetGeneratedLogDirectoryData().GeneratedTopicsData[0]),
+	}
+
+	stageLogger.Infof("Committing offsets for group %q", groupId)
+
+	if err := assertOffsetsCommitted(client, groupId, committedTopics, stageLogger); err != nil {
+		client.Close()
+		return err
+	}
+
+	client.Close()
+
+	// Committed offsets have to be read back from disk after a restart, they can't only be kept in memory
+	if err := b.Restart(); err != nil {
+		return err
+	}
+
+	restartedClient := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client-2")
+
+	if err := restartedClient.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer restartedClient.Close()
+
+	stageLogger.Infof("Fetching committed offsets for group %q after restart", groupId)
+
+	return assertOffsetsFetched(
+		restartedClient,
+		[]builder.OffsetFetchRequestGroupData{
+			{GroupId: groupId, Topics: getOffsetFetchTopics(committedTopics)},
+		},
+		[]response_assertions.ExpectedOffsetFetchGroup{
+			{GroupId: groupId, ErrorCode: 0, Topics: getExpectedOffsetFetchTopics(committedTopics)},
+		},
+		stageLogger,
+	)
+}
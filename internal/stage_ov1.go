@@ -0,0 +1,220 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testOlderApiVersions(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	minProduceVersion, maxProduceVersion := utils.GetSupportedApiVersionRange(0)
+	minFetchVersion, maxFetchVersion := utils.GetSupportedApiVersionRange(1)
+
+	topicName := random.RandomWord()
+	topicUUID := getRandomTopicUUID()
+
+	// Each Produce version writes to its own partition, so that every produced record batch starts at offset 0
+	partitionCount := int32(maxProduceVersion - minProduceVersion + 1)
+
+	partitionGenerationConfigs := []kafka_files_generator.PartitionGenerationConfig{}
+	expectedPartitions := []response_assertions.ExpectedPartition{}
+	for partitionId := range partitionCount {
+		partitionGenerationConfigs = append(partitionGenerationConfigs, kafka_files_generator.PartitionGenerationConfig{
+			PartitionId: int(partitionId),
+		})
+		expectedPartitions = append(expectedPartitions, response_assertions.ExpectedPartition{
+			PartitionId: partitionId,
+			ErrorCode:   0,
+		})
+	}
+
+	files_handler.AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{
+		TopicGenerationConfigList: []kafka_files_generator.TopicGenerationConfig{
+			{
+				Name:                         topicName,
+				UUID:                         topicUUID,
+				PartitonGenerationConfigList: partitionGenerationConfigs,
+			},
+		},
+	})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	recordBatchesByPartition := map[int32]kafkaapi.RecordBatches{}
+
+	for version := minProduceVersion; version <= maxProduceVersion; version++ {
+		partitionId := int32(version - minProduceVersion)
+
+		recordBatches, err := assertProducedForVersion(client, version, topicName, partitionId, stageLogger)
+		if err != nil {
+			return err
+		}
+
+		recordBatchesByPartition[partitionId] = recordBatches
+	}
+
+	// DescribeTopicPartitions only has v0, which is flexible
+	err := assertTopicsDescribed(client, []response_assertions.ExpectedTopic{
+		{
+			Name:               topicName,
+			ErrorCode:          0,
+			UUID:               topicUUID,
+			ExpectedPartitions: expectedPartitions,
+		},
+	}, stageLogger)
+
+	if err != nil {
+		return err
+	}
+
+	for version := minFetchVersion; version <= maxFetchVersion; version++ {
+		partitionId := int32(version-minFetchVersion) % partitionCount
+
+		if err := assertFetchedForVersion(client, version, topicName, topicUUID, partitionId, recordBatchesByPartition[partitionId], stageLogger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assertProducedForVersion sends a Produce request with a single record and returns the record batches that were produced
+func assertProducedForVersion(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	version int16,
+	topicName string,
+	partitionId int32,
+	stageLogger *logger.Logger,
+) (kafkaapi.RecordBatches, error) {
+	stageLogger.Infof("Sending Produce (v%d) request for partition %d", version, partitionId)
+
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewProduceRequestBuilder().
+		WithApiVersion(version).
+		WithCorrelationId(correlationId).
+		WithTopicRequestData([]builder.ProduceRequestTopicData{
+			{
+				TopicName: topicName,
+				PartitionsCreationData: []builder.ProduceRequestPartitionData{
+					{
+						PartitionId: partitionId,
+						Logs:        []string{random.RandomWord()},
+					},
+				},
+			},
+		}).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	assertion := response_assertions.NewProduceResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectThrottleTimeMs(0).
+		ExpectTopicProperties(response_assertions.GetTopicExpectationData(request.Body.Topics))
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.ProduceResponse]{
+		DecodeFunc: response_decoders.DecodeProduceResponseForVersion(version),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return request.Body.Topics[0].Partitions[0].RecordBatches, nil
+}
+
+// assertFetchedForVersion sends a Fetch request for a partition and asserts that expectedRecordBatches are returned
+func assertFetchedForVersion(
+	client *instrumented_kafka_client.InstrumentedKafkaClient,
+	version int16,
+	topicName string,
+	topicUUID string,
+	partitionId int32,
+	expectedRecordBatches kafkaapi.RecordBatches,
+	stageLogger *logger.Logger,
+) error {
+	stageLogger.Infof("Sending Fetch (v%d) request for partition %d", version, partitionId)
+
+	correlationId := utils.GetRandomCorrelationId()
+
+	request := builder.NewFetchRequestBuilder().
+		WithApiVersion(version).
+		WithCorrelationId(correlationId).
+		WithTopicName(topicName).
+		WithTopicUUID(topicUUID).
+		WithPartitionID(partitionId).
+		Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewFetchResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectPartitionID(partitionId).
+		ExpectErrorCodeInPartition(0).
+		ExpectThrottleTimeMs(0).
+		ExpectRecordBatches(expectedRecordBatches)
+
+	// Versions before 13 identify topics by name, so the broker has to look the topic up without its UUID
+	if version < 13 {
+		assertion.ExpectTopicName(topicName)
+	} else {
+		assertion.ExpectTopicUUID(topicUUID)
+	}
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.FetchResponse]{
+		DecodeFunc: response_decoders.DecodeFetchResponseForVersion(version),
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/codecrafters-io/kafka-tester/protocol/builder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/tester-utils/logger"
 	"github.com/codecrafters-io/tester-utils/random"
 	"github.com/codecrafters-io/tester-utils/test_case_harness"
@@ -47,7 +48,7 @@ func testProduceWithInvalidRequest(stageHarness *test_case_harness.TestCaseHarne
 		return err
 	}
 
-	client := instrumented_kafka_client.NewFromAddr("localhost:9092", stageLogger, "client")
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
 	defer client.Close()
 
 	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
@@ -66,7 +67,7 @@ func testProduceWithInvalidRequest(stageHarness *test_case_harness.TestCaseHarne
 }
 
 func testProduceWithTopicAndPartitionId(client *instrumented_kafka_client.InstrumentedKafkaClient, stageLogger *logger.Logger, topicName string, partitionId int32) error {
-	correlationId := getRandomCorrelationId()
+	correlationId := utils.GetRandomCorrelationId()
 
 	request := builder.NewProduceRequestBuilder().
 		WithCorrelationId(correlationId).
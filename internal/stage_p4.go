@@ -10,6 +10,7 @@ import (
 	"github.com/codecrafters-io/kafka-tester/protocol/builder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/tester-utils/logger"
 	"github.com/codecrafters-io/tester-utils/random"
 	"github.com/codecrafters-io/tester-utils/test_case_harness"
@@ -48,13 +49,13 @@ func testProduceSingleRecord(stageHarness *test_case_harness.TestCaseHarness) er
 		return err
 	}
 
-	client := instrumented_kafka_client.NewFromAddr("localhost:9092", stageLogger, "client")
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
 	defer client.Close()
 
 	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
 		return err
 	}
-	correlationId := getRandomCorrelationId()
+	correlationId := utils.GetRandomCorrelationId()
 
 	// Send produce reuqest for the existing topic and partition
 
@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/sasl"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testSaslScramAuthentication(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	user := getRandomSASLUser()
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).
+		AddSASLGenerationConfig(kafka_files_generator.SASLGenerationConfig{
+			Users: []kafka_files_generator.SASLUser{user},
+		}).
+		// SCRAM credentials are stored in the cluster metadata log
+		AddLogDirectoryGenerationConfig(kafka_files_generator.LogDirectoryGenerationConfig{})
+
+	if err := files_handler.GenerateServerConfigAndLogDirs(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetSASLListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	stageLogger.Infof("Authenticating as %s using SCRAM-SHA-256", user.Username)
+
+	if err := client.Authenticate(&sasl.ScramSha256{Username: user.Username, Password: user.Password}, stageLogger); err != nil {
+		return err
+	}
+
+	return assertApiVersionsAfterAuthentication(client, stageLogger)
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/sasl"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testSaslInvalidCredentials(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	user := getRandomSASLUser()
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).
+		AddSASLGenerationConfig(kafka_files_generator.SASLGenerationConfig{
+			Users: []kafka_files_generator.SASLUser{user},
+		})
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetSASLListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	wrongPassword := fmt.Sprintf("%s-%s", user.Password, random.RandomWord())
+	mechanism := &sasl.Plain{Username: user.Username, Password: wrongPassword}
+
+	stageLogger.Infof("Authenticating as %s using PLAIN, with an incorrect password", user.Username)
+
+	if err := client.SendSaslHandshake(mechanism.Name(), stageLogger); err != nil {
+		return err
+	}
+
+	authBytes, err := mechanism.InitialResponse()
+	if err != nil {
+		return err
+	}
+
+	// 58: SASL_AUTHENTICATION_FAILED
+	_, err = client.SendSaslAuthenticate(authBytes, 58, stageLogger)
+	return err
+}
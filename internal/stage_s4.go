@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_client"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testSaslUnauthenticatedRequest(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).
+		AddSASLGenerationConfig(kafka_files_generator.SASLGenerationConfig{
+			Users: []kafka_files_generator.SASLUser{getRandomSASLUser()},
+		})
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetSASLListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	stageLogger.Infof("Sending a Fetch request without authenticating")
+
+	request := builder.NewFetchRequstWithEmptyTopicsBuilder().
+		WithCorrelationId(utils.GetRandomCorrelationId()).
+		Build()
+
+	// The broker must not respond to anything other than ApiVersions & SaslHandshake before authentication is complete
+	_, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err == nil {
+		return fmt.Errorf("Expected unauthenticated Fetch request to be rejected, but a response was received")
+	}
+
+	// Brokers reject unauthenticated requests by closing the connection, a timeout means the request was ignored instead
+	var connectionError *kafka_client.ConnectionError
+	if !errors.As(err, &connectionError) || (connectionError.Kind != kafka_client.ConnectionClosed && connectionError.Kind != kafka_client.ConnectionReset) {
+		return fmt.Errorf("Expected the connection to be closed after an unauthenticated Fetch request, got: %v", err)
+	}
+
+	stageLogger.Successf("✓ Unauthenticated Fetch request was rejected")
+	return nil
+}
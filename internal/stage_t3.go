@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_client"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testMutualTLS(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger("")).
+		AddTLSGenerationConfig(kafka_files_generator.TLSGenerationConfig{RequireClientCertificate: true})
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Connecting with a client certificate")
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetSSLListenerAddress(), stageLogger, "client-1")
+	client.TLSConfig = files_handler.GetGeneratedTLSData().ClientTLSConfig(true)
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	correlationId := utils.GetRandomCorrelationId()
+	request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(correlationId).Build()
+
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewApiVersionsResponseAssertion().
+		ExpectCorrelationId(correlationId).
+		ExpectErrorCode(0).
+		ExpectApiKeyEntry(18, 0, 4)
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.ApiVersionsResponse]{
+		DecodeFunc: response_decoders.DecodeApiVersionsResponse,
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	if err != nil {
+		return err
+	}
+
+	stageLogger.Infof("Connecting without a client certificate")
+
+	return assertConnectionWithoutClientCertificateIsRejected(b, files_handler, stageLogger)
+}
+
+// assertConnectionWithoutClientCertificateIsRejected checks that the broker doesn't serve clients that don't present a certificate.
+// With TLS 1.3 the server verifies the client certificate after the client considers the handshake complete,
+// so the rejection can show up either as a handshake error or as a TLS alert (or a closed connection) instead of the first response.
+// Timeouts don't count as rejections, a broker that hangs isn't rejecting the client.
+func assertConnectionWithoutClientCertificateIsRejected(b *kafka_executable.KafkaExecutable, files_handler *kafka_files_generator.FilesHandler, stageLogger *logger.Logger) error {
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetSSLListenerAddress(), stageLogger, "client-2")
+	client.TLSConfig = files_handler.GetGeneratedTLSData().ClientTLSConfig(false)
+
+	err := client.ConnectWithRetries(b, stageLogger)
+
+	var handshakeError *kafka_client.TLSHandshakeError
+	if errors.As(err, &handshakeError) && !handshakeError.TimedOut() {
+		stageLogger.Successf("✓ Connection without a client certificate was rejected during the TLS handshake")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	request := builder.NewApiVersionsRequestBuilder().WithCorrelationId(utils.GetRandomCorrelationId()).Build()
+
+	_, err = client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err == nil {
+		return fmt.Errorf("Expected connection without a client certificate to be rejected, but a response was received")
+	}
+
+	// The request can also fail to send, if the broker resets the connection right after the handshake
+	var connectionError *kafka_client.ConnectionError
+	isRejection := errors.Is(err, syscall.ECONNRESET) || (errors.As(err, &connectionError) && connectionError.Kind != kafka_client.ConnectionTimedOut)
+
+	if !isRejection {
+		return fmt.Errorf("Expected connection without a client certificate to be rejected with a TLS alert or closed, got: %v", err)
+	}
+
+	stageLogger.Successf("✓ Connection without a client certificate was rejected")
+	return nil
+}
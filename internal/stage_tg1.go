@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/instrumented_kafka_client"
+	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
+	"github.com/codecrafters-io/kafka-tester/internal/request_encoders"
+	"github.com/codecrafters-io/kafka-tester/internal/response_asserter"
+	"github.com/codecrafters-io/kafka-tester/internal/response_assertions"
+	"github.com/codecrafters-io/kafka-tester/internal/response_decoders"
+	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/codecrafters-io/tester-utils/logger"
+	"github.com/codecrafters-io/tester-utils/random"
+	"github.com/codecrafters-io/tester-utils/test_case_harness"
+)
+
+func testUnknownTaggedFields(stageHarness *test_case_harness.TestCaseHarness) error {
+	stageLogger := stageHarness.Logger
+
+	files_handler := kafka_files_generator.NewFilesHandler(logger.GetQuietLogger(""))
+
+	if err := files_handler.GenerateServerConfiguration(); err != nil {
+		return err
+	}
+
+	b := kafka_executable.NewKafkaExecutable(stageHarness)
+
+	if err := b.Run(); err != nil {
+		return err
+	}
+
+	client := instrumented_kafka_client.NewFromAddr(files_handler.GetListenerAddress(), stageLogger, "client")
+
+	if err := client.ConnectWithRetries(b, stageLogger); err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	stageLogger.Infof("Sending ApiVersions request with unknown tagged fields in the header and body")
+
+	taggedRequest := builder.NewApiVersionsRequestBuilder().
+		WithCorrelationId(utils.GetRandomCorrelationId()).
+		WithHeaderTaggedFields(getRandomUnknownTaggedFields(1)).
+		WithBodyTaggedFields(getRandomUnknownTaggedFields(2)).
+		Build()
+
+	if err := sendApiVersionsAndAssertResponse(client, taggedRequest, stageLogger); err != nil {
+		return err
+	}
+
+	// Skipping over tagged fields by the wrong number of bytes would corrupt the next request on the connection
+	stageLogger.Infof("Sending ApiVersions request without tagged fields on the same connection")
+
+	plainRequest := builder.NewApiVersionsRequestBuilder().
+		WithCorrelationId(utils.GetRandomCorrelationId()).
+		Build()
+
+	return sendApiVersionsAndAssertResponse(client, plainRequest, stageLogger)
+}
+
+func sendApiVersionsAndAssertResponse(client *instrumented_kafka_client.InstrumentedKafkaClient, request kafkaapi.ApiVersionsRequest, stageLogger *logger.Logger) error {
+	rawResponse, err := client.SendAndReceive(
+		request_encoders.Encode(request, stageLogger),
+		request.Header.ApiKey.Value,
+		stageLogger,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	assertion := response_assertions.NewApiVersionsResponseAssertion().
+		ExpectCorrelationId(request.Header.CorrelationId.Value).
+		ExpectErrorCode(0).
+		ExpectApiKeyEntry(18, 0, 4)
+
+	_, err = response_asserter.ResponseAsserter[kafkaapi.ApiVersionsResponse]{
+		DecodeFunc: response_decoders.DecodeApiVersionsResponse,
+		Assertion:  assertion,
+		Logger:     stageLogger,
+	}.DecodeAndAssert(rawResponse)
+
+	return err
+}
+
+// getRandomUnknownTaggedFields returns tagged fields with tags that aren't defined for any request
+func getRandomUnknownTaggedFields(count int) value.TaggedFields {
+	taggedFields := value.TaggedFields{}
+	tag := uint64(random.RandomInt(1000, 2000))
+
+	for range count {
+		taggedFields.Fields = append(taggedFields.Fields, value.TaggedField{
+			Tag:  tag,
+			Data: []byte(random.RandomWord()),
+		})
+
+		tag += uint64(random.RandomInt(1, 100))
+	}
+
+	return taggedFields
+}
@@ -40,6 +40,13 @@ func TestStages(t *testing.T) {
 			StdoutFixturePath:   "./test_helpers/fixtures/base/insufficient_message_length_bytes",
 			NormalizeOutputFunc: normalizeTesterOutput,
 		},
+		"base_stage_trailing_bytes_pv1": {
+			StageSlugs:          []string{"pv1"},
+			CodePath:            "./test_helpers/scenarios/base/trailing_bytes_pv1",
+			ExpectedExitCode:    1,
+			StdoutFixturePath:   "./test_helpers/fixtures/base/trailing_bytes_pv1",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
 		"base_stage_correlation_id_mismatch": {
 			StageSlugs:          []string{"pv1"},
 			CodePath:            "./test_helpers/scenarios/base/correlation_id_mismatch",
@@ -69,12 +76,26 @@ func TestStages(t *testing.T) {
 			NormalizeOutputFunc: normalizeTesterOutput,
 		},
 		"concurrent_stages_pass": {
-			StageSlugs:          []string{"nh4", "sk0"},
+			StageSlugs:          []string{"nh4", "sk0", "pq7", "gx4", "mb2", "kp5"},
 			CodePath:            "./test_helpers/pass_all",
 			ExpectedExitCode:    0,
 			StdoutFixturePath:   "./test_helpers/fixtures/concurrent_stages/pass",
 			NormalizeOutputFunc: normalizeTesterOutput,
 		},
+		"concurrent_stages_out_of_order_responses_pq7": {
+			StageSlugs:          []string{"pq7"},
+			CodePath:            "./test_helpers/scenarios/concurrent_stages/out_of_order_responses_pq7",
+			ExpectedExitCode:    1,
+			StdoutFixturePath:   "./test_helpers/fixtures/concurrent_stages/out_of_order_responses_pq7",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"concurrent_stages_close_after_response_kp5": {
+			StageSlugs:          []string{"kp5"},
+			CodePath:            "./test_helpers/scenarios/concurrent_stages/close_after_response_kp5",
+			ExpectedExitCode:    1,
+			StdoutFixturePath:   "./test_helpers/fixtures/concurrent_stages/close_after_response_kp5",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
 		"describe_topic_partitions_pass": {
 			StageSlugs:          []string{"yk1", "vt6", "ea7", "ku4", "wq2"},
 			CodePath:            "./test_helpers/pass_all",
@@ -83,7 +104,7 @@ func TestStages(t *testing.T) {
 			NormalizeOutputFunc: normalizeTesterOutput,
 		},
 		"fetch_pass": {
-			StageSlugs:          []string{"gs0", "dh6", "hn6", "cm4", "eg2", "fd8"},
+			StageSlugs:          []string{"gs0", "dh6", "hn6", "cm4", "eg2", "fd8", "lp7"},
 			CodePath:            "./test_helpers/pass_all",
 			ExpectedExitCode:    0,
 			StdoutFixturePath:   "./test_helpers/fixtures/fetch/pass",
@@ -96,6 +117,90 @@ func TestStages(t *testing.T) {
 			StdoutFixturePath:   "./test_helpers/fixtures/produce/pass",
 			NormalizeOutputFunc: normalizeTesterOutput,
 		},
+		"tls_pass": {
+			StageSlugs:          []string{"qv3", "hw8", "jk5"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/tls/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"sasl_pass": {
+			StageSlugs:          []string{"kd2", "vb7", "rf4", "mu6"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/sasl/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"listeners_pass": {
+			StageSlugs:          []string{"ln4"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/listeners/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"tagged_fields_pass": {
+			StageSlugs:          []string{"ut3"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/tagged_fields/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"older_api_versions_pass": {
+			StageSlugs:          []string{"ov5"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/older_api_versions/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"record_headers_pass": {
+			StageSlugs:          []string{"hr3", "hk6"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/record_headers/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"compression_pass": {
+			StageSlugs:          []string{"cz2", "cq8"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/compression/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"create_topics_pass": {
+			StageSlugs:          []string{"kt7", "rw2", "xn5"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/create_topics/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"metadata_pass": {
+			StageSlugs:          []string{"mq4", "hd9", "zv3"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/metadata/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"list_offsets_pass": {
+			StageSlugs:          []string{"pf6", "jt2", "wb8"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/list_offsets/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"offset_commits_pass": {
+			StageSlugs:          []string{"rq5", "kd7", "ne4"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/offset_commits/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
+		"consumer_groups_pass": {
+			StageSlugs:          []string{"gc4", "jm8", "rb2"},
+			CodePath:            "./test_helpers/pass_all",
+			ExpectedExitCode:    0,
+			StdoutFixturePath:   "./test_helpers/fixtures/consumer_groups/pass",
+			NormalizeOutputFunc: normalizeTesterOutput,
+		},
 		"fetch_recordbatch_checksum_fail": {
 			StageSlugs:          []string{"fd8"},
 			CodePath:            "./test_helpers/scenarios/fetch/recordbatch_checksum_fail",
@@ -141,6 +246,10 @@ func normalizeTesterOutput(testerOutput []byte) []byte {
 		"Record Value":           {regexp.MustCompile(`✓ Record\[[0-9]{1,}\] Value: [A-Za-z0-9 !]{1,}`)},
 		"RecordBatch BaseOffset": {regexp.MustCompile(`✓ RecordBatch\[[0-9]{1,}\] BaseOffset: [0-9]{1,}`)},
 		"SessionId":              {regexp.MustCompile(`- SessionID (\[0-9].*)`), regexp.MustCompile(`- SessionID \([0-9]+\)`)},
+		"MemberId":               {regexp.MustCompile(`✓ (Members\[[0-9]+\]\.)?MemberId: \S+`), regexp.MustCompile(`- MemberId \(.*\)`), regexp.MustCompile(`member( ID)? "[^"]*"`)},
+		"Leader":                 {regexp.MustCompile(`✓ Leader: \S+`), regexp.MustCompile(`- Leader \(.*\)`)},
+		"TopicId":                {regexp.MustCompile(`✓ Topics\[[0-9]+\]\.TopicId: \S+`), regexp.MustCompile(`- TopicId \(.*\)`)},
+		"AuthBytes":              {regexp.MustCompile(`- AuthBytes \(.*\)`)},
 	}
 
 	for replacement, regexes := range replacements {
@@ -149,5 +258,9 @@ func normalizeTesterOutput(testerOutput []byte) []byte {
 		}
 	}
 
+	// Latencies vary between runs, so the latency summary is left out of fixtures entirely
+	latencySummaryRegex := regexp.MustCompile(`(?m)^\x1b\[33m\[tester::#[a-zA-Z0-9-]{3}\]( \[[a-zA-Z0-9-]+\])? \x1b\[0m\x1b\[36mLatency for ".*\n`)
+	testerOutput = latencySummaryRegex.ReplaceAll(testerOutput, nil)
+
 	return testerOutput
 }
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+)
+
+func main() {
+	fmt.Println("Starting Kafka broker on port 9092...")
+
+	// Listen on port 9092
+	listener, err := net.Listen("tcp", ":9092")
+	if err != nil {
+		fmt.Printf("Error starting server: %v\n", err)
+		os.Exit(1)
+	}
+	defer listener.Close()
+
+	fmt.Println("Broker listening on port 9092.")
+	fmt.Println("This server closes the connection after sending a single response")
+
+	for {
+		// Accept incoming connections
+		conn, err := listener.Accept()
+		if err != nil {
+			fmt.Printf("Error accepting connection: %v\n", err)
+			continue
+		}
+
+		// Handle connection in a goroutine
+		go handleConnection(conn)
+	}
+}
+
+func handleConnection(conn net.Conn) {
+	defer conn.Close()
+
+	messageSizeBytes := make([]byte, 4)
+	if _, err := io.ReadFull(conn, messageSizeBytes); err != nil {
+		return
+	}
+
+	message := make([]byte, binary.BigEndian.Uint32(messageSizeBytes))
+	if _, err := io.ReadFull(conn, message); err != nil {
+		return
+	}
+
+	correlationId := message[4:8]
+
+	// ApiVersions v4 response with a single entry for ApiVersions (key 18, versions 0-4)
+	body := []byte{}
+	body = append(body, correlationId...)
+	body = append(body, 0, 0)                 // error_code
+	body = append(body, 2)                    // api_keys (compact array, 1 element)
+	body = append(body, 0, 18, 0, 0, 0, 4, 0) // api_key, min_version, max_version, tag buffer
+	body = append(body, 0, 0, 0, 0)           // throttle_time_ms
+	body = append(body, 0)                    // tag buffer
+
+	response := make([]byte, 4)
+	binary.BigEndian.PutUint32(response, uint32(len(body)))
+	response = append(response, body...)
+
+	if _, err := conn.Write(response); err != nil {
+		fmt.Printf("Error writing response: %v\n", err)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+)
+
+func main() {
+	fmt.Println("Starting Kafka broker on port 9092...")
+
+	// Listen on port 9092
+	listener, err := net.Listen("tcp", ":9092")
+	if err != nil {
+		fmt.Printf("Error starting server: %v\n", err)
+		os.Exit(1)
+	}
+	defer listener.Close()
+
+	fmt.Println("Broker listening on port 9092.")
+	fmt.Println("This server answers pipelined requests in reverse order")
+
+	for {
+		// Accept incoming connections
+		conn, err := listener.Accept()
+		if err != nil {
+			fmt.Printf("Error accepting connection: %v\n", err)
+			continue
+		}
+
+		// Handle connection in a goroutine
+		go handleConnection(conn)
+	}
+}
+
+func handleConnection(conn net.Conn) {
+	defer conn.Close()
+
+	// Read requests until the client stops sending
+	correlationIds := []uint32{}
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+
+		messageSizeBytes := make([]byte, 4)
+		if _, err := io.ReadFull(conn, messageSizeBytes); err != nil {
+			break
+		}
+
+		message := make([]byte, binary.BigEndian.Uint32(messageSizeBytes))
+		if _, err := io.ReadFull(conn, message); err != nil {
+			break
+		}
+
+		correlationIds = append(correlationIds, binary.BigEndian.Uint32(message[4:8]))
+	}
+
+	conn.SetReadDeadline(time.Time{})
+
+	// Respond in reverse order: message_size (4 bytes) + correlation_id (4 bytes)
+	for i := len(correlationIds) - 1; i >= 0; i-- {
+		response := make([]byte, 8)
+		binary.BigEndian.PutUint32(response[0:4], 4)
+		binary.BigEndian.PutUint32(response[4:8], correlationIds[i])
+
+		if _, err := conn.Write(response); err != nil {
+			fmt.Printf("Error writing response: %v\n", err)
+			return
+		}
+	}
+}
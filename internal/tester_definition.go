@@ -42,6 +42,22 @@ var testerDefinition = tester_definition.TesterDefinition{
 			Slug:     "sk0",
 			TestFunc: testConcurrentRequests,
 		},
+		{
+			Slug:     "pq7",
+			TestFunc: testPipelinedRequests,
+		},
+		{
+			Slug:     "gx4",
+			TestFunc: testFragmentedRequests,
+		},
+		{
+			Slug:     "mb2",
+			TestFunc: testCoalescedRequests,
+		},
+		{
+			Slug:     "kp5",
+			TestFunc: testPersistentConnection,
+		},
 		// DescribeTopicPartitions
 		{
 			Slug:     "yk1",
@@ -88,6 +104,10 @@ var testerDefinition = tester_definition.TesterDefinition{
 			Slug:     "fd8",
 			TestFunc: testFetchMultipleMessages,
 		},
+		{
+			Slug:     "lp7",
+			TestFunc: testFetchWaitsForMaxWait,
+		},
 		// Produce
 		{
 			Slug:     "xz1",
@@ -117,5 +137,133 @@ var testerDefinition = tester_definition.TesterDefinition{
 			Slug:     "ov0",
 			TestFunc: testProduceForMultipleTopics,
 		},
+		// TLS
+		{
+			Slug:     "qv3",
+			TestFunc: testAPIVersionOverTLS,
+		},
+		{
+			Slug:     "hw8",
+			TestFunc: testFetchOverTLS,
+		},
+		{
+			Slug:     "jk5",
+			TestFunc: testMutualTLS,
+		},
+		// SASL
+		{
+			Slug:     "kd2",
+			TestFunc: testSaslPlainAuthentication,
+		},
+		{
+			Slug:     "vb7",
+			TestFunc: testSaslScramAuthentication,
+		},
+		{
+			Slug:     "rf4",
+			TestFunc: testSaslInvalidCredentials,
+		},
+		{
+			Slug:     "mu6",
+			TestFunc: testSaslUnauthenticatedRequest,
+		},
+		// Listeners
+		{
+			Slug:     "ln4",
+			TestFunc: testListenerPortFromServerProperties,
+		},
+		// Tagged fields
+		{
+			Slug:     "ut3",
+			TestFunc: testUnknownTaggedFields,
+		},
+		// Older API versions
+		{
+			Slug:     "ov5",
+			TestFunc: testOlderApiVersions,
+		},
+		// Record headers
+		{
+			Slug:     "hr3",
+			TestFunc: testFetchRecordHeaders,
+		},
+		{
+			Slug:     "hk6",
+			TestFunc: testProduceRecordHeaders,
+		},
+		// Compression
+		{
+			Slug:     "cz2",
+			TestFunc: testFetchCompressedRecordBatches,
+		},
+		{
+			Slug:     "cq8",
+			TestFunc: testProduceCompressedRecordBatches,
+		},
+		// Create topics
+		{
+			Slug:     "kt7",
+			TestFunc: testCreateTopic,
+		},
+		{
+			Slug:     "rw2",
+			TestFunc: testCreateExistingTopic,
+		},
+		{
+			Slug:     "xn5",
+			TestFunc: testCreateInvalidTopics,
+		},
+		// Metadata
+		{
+			Slug:     "mq4",
+			TestFunc: testMetadataForTopic,
+		},
+		{
+			Slug:     "hd9",
+			TestFunc: testMetadataForUnknownTopic,
+		},
+		{
+			Slug:     "zv3",
+			TestFunc: testMetadataForAllTopics,
+		},
+		// List offsets
+		{
+			Slug:     "pf6",
+			TestFunc: testListEarliestAndLatestOffsets,
+		},
+		{
+			Slug:     "jt2",
+			TestFunc: testListMaxTimestampOffsets,
+		},
+		{
+			Slug:     "wb8",
+			TestFunc: testListOffsetsForTimestamp,
+		},
+		// Offset commits
+		{
+			Slug:     "rq5",
+			TestFunc: testCommitAndFetchOffsets,
+		},
+		{
+			Slug:     "kd7",
+			TestFunc: testFetchOffsetsForMultipleGroups,
+		},
+		{
+			Slug:     "ne4",
+			TestFunc: testCommittedOffsetsPersistAcrossRestarts,
+		},
+		// Consumer groups
+		{
+			Slug:     "gc4",
+			TestFunc: testFindGroupCoordinator,
+		},
+		{
+			Slug:     "jm8",
+			TestFunc: testJoinAndSyncSingleMemberGroup,
+		},
+		{
+			Slug:     "rb2",
+			TestFunc: testRebalanceMultiMemberGroup,
+		},
 	},
 }
@@ -3,11 +3,13 @@ package internal
 import (
 	"encoding/base64"
 	"fmt"
-	"math"
 	"strings"
 
 	"github.com/codecrafters-io/kafka-tester/protocol/builder"
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
 	"github.com/codecrafters-io/tester-utils/random"
 	"github.com/google/uuid"
 )
@@ -16,10 +18,6 @@ func getInvalidAPIVersion() int16 {
 	return int16(random.RandomInt(1000, 2000))
 }
 
-func getRandomCorrelationId() int32 {
-	return int32(random.RandomInt(0, math.MaxInt32-1))
-}
-
 func getRandomTopicUUID() string {
 	// Generate a deterministic UUID format with randomizable trailing digits
 	// Uses format: 71a59a51-8968-4f8b-937e-xxxxxxxxxxxx where x are random hex digits
@@ -63,6 +61,11 @@ func getRandomTopicNames(count int) []string {
 	return random.RandomWords(count)
 }
 
+// getNewTopicName returns a random topic name that doesn't clash with generated topics, which are named using single words
+func getNewTopicName() string {
+	return fmt.Sprintf("%s-%d", random.RandomWord(), random.RandomInt(100, 1000))
+}
+
 func getRandomTopicUUIDs(count int) []string {
 	// Generate deterministic UUIDs with incremental suffixes to ensure uniqueness
 	// Uses format: 71a59a51-8968-4f8b-937e-xxxxxxxxxxxx where x are deterministic hex digits
@@ -118,6 +121,37 @@ func generateEmptyPartitionConfigs(numPartitions int) []kafka_files_generator.Pa
 	return partitionConfigs
 }
 
+// generatePartitionConfigsWithRandomLogs prepares partitions that each have 2-4 logs, with increasing timestamps
+func generatePartitionConfigsWithRandomLogs(numPartitions int) []kafka_files_generator.PartitionGenerationConfig {
+	partitionConfigs := make([]kafka_files_generator.PartitionGenerationConfig, numPartitions)
+	for i := range numPartitions {
+		partitionConfigs[i] = kafka_files_generator.PartitionGenerationConfig{
+			PartitionId: i,
+			Logs:        random.RandomWords(random.RandomInt(2, 5)),
+		}
+	}
+	return partitionConfigs
+}
+
+// getConsumerOffsetsTopicGenerationConfig prepares the topic that committed offsets are stored in.
+// Brokers usually create it when a client first looks up a group coordinator, generating it lets offsets be committed without that lookup.
+func getConsumerOffsetsTopicGenerationConfig() kafka_files_generator.TopicGenerationConfig {
+	return kafka_files_generator.TopicGenerationConfig{
+		Name:                         kafka_files_generator.CONSUMER_OFFSETS_TOPIC_NAME,
+		UUID:                         getRandomTopicUUID(),
+		PartitonGenerationConfigList: generateEmptyPartitionConfigs(kafka_files_generator.CONSUMER_OFFSETS_TOPIC_PARTITIONS_COUNT),
+	}
+}
+
+// getRandomGroupIds returns unique group IDs, they're suffixed so that they don't look like topic names in logs
+func getRandomGroupIds(count int) []string {
+	groupIds := []string{}
+	for _, word := range random.RandomWords(count) {
+		groupIds = append(groupIds, word+"-group")
+	}
+	return groupIds
+}
+
 // generatePartitionRequestWithRandomLogs generates partition data for a Produce request
 // with random log messages. It creates ProduceRequestPartitionData for the specified
 // number of partitions, each containing 2-4 random log messages.
@@ -131,3 +165,34 @@ func generatePartitionRequestWithRandomLogs(numPartitions int) []builder.Produce
 	}
 	return partitionCreationData
 }
+
+func getRandomSASLUser() kafka_files_generator.SASLUser {
+	return kafka_files_generator.SASLUser{
+		Username: random.RandomWord(),
+		Password: fmt.Sprintf("%s-%s", random.RandomWord(), random.RandomWord()),
+	}
+}
+
+// getRandomRecordHeaders returns record headers with random keys and values, the last header has a null value
+func getRandomRecordHeaders(count int) []kafkaapi.RecordHeader {
+	headers := []kafkaapi.RecordHeader{}
+
+	for i, key := range random.RandomWords(count) {
+		header := kafkaapi.RecordHeader{
+			Key: value.RawBytes{Value: []byte(key)},
+		}
+
+		if i < count-1 {
+			header.Value = value.RawBytes{Value: []byte(random.RandomWord())}
+		}
+
+		headers = append(headers, header)
+	}
+
+	return headers
+}
+
+// getRandomCompressionCodec returns one of the codecs that record batches can be compressed with
+func getRandomCompressionCodec() compression.Codec {
+	return random.RandomElementFromArray([]compression.Codec{compression.Gzip, compression.Snappy, compression.LZ4, compression.Zstd})
+}
@@ -0,0 +1,21 @@
+package value_assertions
+
+import (
+	"bytes"
+	"fmt"
+
+	value "github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func IsEqualTo(expectedValue []byte, actualValue value.KafkaProtocolValue) error {
+	castedActualValue, ok := actualValue.(value.Bytes)
+	if !ok {
+		panic("CodeCrafters Internal Error: Expected BYTES value, got " + actualValue.GetType())
+	}
+
+	if !bytes.Equal(castedActualValue.Value, expectedValue) {
+		return fmt.Errorf("Error: Expected BYTES value to be %s, got %s", value.Bytes{Value: expectedValue}, castedActualValue)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package value_assertions
+
+import (
+	"bytes"
+	"fmt"
+
+	value "github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// IsEqualTo treats a nil expectedValue as NULL, which is different from an empty byte array
+func IsEqualTo(expectedValue []byte, actualValue value.KafkaProtocolValue) error {
+	castedActualValue, ok := actualValue.(value.CompactNullableBytes)
+	if !ok {
+		panic("CodeCrafters Internal Error: Expected COMPACT_NULLABLE_BYTES value, got " + actualValue.GetType())
+	}
+
+	isNullMismatch := (expectedValue == nil) != (castedActualValue.Value == nil)
+	if isNullMismatch || !bytes.Equal(castedActualValue.Value, expectedValue) {
+		return fmt.Errorf("Error: Expected COMPACT_NULLABLE_BYTES value to be %s, got %s", value.CompactNullableBytes{Value: expectedValue}, castedActualValue)
+	}
+
+	return nil
+}
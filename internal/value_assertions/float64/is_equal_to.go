@@ -0,0 +1,20 @@
+package value_assertions
+
+import (
+	"fmt"
+
+	value "github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func IsEqualTo(expectedValue float64, actualValue value.KafkaProtocolValue) error {
+	castedActualValue, ok := actualValue.(value.Float64)
+	if !ok {
+		panic("CodeCrafters Internal Error: Expected FLOAT64 value, got " + actualValue.GetType())
+	}
+
+	if castedActualValue.Value != expectedValue {
+		return fmt.Errorf("Error: Expected FLOAT64 value to be %v, got %v", expectedValue, castedActualValue.Value)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package value_assertions
+
+import (
+	"fmt"
+
+	value "github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// IsEqualTo treats a nil expectedValue as NULL, which is different from an empty string
+func IsEqualTo(expectedValue *string, actualValue value.KafkaProtocolValue) error {
+	castedActualValue, ok := actualValue.(value.NullableString)
+	if !ok {
+		panic("CodeCrafters Internal Error: Expected NULLABLE_STRING value, got " + actualValue.GetType())
+	}
+
+	isNullMismatch := (expectedValue == nil) != (castedActualValue.Value == nil)
+	if isNullMismatch || (expectedValue != nil && *expectedValue != *castedActualValue.Value) {
+		return fmt.Errorf("Error: Expected NULLABLE_STRING value to be %s, got %s", value.NullableString{Value: expectedValue}, castedActualValue)
+	}
+
+	return nil
+}
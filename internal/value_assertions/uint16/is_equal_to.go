@@ -0,0 +1,20 @@
+package value_assertions
+
+import (
+	"fmt"
+
+	value "github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+func IsEqualTo(expectedValue uint16, actualValue value.KafkaProtocolValue) error {
+	castedActualValue, ok := actualValue.(value.Uint16)
+	if !ok {
+		panic("CodeCrafters Internal Error: Expected UINT16 value, got " + actualValue.GetType())
+	}
+
+	if castedActualValue.Value != expectedValue {
+		return fmt.Errorf("Error: Expected UINT16 value to be %d, got %d", expectedValue, castedActualValue.Value)
+	}
+
+	return nil
+}
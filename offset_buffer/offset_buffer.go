@@ -1,12 +1,37 @@
 package offset_buffer
 
+import (
+	"fmt"
+	"io"
+)
+
+// readerChunkSize is how many bytes a reader-backed buffer reads ahead at a time
+const readerChunkSize = 64 * 1024
+
+// Buffer tracks a read offset over bytes that are either fully in memory or streamed from an io.Reader.
+//
+// Offsets are always absolute (from the start of the payload), even when the bytes before them have been discarded.
 type Buffer struct {
-	data   []byte
+	// data holds the bytes from windowStartOffset onwards that have been buffered so far
+	data              []byte
+	windowStartOffset uint64
+
 	offset uint64
+	length uint64
+
+	reader  io.Reader
+	readErr error
 }
 
 func NewBuffer(bytes []byte) *Buffer {
-	return &Buffer{data: bytes}
+	return &Buffer{data: bytes, length: uint64(len(bytes))}
+}
+
+// NewReaderBuffer returns a buffer that reads length bytes from reader on demand.
+//
+// Only the bytes that haven't been read yet (and at least one chunk) are kept in memory.
+func NewReaderBuffer(reader io.Reader, length uint64) *Buffer {
+	return &Buffer{data: []byte{}, length: length, reader: reader}
 }
 
 func (b *Buffer) Offset() uint64 {
@@ -14,20 +39,75 @@ func (b *Buffer) Offset() uint64 {
 }
 
 func (b *Buffer) RemainingBytesCount() uint64 {
-	return uint64(len(b.data)) - b.offset
+	return b.length - b.offset
 }
 
-func (b *Buffer) RemainingBytes() []byte {
-	return b.data[b.offset:]
+// Err returns the error that the underlying reader failed with, if any.
+//
+// Once the reader fails, the buffer acts as if the payload ended at the last byte that was read, so decoding stops there.
+func (b *Buffer) Err() error {
+	return b.readErr
 }
 
-func (b *Buffer) MustReadNBytes(length uint64) []byte {
+// PeekNBytes returns up to length bytes from the current offset without reading them
+func (b *Buffer) PeekNBytes(length uint64) []byte {
+	length = min(length, b.RemainingBytesCount())
+	b.fill(length)
+
+	relativeOffset := b.offset - b.windowStartOffset
+	return b.data[relativeOffset : relativeOffset+min(length, b.bufferedBytesCount())]
+}
+
+// HasNBytes returns true if length bytes can be read from the current offset.
+//
+// For reader-backed buffers, this is where read failures are found, so it must be checked before MustReadNBytes.
+func (b *Buffer) HasNBytes(length uint64) bool {
 	if b.RemainingBytesCount() < length {
+		return false
+	}
+
+	b.fill(length)
+	return b.bufferedBytesCount() >= length
+}
+
+func (b *Buffer) MustReadNBytes(length uint64) []byte {
+	if !b.HasNBytes(length) {
 		panic("Codecrafters Internal Error - Remaining bytes is less than length")
 	}
 
+	relativeOffset := b.offset - b.windowStartOffset
 	result := make([]byte, length)
-	copy(result, b.data[b.offset:])
+	copy(result, b.data[relativeOffset:relativeOffset+length])
+
 	b.offset += length
 	return result
 }
+
+func (b *Buffer) bufferedBytesCount() uint64 {
+	return b.windowStartOffset + uint64(len(b.data)) - b.offset
+}
+
+// fill makes sure that at least length bytes after the current offset are buffered
+func (b *Buffer) fill(length uint64) {
+	if b.reader == nil || b.bufferedBytesCount() >= length {
+		return
+	}
+
+	// Drop the bytes that have been read already, so the window doesn't grow with the payload
+	relativeOffset := b.offset - b.windowStartOffset
+	b.data = append(b.data[:0], b.data[relativeOffset:]...)
+	b.windowStartOffset = b.offset
+
+	bufferedEndOffset := b.offset + uint64(len(b.data))
+	readLength := min(max(length-uint64(len(b.data)), readerChunkSize), b.length-bufferedEndOffset)
+
+	chunk := make([]byte, readLength)
+	n, err := io.ReadFull(b.reader, chunk)
+	b.data = append(b.data, chunk[:n]...)
+
+	if err != nil {
+		// Truncate the payload so that the decoder reports an error instead of reading bytes that don't exist
+		b.readErr = fmt.Errorf("failed to read bytes %d-%d: %w", bufferedEndOffset, bufferedEndOffset+readLength-1, err)
+		b.length = bufferedEndOffset + uint64(n)
+	}
+}
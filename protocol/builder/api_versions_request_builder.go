@@ -10,6 +10,8 @@ type ApiVersionsRequestBuilder struct {
 	correlationId         int32
 	clientSoftwareName    string
 	clientSoftwareVersion string
+	headerTaggedFields    value.TaggedFields
+	bodyTaggedFields      value.TaggedFields
 }
 
 func NewApiVersionsRequestBuilder() *ApiVersionsRequestBuilder {
@@ -31,13 +33,26 @@ func (b *ApiVersionsRequestBuilder) WithVersion(version int16) *ApiVersionsReque
 	return b
 }
 
+// WithHeaderTaggedFields sets the tagged fields sent in the request header's tag buffer
+func (b *ApiVersionsRequestBuilder) WithHeaderTaggedFields(taggedFields value.TaggedFields) *ApiVersionsRequestBuilder {
+	b.headerTaggedFields = taggedFields
+	return b
+}
+
+// WithBodyTaggedFields sets the tagged fields sent in the request body's tag buffer
+func (b *ApiVersionsRequestBuilder) WithBodyTaggedFields(taggedFields value.TaggedFields) *ApiVersionsRequestBuilder {
+	b.bodyTaggedFields = taggedFields
+	return b
+}
+
 func (b *ApiVersionsRequestBuilder) Build() kafkaapi.ApiVersionsRequest {
 	return kafkaapi.ApiVersionsRequest{
-		Header: NewRequestHeaderBuilder().BuildApiVersionsRequestHeader(b.correlationId, b.version),
+		Header: NewRequestHeaderBuilder().WithTaggedFields(b.headerTaggedFields).BuildApiVersionsRequestHeader(b.correlationId, b.version),
 		Body: kafkaapi.ApiVersionsRequestBody{
 			Version:               value.Int16{Value: b.version},
 			ClientSoftwareName:    value.CompactString{Value: b.clientSoftwareName},
 			ClientSoftwareVersion: value.CompactString{Value: b.clientSoftwareVersion},
+			TaggedFields:          b.bodyTaggedFields,
 		},
 	}
 }
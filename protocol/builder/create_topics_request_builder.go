@@ -0,0 +1,64 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type CreateTopicsRequestTopicData struct {
+	Name              string
+	NumPartitions     int32
+	ReplicationFactor int16
+}
+
+type CreateTopicsRequestBuilder struct {
+	correlationId int32
+	topics        []CreateTopicsRequestTopicData
+	timeoutMs     int32
+}
+
+func NewCreateTopicsRequestBuilder() *CreateTopicsRequestBuilder {
+	return &CreateTopicsRequestBuilder{
+		timeoutMs: 5000,
+	}
+}
+
+func (b *CreateTopicsRequestBuilder) WithCorrelationId(correlationId int32) *CreateTopicsRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *CreateTopicsRequestBuilder) WithTopics(topics []CreateTopicsRequestTopicData) *CreateTopicsRequestBuilder {
+	b.topics = topics
+	return b
+}
+
+func (b *CreateTopicsRequestBuilder) WithTimeoutMs(timeoutMs int32) *CreateTopicsRequestBuilder {
+	b.timeoutMs = timeoutMs
+	return b
+}
+
+func (b *CreateTopicsRequestBuilder) Build() generated_kafkaapi.CreateTopicsRequest {
+	topics := []generated_kafkaapi.CreateTopicsRequestCreatableTopic{}
+
+	for _, topic := range b.topics {
+		topics = append(topics, generated_kafkaapi.CreateTopicsRequestCreatableTopic{
+			Name:              value.String{Value: topic.Name},
+			NumPartitions:     value.Int32{Value: topic.NumPartitions},
+			ReplicationFactor: value.Int16{Value: topic.ReplicationFactor},
+			// We always let the broker assign partitions, and use the default configs
+			Assignments: []generated_kafkaapi.CreateTopicsRequestCreatableReplicaAssignment{},
+			Configs:     []generated_kafkaapi.CreateTopicsRequestCreatableTopicConfig{},
+		})
+	}
+
+	return generated_kafkaapi.CreateTopicsRequest{
+		// Always send v7 of CreateTopics Request, it's the first version that returns topic IDs
+		Header: NewRequestHeaderBuilder().BuildCreateTopicsRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.CreateTopicsRequestBody{
+			Topics:       topics,
+			TimeoutMs:    value.Int32{Value: b.timeoutMs},
+			ValidateOnly: value.Boolean{Value: false},
+		},
+	}
+}
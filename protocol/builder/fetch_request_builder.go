@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
@@ -17,14 +18,25 @@ type FetchRequestTopic struct {
 }
 
 type FetchRequestBuilder struct {
+	apiVersion    int16
 	correlationId int32
 	sessionId     int32
 	topicUUID     string
+	topicName     string
 	partitionID   int32
+	maxWaitMS     int32
 }
 
 func NewFetchRequestBuilder() *FetchRequestBuilder {
-	return &FetchRequestBuilder{}
+	return &FetchRequestBuilder{
+		apiVersion: 16,
+		maxWaitMS:  500,
+	}
+}
+
+func (b *FetchRequestBuilder) WithApiVersion(apiVersion int16) *FetchRequestBuilder {
+	b.apiVersion = apiVersion
+	return b
 }
 
 func (b *FetchRequestBuilder) WithCorrelationId(correlationId int32) *FetchRequestBuilder {
@@ -42,22 +54,39 @@ func (b *FetchRequestBuilder) WithTopicUUID(topicUUID string) *FetchRequestBuild
 	return b
 }
 
+// WithTopicName sets the topic name, which is sent instead of the topic UUID in versions before 13
+func (b *FetchRequestBuilder) WithTopicName(topicName string) *FetchRequestBuilder {
+	b.topicName = topicName
+	return b
+}
+
 func (b *FetchRequestBuilder) WithPartitionID(partitionID int32) *FetchRequestBuilder {
 	b.partitionID = partitionID
 	return b
 }
 
+func (b *FetchRequestBuilder) WithMaxWaitMS(maxWaitMS int32) *FetchRequestBuilder {
+	b.maxWaitMS = maxWaitMS
+	return b
+}
+
 func (b *FetchRequestBuilder) Build() kafkaapi.FetchRequest {
+	if b.apiVersion < 13 && b.topicName == "" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Fetch v%d identifies topics by name, use WithTopicName", b.apiVersion))
+	}
+
 	// We have not designed stages for fetching from multiple topics/partitions
 	return kafkaapi.FetchRequest{
-		Header: NewRequestHeaderBuilder().BuildFetchRequestHeader(b.correlationId),
+		Header: NewRequestHeaderBuilder().BuildFetchRequestHeader(b.correlationId, b.apiVersion),
 		Body: kafkaapi.FetchRequestBody{
-			MaxWaitMS: value.Int32{Value: 500},
+			ReplicaId: value.Int32{Value: -1},
+			MaxWaitMS: value.Int32{Value: b.maxWaitMS},
 			MinBytes:  value.Int32{Value: 1},
 			MaxBytes:  value.Int32{Value: math.MaxInt32},
 			SessionId: value.Int32{Value: b.sessionId},
 			Topics: []kafkaapi.Topic{
 				{
+					Name: value.CompactString{Value: b.topicName},
 					UUID: value.UUID{Value: b.topicUUID},
 					Partitions: []kafkaapi.Partition{
 						{
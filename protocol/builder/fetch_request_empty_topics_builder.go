@@ -22,8 +22,9 @@ func (b *FetchRequstWithEmptyTopicsBuilder) WithCorrelationId(correlationId int3
 
 func (b *FetchRequstWithEmptyTopicsBuilder) Build() kafkaapi.FetchRequest {
 	return kafkaapi.FetchRequest{
-		Header: NewRequestHeaderBuilder().BuildFetchRequestHeader(b.correlationId),
+		Header: NewRequestHeaderBuilder().BuildFetchRequestHeader(b.correlationId, 16),
 		Body: kafkaapi.FetchRequestBody{
+			ReplicaId:       value.Int32{Value: -1},
 			MaxWaitMS:       value.Int32{Value: 500},
 			MinBytes:        value.Int32{Value: 1},
 			MaxBytes:        value.Int32{Value: math.MaxInt32},
@@ -0,0 +1,50 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type FindCoordinatorRequestBuilder struct {
+	correlationId   int32
+	keyType         int8
+	coordinatorKeys []string
+}
+
+func NewFindCoordinatorRequestBuilder() *FindCoordinatorRequestBuilder {
+	return &FindCoordinatorRequestBuilder{}
+}
+
+func (b *FindCoordinatorRequestBuilder) WithCorrelationId(correlationId int32) *FindCoordinatorRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+// WithKeyType sets the type of coordinator to find, 0 for group coordinators (the default) and 1 for transaction coordinators
+func (b *FindCoordinatorRequestBuilder) WithKeyType(keyType int8) *FindCoordinatorRequestBuilder {
+	b.keyType = keyType
+	return b
+}
+
+// WithCoordinatorKeys sets the group IDs (or transactional IDs) to find coordinators for
+func (b *FindCoordinatorRequestBuilder) WithCoordinatorKeys(coordinatorKeys []string) *FindCoordinatorRequestBuilder {
+	b.coordinatorKeys = coordinatorKeys
+	return b
+}
+
+func (b *FindCoordinatorRequestBuilder) Build() generated_kafkaapi.FindCoordinatorRequest {
+	coordinatorKeys := []value.String{}
+
+	for _, coordinatorKey := range b.coordinatorKeys {
+		coordinatorKeys = append(coordinatorKeys, value.String{Value: coordinatorKey})
+	}
+
+	return generated_kafkaapi.FindCoordinatorRequest{
+		// Always send v4 of FindCoordinator Request, it's the first version that finds coordinators for multiple keys
+		Header: NewRequestHeaderBuilder().BuildFindCoordinatorRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.FindCoordinatorRequestBody{
+			KeyType:         value.Int8{Value: b.keyType},
+			CoordinatorKeys: coordinatorKeys,
+		},
+	}
+}
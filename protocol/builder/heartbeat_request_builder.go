@@ -0,0 +1,50 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type HeartbeatRequestBuilder struct {
+	correlationId int32
+	groupId       string
+	generationId  int32
+	memberId      string
+}
+
+func NewHeartbeatRequestBuilder() *HeartbeatRequestBuilder {
+	return &HeartbeatRequestBuilder{}
+}
+
+func (b *HeartbeatRequestBuilder) WithCorrelationId(correlationId int32) *HeartbeatRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *HeartbeatRequestBuilder) WithGroupId(groupId string) *HeartbeatRequestBuilder {
+	b.groupId = groupId
+	return b
+}
+
+func (b *HeartbeatRequestBuilder) WithGenerationId(generationId int32) *HeartbeatRequestBuilder {
+	b.generationId = generationId
+	return b
+}
+
+func (b *HeartbeatRequestBuilder) WithMemberId(memberId string) *HeartbeatRequestBuilder {
+	b.memberId = memberId
+	return b
+}
+
+func (b *HeartbeatRequestBuilder) Build() generated_kafkaapi.HeartbeatRequest {
+	return generated_kafkaapi.HeartbeatRequest{
+		// Always send v4 of Heartbeat Request, the latest version
+		Header: NewRequestHeaderBuilder().BuildHeartbeatRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.HeartbeatRequestBody{
+			GroupId:         value.String{Value: b.groupId},
+			GenerationId:    value.Int32{Value: b.generationId},
+			MemberId:        value.String{Value: b.memberId},
+			GroupInstanceId: value.NullableString{Value: nil},
+		},
+	}
+}
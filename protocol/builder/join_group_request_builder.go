@@ -0,0 +1,93 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type JoinGroupRequestProtocolData struct {
+	Name     string
+	Metadata []byte
+}
+
+type JoinGroupRequestBuilder struct {
+	correlationId      int32
+	groupId            string
+	sessionTimeoutMs   int32
+	rebalanceTimeoutMs int32
+	memberId           string
+	protocolType       string
+	protocols          []JoinGroupRequestProtocolData
+}
+
+func NewJoinGroupRequestBuilder() *JoinGroupRequestBuilder {
+	return &JoinGroupRequestBuilder{
+		// These are the defaults of session.timeout.ms and max.poll.interval.ms in the Java consumer
+		sessionTimeoutMs:   45000,
+		rebalanceTimeoutMs: 300000,
+		// An empty member ID is sent when joining a group for the first time
+		memberId: "",
+	}
+}
+
+func (b *JoinGroupRequestBuilder) WithCorrelationId(correlationId int32) *JoinGroupRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *JoinGroupRequestBuilder) WithGroupId(groupId string) *JoinGroupRequestBuilder {
+	b.groupId = groupId
+	return b
+}
+
+func (b *JoinGroupRequestBuilder) WithSessionTimeoutMs(sessionTimeoutMs int32) *JoinGroupRequestBuilder {
+	b.sessionTimeoutMs = sessionTimeoutMs
+	return b
+}
+
+func (b *JoinGroupRequestBuilder) WithRebalanceTimeoutMs(rebalanceTimeoutMs int32) *JoinGroupRequestBuilder {
+	b.rebalanceTimeoutMs = rebalanceTimeoutMs
+	return b
+}
+
+func (b *JoinGroupRequestBuilder) WithMemberId(memberId string) *JoinGroupRequestBuilder {
+	b.memberId = memberId
+	return b
+}
+
+func (b *JoinGroupRequestBuilder) WithProtocolType(protocolType string) *JoinGroupRequestBuilder {
+	b.protocolType = protocolType
+	return b
+}
+
+func (b *JoinGroupRequestBuilder) WithProtocols(protocols []JoinGroupRequestProtocolData) *JoinGroupRequestBuilder {
+	b.protocols = protocols
+	return b
+}
+
+func (b *JoinGroupRequestBuilder) Build() generated_kafkaapi.JoinGroupRequest {
+	protocols := []generated_kafkaapi.JoinGroupRequestProtocol{}
+
+	for _, protocol := range b.protocols {
+		protocols = append(protocols, generated_kafkaapi.JoinGroupRequestProtocol{
+			Name:     value.String{Value: protocol.Name},
+			Metadata: value.Bytes{Value: protocol.Metadata},
+		})
+	}
+
+	return generated_kafkaapi.JoinGroupRequest{
+		// Always send v9 of JoinGroup Request, v4+ requires members to rejoin with the member ID assigned by the broker
+		Header: NewRequestHeaderBuilder().BuildJoinGroupRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.JoinGroupRequestBody{
+			GroupId:            value.String{Value: b.groupId},
+			SessionTimeoutMs:   value.Int32{Value: b.sessionTimeoutMs},
+			RebalanceTimeoutMs: value.Int32{Value: b.rebalanceTimeoutMs},
+			MemberId:           value.String{Value: b.memberId},
+			// Static membership isn't used, so members are only identified by their member ID
+			GroupInstanceId: value.NullableString{Value: nil},
+			ProtocolType:    value.String{Value: b.protocolType},
+			Protocols:       protocols,
+			Reason:          value.NullableString{Value: nil},
+		},
+	}
+}
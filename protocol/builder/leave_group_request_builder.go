@@ -0,0 +1,52 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type LeaveGroupRequestBuilder struct {
+	correlationId int32
+	groupId       string
+	memberIds     []string
+}
+
+func NewLeaveGroupRequestBuilder() *LeaveGroupRequestBuilder {
+	return &LeaveGroupRequestBuilder{}
+}
+
+func (b *LeaveGroupRequestBuilder) WithCorrelationId(correlationId int32) *LeaveGroupRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *LeaveGroupRequestBuilder) WithGroupId(groupId string) *LeaveGroupRequestBuilder {
+	b.groupId = groupId
+	return b
+}
+
+func (b *LeaveGroupRequestBuilder) WithMemberIds(memberIds []string) *LeaveGroupRequestBuilder {
+	b.memberIds = memberIds
+	return b
+}
+
+func (b *LeaveGroupRequestBuilder) Build() generated_kafkaapi.LeaveGroupRequest {
+	members := []generated_kafkaapi.LeaveGroupRequestMemberIdentity{}
+
+	for _, memberId := range b.memberIds {
+		members = append(members, generated_kafkaapi.LeaveGroupRequestMemberIdentity{
+			MemberId:        value.String{Value: memberId},
+			GroupInstanceId: value.NullableString{Value: nil},
+			Reason:          value.NullableString{Value: nil},
+		})
+	}
+
+	return generated_kafkaapi.LeaveGroupRequest{
+		// Always send v5 of LeaveGroup Request, v3+ removes multiple members in a single request
+		Header: NewRequestHeaderBuilder().BuildLeaveGroupRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.LeaveGroupRequestBody{
+			GroupId: value.String{Value: b.groupId},
+			Members: members,
+		},
+	}
+}
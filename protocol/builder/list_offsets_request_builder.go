@@ -0,0 +1,76 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type ListOffsetsRequestPartitionData struct {
+	PartitionIndex int32
+	// Timestamp is either a record timestamp, or one of kafkaapi.ListOffsetsLatestTimestamp, ListOffsetsEarliestTimestamp or ListOffsetsMaxTimestamp
+	Timestamp int64
+}
+
+type ListOffsetsRequestTopicData struct {
+	Name       string
+	Partitions []ListOffsetsRequestPartitionData
+}
+
+type ListOffsetsRequestBuilder struct {
+	correlationId  int32
+	topics         []ListOffsetsRequestTopicData
+	isolationLevel int8
+}
+
+func NewListOffsetsRequestBuilder() *ListOffsetsRequestBuilder {
+	return &ListOffsetsRequestBuilder{}
+}
+
+func (b *ListOffsetsRequestBuilder) WithCorrelationId(correlationId int32) *ListOffsetsRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *ListOffsetsRequestBuilder) WithTopics(topics []ListOffsetsRequestTopicData) *ListOffsetsRequestBuilder {
+	b.topics = topics
+	return b
+}
+
+// WithIsolationLevel sets the isolation level, 0 for READ_UNCOMMITTED (the default) and 1 for READ_COMMITTED
+func (b *ListOffsetsRequestBuilder) WithIsolationLevel(isolationLevel int8) *ListOffsetsRequestBuilder {
+	b.isolationLevel = isolationLevel
+	return b
+}
+
+func (b *ListOffsetsRequestBuilder) Build() generated_kafkaapi.ListOffsetsRequest {
+	topics := []generated_kafkaapi.ListOffsetsRequestListOffsetsTopic{}
+
+	for _, topic := range b.topics {
+		partitions := []generated_kafkaapi.ListOffsetsRequestListOffsetsPartition{}
+
+		for _, partition := range topic.Partitions {
+			partitions = append(partitions, generated_kafkaapi.ListOffsetsRequestListOffsetsPartition{
+				PartitionIndex: value.Int32{Value: partition.PartitionIndex},
+				// -1 skips leader epoch fencing
+				CurrentLeaderEpoch: value.Int32{Value: -1},
+				Timestamp:          value.Int64{Value: partition.Timestamp},
+			})
+		}
+
+		topics = append(topics, generated_kafkaapi.ListOffsetsRequestListOffsetsTopic{
+			Name:       value.String{Value: topic.Name},
+			Partitions: partitions,
+		})
+	}
+
+	return generated_kafkaapi.ListOffsetsRequest{
+		// Always send v8 of ListOffsets Request, v7 is the first version that supports MAX_TIMESTAMP
+		Header: NewRequestHeaderBuilder().BuildListOffsetsRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.ListOffsetsRequestBody{
+			// -1 since the request is sent by a consumer, not a follower broker
+			ReplicaId:      value.Int32{Value: -1},
+			IsolationLevel: value.Int8{Value: b.isolationLevel},
+			Topics:         topics,
+		},
+	}
+}
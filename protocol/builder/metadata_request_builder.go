@@ -0,0 +1,70 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type MetadataRequestBuilder struct {
+	correlationId int32
+	// topicNames is nil when requesting metadata for all topics
+	topicNames             []string
+	allowAutoTopicCreation bool
+}
+
+func NewMetadataRequestBuilder() *MetadataRequestBuilder {
+	return &MetadataRequestBuilder{
+		topicNames: []string{},
+	}
+}
+
+func (b *MetadataRequestBuilder) WithCorrelationId(correlationId int32) *MetadataRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *MetadataRequestBuilder) WithTopicNames(topicNames []string) *MetadataRequestBuilder {
+	if topicNames == nil {
+		panic("Codecrafters Internal Error - WithTopicNames called with nil, use WithAllTopics instead")
+	}
+
+	b.topicNames = topicNames
+	return b
+}
+
+// WithAllTopics sends a null topics array, which requests metadata for all topics
+func (b *MetadataRequestBuilder) WithAllTopics() *MetadataRequestBuilder {
+	b.topicNames = nil
+	return b
+}
+
+func (b *MetadataRequestBuilder) WithAllowAutoTopicCreation(allowAutoTopicCreation bool) *MetadataRequestBuilder {
+	b.allowAutoTopicCreation = allowAutoTopicCreation
+	return b
+}
+
+func (b *MetadataRequestBuilder) Build() generated_kafkaapi.MetadataRequest {
+	var topics []generated_kafkaapi.MetadataRequestTopic
+
+	if b.topicNames != nil {
+		topics = []generated_kafkaapi.MetadataRequestTopic{}
+	}
+
+	for _, topicName := range b.topicNames {
+		topics = append(topics, generated_kafkaapi.MetadataRequestTopic{
+			// Topics are looked up by name, so the topic ID is left as zero
+			TopicId: value.UUID{Value: "00000000-0000-0000-0000-000000000000"},
+			Name:    value.NullableString{Value: &topicName},
+		})
+	}
+
+	return generated_kafkaapi.MetadataRequest{
+		// Always send v12 of Metadata Request, it's the first version that supports topic IDs
+		Header: NewRequestHeaderBuilder().BuildMetadataRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.MetadataRequestBody{
+			Topics:                           topics,
+			AllowAutoTopicCreation:           value.Boolean{Value: b.allowAutoTopicCreation},
+			IncludeTopicAuthorizedOperations: value.Boolean{Value: false},
+		},
+	}
+}
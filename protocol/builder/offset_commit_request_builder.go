@@ -0,0 +1,93 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type OffsetCommitRequestPartitionData struct {
+	PartitionIndex    int32
+	CommittedOffset   int64
+	CommittedMetadata string
+}
+
+type OffsetCommitRequestTopicData struct {
+	Name       string
+	Partitions []OffsetCommitRequestPartitionData
+}
+
+type OffsetCommitRequestBuilder struct {
+	correlationId             int32
+	groupId                   string
+	generationIdOrMemberEpoch int32
+	memberId                  string
+	topics                    []OffsetCommitRequestTopicData
+}
+
+func NewOffsetCommitRequestBuilder() *OffsetCommitRequestBuilder {
+	return &OffsetCommitRequestBuilder{
+		// -1 and an empty member ID commit offsets for a group that doesn't use group membership
+		generationIdOrMemberEpoch: -1,
+		memberId:                  "",
+	}
+}
+
+func (b *OffsetCommitRequestBuilder) WithCorrelationId(correlationId int32) *OffsetCommitRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *OffsetCommitRequestBuilder) WithGroupId(groupId string) *OffsetCommitRequestBuilder {
+	b.groupId = groupId
+	return b
+}
+
+func (b *OffsetCommitRequestBuilder) WithGenerationId(generationId int32) *OffsetCommitRequestBuilder {
+	b.generationIdOrMemberEpoch = generationId
+	return b
+}
+
+func (b *OffsetCommitRequestBuilder) WithMemberId(memberId string) *OffsetCommitRequestBuilder {
+	b.memberId = memberId
+	return b
+}
+
+func (b *OffsetCommitRequestBuilder) WithTopics(topics []OffsetCommitRequestTopicData) *OffsetCommitRequestBuilder {
+	b.topics = topics
+	return b
+}
+
+func (b *OffsetCommitRequestBuilder) Build() generated_kafkaapi.OffsetCommitRequest {
+	topics := []generated_kafkaapi.OffsetCommitRequestTopic{}
+
+	for _, topic := range b.topics {
+		partitions := []generated_kafkaapi.OffsetCommitRequestPartition{}
+
+		for _, partition := range topic.Partitions {
+			partitions = append(partitions, generated_kafkaapi.OffsetCommitRequestPartition{
+				PartitionIndex:  value.Int32{Value: partition.PartitionIndex},
+				CommittedOffset: value.Int64{Value: partition.CommittedOffset},
+				// -1 skips leader epoch fencing
+				CommittedLeaderEpoch: value.Int32{Value: -1},
+				CommittedMetadata:    value.NullableString{Value: &partition.CommittedMetadata},
+			})
+		}
+
+		topics = append(topics, generated_kafkaapi.OffsetCommitRequestTopic{
+			Name:       value.String{Value: topic.Name},
+			Partitions: partitions,
+		})
+	}
+
+	return generated_kafkaapi.OffsetCommitRequest{
+		// Always send v9 of OffsetCommit Request, the latest version supported by Kafka 4.0
+		Header: NewRequestHeaderBuilder().BuildOffsetCommitRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.OffsetCommitRequestBody{
+			GroupId:                   value.String{Value: b.groupId},
+			GenerationIdOrMemberEpoch: value.Int32{Value: b.generationIdOrMemberEpoch},
+			MemberId:                  value.String{Value: b.memberId},
+			GroupInstanceId:           value.NullableString{Value: nil},
+			Topics:                    topics,
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type OffsetFetchRequestTopicData struct {
+	Name             string
+	PartitionIndexes []int32
+}
+
+type OffsetFetchRequestGroupData struct {
+	GroupId string
+	// Topics is nil when fetching the committed offsets of all topics in the group
+	Topics []OffsetFetchRequestTopicData
+}
+
+type OffsetFetchRequestBuilder struct {
+	correlationId int32
+	groups        []OffsetFetchRequestGroupData
+	requireStable bool
+}
+
+func NewOffsetFetchRequestBuilder() *OffsetFetchRequestBuilder {
+	return &OffsetFetchRequestBuilder{}
+}
+
+func (b *OffsetFetchRequestBuilder) WithCorrelationId(correlationId int32) *OffsetFetchRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *OffsetFetchRequestBuilder) WithGroups(groups []OffsetFetchRequestGroupData) *OffsetFetchRequestBuilder {
+	b.groups = groups
+	return b
+}
+
+func (b *OffsetFetchRequestBuilder) WithRequireStable(requireStable bool) *OffsetFetchRequestBuilder {
+	b.requireStable = requireStable
+	return b
+}
+
+func (b *OffsetFetchRequestBuilder) Build() generated_kafkaapi.OffsetFetchRequest {
+	groups := []generated_kafkaapi.OffsetFetchRequestGroup{}
+
+	for _, group := range b.groups {
+		var topics []generated_kafkaapi.OffsetFetchRequestTopics
+
+		if group.Topics != nil {
+			topics = []generated_kafkaapi.OffsetFetchRequestTopics{}
+		}
+
+		for _, topic := range group.Topics {
+			partitionIndexes := []value.Int32{}
+
+			for _, partitionIndex := range topic.PartitionIndexes {
+				partitionIndexes = append(partitionIndexes, value.Int32{Value: partitionIndex})
+			}
+
+			topics = append(topics, generated_kafkaapi.OffsetFetchRequestTopics{
+				Name:             value.String{Value: topic.Name},
+				PartitionIndexes: partitionIndexes,
+			})
+		}
+
+		groups = append(groups, generated_kafkaapi.OffsetFetchRequestGroup{
+			GroupId: value.String{Value: group.GroupId},
+			// A null member ID and -1 epoch skip member validation, they're only used by the new consumer protocol
+			MemberId:    value.NullableString{Value: nil},
+			MemberEpoch: value.Int32{Value: -1},
+			Topics:      topics,
+		})
+	}
+
+	return generated_kafkaapi.OffsetFetchRequest{
+		// Always send v9 of OffsetFetch Request, v8 is the first version that fetches offsets for multiple groups
+		Header: NewRequestHeaderBuilder().BuildOffsetFetchRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.OffsetFetchRequestBody{
+			Groups:        groups,
+			RequireStable: value.Boolean{Value: b.requireStable},
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafka_files_generator"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
@@ -38,6 +39,8 @@ func GetProduceRequestTopicData(generatedLogDirectoryData *kafka_files_generator
 type ProduceRequestPartitionData struct {
 	PartitionId int32
 	Logs        []string
+	// Headers are attached to every record in the partition
+	Headers []kafkaapi.RecordHeader
 }
 
 type ProduceRequestTopicData struct {
@@ -46,12 +49,21 @@ type ProduceRequestTopicData struct {
 }
 
 type ProduceRequestBuilder struct {
+	apiVersion        int16
 	correlationId     int32
 	topicCreationData []ProduceRequestTopicData
+	compressionCodec  compression.Codec
 }
 
 func NewProduceRequestBuilder() *ProduceRequestBuilder {
-	return &ProduceRequestBuilder{}
+	return &ProduceRequestBuilder{
+		apiVersion: 11,
+	}
+}
+
+func (b *ProduceRequestBuilder) WithApiVersion(apiVersion int16) *ProduceRequestBuilder {
+	b.apiVersion = apiVersion
+	return b
 }
 
 func (b *ProduceRequestBuilder) WithCorrelationId(correlationId int32) *ProduceRequestBuilder {
@@ -64,6 +76,12 @@ func (b *ProduceRequestBuilder) WithTopicRequestData(topicData []ProduceRequestT
 	return b
 }
 
+// WithCompressionCodec compresses the records of every batch in the request with codec
+func (b *ProduceRequestBuilder) WithCompressionCodec(codec compression.Codec) *ProduceRequestBuilder {
+	b.compressionCodec = codec
+	return b
+}
+
 func (b *ProduceRequestBuilder) Build() kafkaapi.ProduceRequest {
 	topicsArray := []kafkaapi.ProduceRequestTopicData{}
 
@@ -76,6 +94,11 @@ func (b *ProduceRequestBuilder) Build() kafkaapi.ProduceRequest {
 		for _, partition := range topic.PartitionsCreationData {
 			records := []kafkaapi.Record{}
 
+			headers := partition.Headers
+			if headers == nil {
+				headers = []kafkaapi.RecordHeader{}
+			}
+
 			// Make one record object for one log inside the partition
 			for i, log := range partition.Logs {
 				records = append(records, kafkaapi.Record{
@@ -84,7 +107,7 @@ func (b *ProduceRequestBuilder) Build() kafkaapi.ProduceRequest {
 					OffsetDelta:    value.Varint{Value: int64(i)},
 					Key:            value.RawBytes{},
 					Value:          value.RawBytes{Value: []byte(log)},
-					Headers:        []kafkaapi.RecordHeader{},
+					Headers:        headers,
 				})
 			}
 
@@ -95,7 +118,7 @@ func (b *ProduceRequestBuilder) Build() kafkaapi.ProduceRequest {
 						BaseOffset:           value.Int64{Value: 0},
 						PartitionLeaderEpoch: value.Int32{Value: 0},
 						Magic:                value.Int8{Value: 2},
-						Attributes:           value.Int16{Value: 0},
+						Attributes:           value.Int16{Value: compression.WithCodec(0, b.compressionCodec)},
 						LastOffsetDelta:      value.Int32{Value: int32(len(records) - 1)},
 						FirstTimestamp:       value.Int64{Value: 1726045973899},
 						MaxTimestamp:         value.Int64{Value: 1726045973899},
@@ -118,7 +141,7 @@ func (b *ProduceRequestBuilder) Build() kafkaapi.ProduceRequest {
 	}
 
 	return kafkaapi.ProduceRequest{
-		Header: NewRequestHeaderBuilder().BuildProduceRequestHeader(b.correlationId),
+		Header: NewRequestHeaderBuilder().BuildProduceRequestHeader(b.correlationId, b.apiVersion),
 		Body: kafkaapi.ProduceRequestBody{
 			TransactionalId: value.CompactNullableString{},
 			Acks:            value.Int16{Value: -1},
@@ -1,7 +1,10 @@
 package builder
 
 import (
+	"fmt"
+
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/utils"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
 
@@ -9,6 +12,7 @@ type RequestHeaderBuilder struct {
 	apiKey        int16
 	apiVersion    int16
 	correlationId int32
+	taggedFields  value.TaggedFields
 }
 
 func NewRequestHeaderBuilder() *RequestHeaderBuilder {
@@ -34,16 +38,27 @@ func (b *RequestHeaderBuilder) WithCorrelationId(correlationId int32) *RequestHe
 	return b
 }
 
+func (b *RequestHeaderBuilder) WithTaggedFields(taggedFields value.TaggedFields) *RequestHeaderBuilder {
+	b.taggedFields = taggedFields
+	return b
+}
+
 func (b *RequestHeaderBuilder) Build() headers.RequestHeader {
 	if b.correlationId == -1 {
 		panic("CodeCrafters Internal Error: Correlation ID is required")
 	}
 
+	// ApiVersions requests are sent with unsupported versions on purpose, to check the error code in the response
+	if b.apiKey != 18 && !utils.IsSupportedApiVersion(b.apiKey, b.apiVersion) {
+		panic(fmt.Sprintf("CodeCrafters Internal Error: %s v%d requests aren't supported", utils.APIKeyToName(b.apiKey), b.apiVersion))
+	}
+
 	return headers.RequestHeader{
 		ApiKey:        value.Int16{Value: b.apiKey},
 		ApiVersion:    value.Int16{Value: b.apiVersion},
 		CorrelationId: value.Int32{Value: b.correlationId},
 		ClientId:      value.String{Value: "kafka-tester"},
+		TaggedFields:  b.taggedFields,
 	}
 }
 
@@ -51,14 +66,62 @@ func (b *RequestHeaderBuilder) BuildApiVersionsRequestHeader(correlationId int32
 	return b.WithApiKey(18).WithApiVersion(apiVersion).WithCorrelationId(correlationId).Build()
 }
 
+func (b *RequestHeaderBuilder) BuildListOffsetsRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(2).WithApiVersion(8).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildMetadataRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(3).WithApiVersion(12).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildOffsetCommitRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(8).WithApiVersion(9).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildOffsetFetchRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(9).WithApiVersion(9).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildFindCoordinatorRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(10).WithApiVersion(4).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildJoinGroupRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(11).WithApiVersion(9).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildHeartbeatRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(12).WithApiVersion(4).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildLeaveGroupRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(13).WithApiVersion(5).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildSyncGroupRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(14).WithApiVersion(5).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildCreateTopicsRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(19).WithApiVersion(7).WithCorrelationId(correlationId).Build()
+}
+
 func (b *RequestHeaderBuilder) BuildDescribeTopicPartitionsHeader(correlationID int32) headers.RequestHeader {
 	return b.WithApiKey(75).WithApiVersion(0).WithCorrelationId(correlationID).Build()
 }
 
-func (b *RequestHeaderBuilder) BuildFetchRequestHeader(correlationID int32) headers.RequestHeader {
-	return b.WithApiKey(1).WithApiVersion(16).WithCorrelationId(correlationID).Build()
+func (b *RequestHeaderBuilder) BuildFetchRequestHeader(correlationID int32, apiVersion int16) headers.RequestHeader {
+	return b.WithApiKey(1).WithApiVersion(apiVersion).WithCorrelationId(correlationID).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildProduceRequestHeader(correlationId int32, apiVersion int16) headers.RequestHeader {
+	return b.WithApiKey(0).WithApiVersion(apiVersion).WithCorrelationId(correlationId).Build()
+}
+
+func (b *RequestHeaderBuilder) BuildSaslHandshakeRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(17).WithApiVersion(1).WithCorrelationId(correlationId).Build()
 }
 
-func (b *RequestHeaderBuilder) BuildProduceRequestHeader(correlationId int32) headers.RequestHeader {
-	return b.WithApiKey(0).WithApiVersion(11).WithCorrelationId(correlationId).Build()
+func (b *RequestHeaderBuilder) BuildSaslAuthenticateRequestHeader(correlationId int32) headers.RequestHeader {
+	return b.WithApiKey(36).WithApiVersion(2).WithCorrelationId(correlationId).Build()
 }
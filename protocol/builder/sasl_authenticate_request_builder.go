@@ -0,0 +1,38 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type SaslAuthenticateRequestBuilder struct {
+	correlationId int32
+	authBytes     []byte
+}
+
+func NewSaslAuthenticateRequestBuilder() *SaslAuthenticateRequestBuilder {
+	return &SaslAuthenticateRequestBuilder{
+		correlationId: -1,
+		authBytes:     []byte{},
+	}
+}
+
+func (b *SaslAuthenticateRequestBuilder) WithCorrelationId(correlationId int32) *SaslAuthenticateRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *SaslAuthenticateRequestBuilder) WithAuthBytes(authBytes []byte) *SaslAuthenticateRequestBuilder {
+	b.authBytes = authBytes
+	return b
+}
+
+func (b *SaslAuthenticateRequestBuilder) Build() kafkaapi.SaslAuthenticateRequest {
+	return kafkaapi.SaslAuthenticateRequest{
+		Header: NewRequestHeaderBuilder().BuildSaslAuthenticateRequestHeader(b.correlationId),
+		Body: kafkaapi.SaslAuthenticateRequestBody{
+			Version:   value.Int16{Value: 2},
+			AuthBytes: value.CompactBytes{Value: b.authBytes},
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type SaslHandshakeRequestBuilder struct {
+	correlationId int32
+	mechanism     string
+}
+
+func NewSaslHandshakeRequestBuilder() *SaslHandshakeRequestBuilder {
+	return &SaslHandshakeRequestBuilder{
+		correlationId: -1,
+	}
+}
+
+func (b *SaslHandshakeRequestBuilder) WithCorrelationId(correlationId int32) *SaslHandshakeRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *SaslHandshakeRequestBuilder) WithMechanism(mechanism string) *SaslHandshakeRequestBuilder {
+	b.mechanism = mechanism
+	return b
+}
+
+func (b *SaslHandshakeRequestBuilder) Build() kafkaapi.SaslHandshakeRequest {
+	if b.mechanism == "" {
+		panic("CodeCrafters Internal Error: Mechanism is required to build SaslHandshakeRequest")
+	}
+
+	return kafkaapi.SaslHandshakeRequest{
+		Header: NewRequestHeaderBuilder().BuildSaslHandshakeRequestHeader(b.correlationId),
+		Body: kafkaapi.SaslHandshakeRequestBody{
+			Version:   value.Int16{Value: 1},
+			Mechanism: value.String{Value: b.mechanism},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package builder
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/generated_kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type SyncGroupRequestAssignmentData struct {
+	MemberId   string
+	Assignment []byte
+}
+
+type SyncGroupRequestBuilder struct {
+	correlationId int32
+	groupId       string
+	generationId  int32
+	memberId      string
+	protocolType  string
+	protocolName  string
+	assignments   []SyncGroupRequestAssignmentData
+}
+
+func NewSyncGroupRequestBuilder() *SyncGroupRequestBuilder {
+	return &SyncGroupRequestBuilder{
+		// Only the leader sends assignments, other members send an empty array
+		assignments: []SyncGroupRequestAssignmentData{},
+	}
+}
+
+func (b *SyncGroupRequestBuilder) WithCorrelationId(correlationId int32) *SyncGroupRequestBuilder {
+	b.correlationId = correlationId
+	return b
+}
+
+func (b *SyncGroupRequestBuilder) WithGroupId(groupId string) *SyncGroupRequestBuilder {
+	b.groupId = groupId
+	return b
+}
+
+func (b *SyncGroupRequestBuilder) WithGenerationId(generationId int32) *SyncGroupRequestBuilder {
+	b.generationId = generationId
+	return b
+}
+
+func (b *SyncGroupRequestBuilder) WithMemberId(memberId string) *SyncGroupRequestBuilder {
+	b.memberId = memberId
+	return b
+}
+
+func (b *SyncGroupRequestBuilder) WithProtocol(protocolType string, protocolName string) *SyncGroupRequestBuilder {
+	b.protocolType = protocolType
+	b.protocolName = protocolName
+	return b
+}
+
+func (b *SyncGroupRequestBuilder) WithAssignments(assignments []SyncGroupRequestAssignmentData) *SyncGroupRequestBuilder {
+	b.assignments = assignments
+	return b
+}
+
+func (b *SyncGroupRequestBuilder) Build() generated_kafkaapi.SyncGroupRequest {
+	assignments := []generated_kafkaapi.SyncGroupRequestAssignment{}
+
+	for _, assignment := range b.assignments {
+		assignments = append(assignments, generated_kafkaapi.SyncGroupRequestAssignment{
+			MemberId:   value.String{Value: assignment.MemberId},
+			Assignment: value.Bytes{Value: assignment.Assignment},
+		})
+	}
+
+	return generated_kafkaapi.SyncGroupRequest{
+		// Always send v5 of SyncGroup Request, it's the first version that sends the protocol type and name
+		Header: NewRequestHeaderBuilder().BuildSyncGroupRequestHeader(b.correlationId),
+		Body: generated_kafkaapi.SyncGroupRequestBody{
+			GroupId:         value.String{Value: b.groupId},
+			GenerationId:    value.Int32{Value: b.generationId},
+			MemberId:        value.String{Value: b.memberId},
+			GroupInstanceId: value.NullableString{Value: nil},
+			ProtocolType:    value.NullableString{Value: &b.protocolType},
+			ProtocolName:    value.NullableString{Value: &b.protocolName},
+			Assignments:     assignments,
+		},
+	}
+}
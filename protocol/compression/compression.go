@@ -0,0 +1,103 @@
+// Package compression implements the codecs that Kafka uses for record batches.
+//
+// gzip uses the standard library, snappy and zstd use github.com/klauspost/compress, and lz4 uses
+// github.com/pierrec/lz4. Decompressed data is capped at MaxDecompressedSize.
+package compression
+
+import (
+	"fmt"
+	"io"
+)
+
+type Codec int8
+
+const (
+	None   Codec = 0
+	Gzip   Codec = 1
+	Snappy Codec = 2
+	LZ4    Codec = 3
+	Zstd   Codec = 4
+)
+
+// MaxDecompressedSize is the most data a record batch can decompress to, so that a small payload can't exhaust memory
+const MaxDecompressedSize = 100 * 1024 * 1024
+
+// compressionCodecMask selects the bits of RecordBatch.Attributes that store the compression codec
+const compressionCodecMask = 0x07
+
+// CodecFromAttributes returns the compression codec stored in the attributes of a record batch
+func CodecFromAttributes(attributes int16) Codec {
+	return Codec(attributes & compressionCodecMask)
+}
+
+// WithCodec returns attributes with the compression codec bits replaced by codec
+func WithCodec(attributes int16, codec Codec) int16 {
+	return (attributes &^ compressionCodecMask) | int16(codec)
+}
+
+func (c Codec) String() string {
+	switch c {
+	case None:
+		return "none"
+	case Gzip:
+		return "gzip"
+	case Snappy:
+		return "snappy"
+	case LZ4:
+		return "lz4"
+	case Zstd:
+		return "zstd"
+	default:
+		return fmt.Sprintf("unknown(%d)", int8(c))
+	}
+}
+
+// Compress returns data compressed with codec, data is returned as-is for None
+func Compress(codec Codec, data []byte) []byte {
+	switch codec {
+	case None:
+		return data
+	case Gzip:
+		return compressGzip(data)
+	case Snappy:
+		return compressSnappy(data)
+	case LZ4:
+		return compressLZ4(data)
+	case Zstd:
+		return compressZstd(data)
+	default:
+		panic(fmt.Sprintf("Codecrafters Internal Error - Unknown compression codec %d", int8(codec)))
+	}
+}
+
+// Decompress returns data decompressed with codec, data is returned as-is for None
+func Decompress(codec Codec, data []byte) ([]byte, error) {
+	switch codec {
+	case None:
+		return data, nil
+	case Gzip:
+		return decompressGzip(data)
+	case Snappy:
+		return decompressSnappy(data)
+	case LZ4:
+		return decompressLZ4(data)
+	case Zstd:
+		return decompressZstd(data)
+	default:
+		return nil, fmt.Errorf("unknown compression codec %d", int8(codec))
+	}
+}
+
+// readAllBounded reads reader until EOF, and returns an error if it produces more than MaxDecompressedSize bytes
+func readAllBounded(reader io.Reader) ([]byte, error) {
+	decompressed, err := io.ReadAll(io.LimitReader(reader, MaxDecompressedSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decompressed) > MaxDecompressedSize {
+		return nil, fmt.Errorf("decompressed data is larger than %d bytes", MaxDecompressedSize)
+	}
+
+	return decompressed, nil
+}
@@ -0,0 +1,79 @@
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionRoundtrip(t *testing.T) {
+	inputs := [][]byte{
+		{},
+		[]byte("hello world"),
+		bytes.Repeat([]byte("kafka"), 100),
+		bytes.Repeat([]byte{0x01, 0x02, 0x03}, 100_000),
+	}
+
+	for _, codec := range []Codec{None, Gzip, Snappy, LZ4, Zstd} {
+		for _, input := range inputs {
+			decompressed, err := Decompress(codec, Compress(codec, input))
+			require.NoError(t, err, codec.String())
+			assert.Equal(t, input, decompressed, codec.String())
+		}
+	}
+}
+
+func TestCodecFromAttributes(t *testing.T) {
+	assert.Equal(t, Zstd, CodecFromAttributes(0x0014))
+	assert.Equal(t, int16(0x0013), WithCodec(0x0014, LZ4))
+}
+
+func TestDecompressSnappyWithCopies(t *testing.T) {
+	// "a" as a literal, then a copy of 9 bytes at offset 1
+	block := []byte{0x0a, 0x00, 'a', 0x15, 0x01}
+
+	decompressed, err := Decompress(Snappy, block)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("aaaaaaaaaa"), decompressed)
+}
+
+func TestDecompressLZ4WithMatches(t *testing.T) {
+	// "a" as a literal followed by a match of 8 bytes at offset 1, then "a" as the last literal
+	block := []byte{0x14, 'a', 0x01, 0x00, 0x10, 'a'}
+
+	frame := []byte{0x04, 0x22, 0x4D, 0x18, 0x60, 0x40, 0x82, byte(len(block)), 0, 0, 0}
+	frame = append(frame, block...)
+	frame = append(frame, 0, 0, 0, 0)
+
+	decompressed, err := Decompress(LZ4, frame)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("aaaaaaaaaa"), decompressed)
+}
+
+func TestCompressShrinksRepetitiveData(t *testing.T) {
+	input := bytes.Repeat([]byte("kafka"), 1000)
+
+	for _, codec := range []Codec{Gzip, Snappy, LZ4, Zstd} {
+		assert.Less(t, len(Compress(codec, input)), len(input)/10, codec.String())
+	}
+}
+
+func TestDecompressRejectsOversizedData(t *testing.T) {
+	input := make([]byte, MaxDecompressedSize+1)
+
+	for _, codec := range []Codec{Gzip, Snappy, LZ4, Zstd} {
+		_, err := Decompress(codec, Compress(codec, input))
+		assert.Error(t, err, codec.String())
+	}
+}
+
+func TestDecompressRejectsCorruptData(t *testing.T) {
+	for _, codec := range []Codec{Gzip, Snappy, LZ4, Zstd} {
+		compressed := Compress(codec, []byte("hello world"))
+
+		_, err := Decompress(codec, compressed[:len(compressed)-3])
+		assert.Error(t, err, codec.String())
+	}
+}
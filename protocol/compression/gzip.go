@@ -0,0 +1,33 @@
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+)
+
+func compressGzip(data []byte) []byte {
+	buffer := bytes.NewBuffer(nil)
+
+	// The header doesn't include a modification time, so the output only depends on data
+	writer := gzip.NewWriter(buffer)
+	if _, err := writer.Write(data); err != nil {
+		panic("Codecrafters Internal Error - Failed to gzip data: " + err.Error())
+	}
+
+	if err := writer.Close(); err != nil {
+		panic("Codecrafters Internal Error - Failed to gzip data: " + err.Error())
+	}
+
+	return buffer.Bytes()
+}
+
+func decompressGzip(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	defer reader.Close()
+
+	return readAllBounded(reader)
+}
@@ -0,0 +1,27 @@
+package compression
+
+import (
+	"bytes"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// Kafka uses the lz4 frame format, rather than bare lz4 blocks
+func compressLZ4(data []byte) []byte {
+	buffer := bytes.NewBuffer(nil)
+
+	writer := lz4.NewWriter(buffer)
+	if _, err := writer.Write(data); err != nil {
+		panic("Codecrafters Internal Error - Failed to lz4 compress data: " + err.Error())
+	}
+
+	if err := writer.Close(); err != nil {
+		panic("Codecrafters Internal Error - Failed to lz4 compress data: " + err.Error())
+	}
+
+	return buffer.Bytes()
+}
+
+func decompressLZ4(data []byte) ([]byte, error) {
+	return readAllBounded(lz4.NewReader(bytes.NewReader(data)))
+}
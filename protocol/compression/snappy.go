@@ -0,0 +1,88 @@
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/compress/snappy"
+)
+
+// Java clients wrap snappy blocks in the framing format of xerial/snappy-java, other clients send a bare snappy block
+var xerialHeader = []byte{0x82, 'S', 'N', 'A', 'P', 'P', 'Y', 0}
+
+const (
+	xerialVersion           = 1
+	xerialCompatibleVersion = 1
+	xerialBlockSize         = 32 * 1024
+)
+
+func compressSnappy(data []byte) []byte {
+	buffer := bytes.NewBuffer(nil)
+	buffer.Write(xerialHeader)
+	buffer.Write(binary.BigEndian.AppendUint32(nil, xerialVersion))
+	buffer.Write(binary.BigEndian.AppendUint32(nil, xerialCompatibleVersion))
+
+	for start := 0; start < len(data); start += xerialBlockSize {
+		block := snappy.Encode(nil, data[start:min(start+xerialBlockSize, len(data))])
+		buffer.Write(binary.BigEndian.AppendUint32(nil, uint32(len(block))))
+		buffer.Write(block)
+	}
+
+	return buffer.Bytes()
+}
+
+func decompressSnappy(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, xerialHeader) {
+		return decodeSnappyBlock(data)
+	}
+
+	// Skip the header, version and compatible version
+	if len(data) < len(xerialHeader)+8 {
+		return nil, errors.New("snappy: truncated xerial header")
+	}
+
+	remaining := data[len(xerialHeader)+8:]
+	decompressed := []byte{}
+
+	for len(remaining) > 0 {
+		if len(remaining) < 4 {
+			return nil, errors.New("snappy: truncated xerial block length")
+		}
+
+		blockLength := binary.BigEndian.Uint32(remaining)
+		remaining = remaining[4:]
+
+		if uint64(blockLength) > uint64(len(remaining)) {
+			return nil, fmt.Errorf("snappy: xerial block length is %d bytes, only %d bytes remaining", blockLength, len(remaining))
+		}
+
+		block, err := decodeSnappyBlock(remaining[:blockLength])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(decompressed)+len(block) > MaxDecompressedSize {
+			return nil, fmt.Errorf("snappy: decompressed data is larger than %d bytes", MaxDecompressedSize)
+		}
+
+		decompressed = append(decompressed, block...)
+		remaining = remaining[blockLength:]
+	}
+
+	return decompressed, nil
+}
+
+func decodeSnappyBlock(block []byte) ([]byte, error) {
+	decodedLength, err := snappy.DecodedLen(block)
+	if err != nil {
+		return nil, err
+	}
+
+	if decodedLength > MaxDecompressedSize {
+		return nil, fmt.Errorf("snappy: decoded length %d is larger than %d bytes", decodedLength, MaxDecompressedSize)
+	}
+
+	return snappy.Decode(nil, block)
+}
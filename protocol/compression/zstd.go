@@ -0,0 +1,37 @@
+package compression
+
+import (
+	"github.com/klauspost/compress/zstd"
+)
+
+// A single goroutine is used, so that the compressed output only depends on the input
+var (
+	zstdEncoder = mustNewZstdEncoder()
+	zstdDecoder = mustNewZstdDecoder()
+)
+
+func mustNewZstdEncoder() *zstd.Encoder {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		panic("Codecrafters Internal Error - Failed to create zstd encoder: " + err.Error())
+	}
+
+	return encoder
+}
+
+func mustNewZstdDecoder() *zstd.Decoder {
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(MaxDecompressedSize))
+	if err != nil {
+		panic("Codecrafters Internal Error - Failed to create zstd decoder: " + err.Error())
+	}
+
+	return decoder
+}
+
+func compressZstd(data []byte) []byte {
+	return zstdEncoder.EncodeAll(data, nil)
+}
+
+func decompressZstd(data []byte) ([]byte, error) {
+	return zstdDecoder.DecodeAll(data, nil)
+}
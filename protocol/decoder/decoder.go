@@ -4,12 +4,17 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 
 	"github.com/codecrafters-io/kafka-tester/offset_buffer"
 	kafkaValue "github.com/codecrafters-io/kafka-tester/protocol/value"
 	"github.com/google/uuid"
 )
 
+// maxVarintBytesCount is one more than the longest valid varint, so that overlong encodings are reported as malformed
+const maxVarintBytesCount = binary.MaxVarintLen64 + 1
+
 type Decoder struct {
 	buffer *offset_buffer.Buffer
 }
@@ -20,6 +25,18 @@ func NewDecoder(rawBytes []byte) *Decoder {
 	}
 }
 
+// NewDecoderFromReader returns a decoder that streams length bytes from reader, instead of holding all of them in memory
+func NewDecoderFromReader(reader io.Reader, length uint64) *Decoder {
+	return &Decoder{
+		buffer: offset_buffer.NewReaderBuffer(reader, length),
+	}
+}
+
+// ReadErr returns the error that the underlying reader failed with, if the decoder was created using NewDecoderFromReader
+func (d *Decoder) ReadErr() error {
+	return d.buffer.Err()
+}
+
 func (d *Decoder) ReadBytesCount() uint64 {
 	return d.buffer.Offset()
 }
@@ -30,8 +47,18 @@ func (d *Decoder) RemainingBytesCount() uint64 {
 
 // Read functions
 
+// PeekInt8 returns the INT8 that's offset bytes ahead without reading it, false if there aren't enough bytes remaining
+func (d *Decoder) PeekInt8(offset uint64) (int8, bool) {
+	peekedBytes := d.buffer.PeekNBytes(offset + 1)
+	if uint64(len(peekedBytes)) <= offset {
+		return 0, false
+	}
+
+	return int8(peekedBytes[offset]), true
+}
+
 func (d *Decoder) ReadBoolean() (kafkaValue.Boolean, DecoderError) {
-	if d.RemainingBytesCount() < 1 {
+	if !d.buffer.HasNBytes(1) {
 		rem := d.RemainingBytesCount()
 		return kafkaValue.Boolean{}, d.wrapError(fmt.Errorf("Expected BOOLEAN length to be 1 byte, got %d bytes", rem))
 	}
@@ -44,7 +71,7 @@ func (d *Decoder) ReadBoolean() (kafkaValue.Boolean, DecoderError) {
 }
 
 func (d *Decoder) ReadInt8() (kafkaValue.Int8, DecoderError) {
-	if d.RemainingBytesCount() < 1 {
+	if !d.buffer.HasNBytes(1) {
 		rem := d.RemainingBytesCount()
 		return kafkaValue.Int8{}, d.wrapError(fmt.Errorf("Expected INT8 length to be 1 bytes, got %d bytes", rem))
 	}
@@ -58,7 +85,7 @@ func (d *Decoder) ReadInt8() (kafkaValue.Int8, DecoderError) {
 }
 
 func (d *Decoder) ReadInt16() (kafkaValue.Int16, DecoderError) {
-	if d.RemainingBytesCount() < 2 {
+	if !d.buffer.HasNBytes(2) {
 		rem := d.RemainingBytesCount()
 		return kafkaValue.Int16{}, d.wrapError(fmt.Errorf("Expected INT16 length to be 2 bytes, got %d bytes", rem))
 	}
@@ -70,8 +97,21 @@ func (d *Decoder) ReadInt16() (kafkaValue.Int16, DecoderError) {
 	return decodedInteger, nil
 }
 
+func (d *Decoder) ReadUint16() (kafkaValue.Uint16, DecoderError) {
+	if !d.buffer.HasNBytes(2) {
+		rem := d.RemainingBytesCount()
+		return kafkaValue.Uint16{}, d.wrapError(fmt.Errorf("Expected UINT16 length to be 2 bytes, got %d bytes", rem))
+	}
+
+	decodedInteger := kafkaValue.Uint16{
+		Value: binary.BigEndian.Uint16(d.buffer.MustReadNBytes(2)),
+	}
+
+	return decodedInteger, nil
+}
+
 func (d *Decoder) ReadInt32() (kafkaValue.Int32, DecoderError) {
-	if d.RemainingBytesCount() < 4 {
+	if !d.buffer.HasNBytes(4) {
 		rem := d.RemainingBytesCount()
 		return kafkaValue.Int32{}, d.wrapError(fmt.Errorf("Expected INT32 length to be 4 bytes, got %d bytes", rem))
 	}
@@ -84,7 +124,7 @@ func (d *Decoder) ReadInt32() (kafkaValue.Int32, DecoderError) {
 }
 
 func (d *Decoder) ReadInt64() (kafkaValue.Int64, DecoderError) {
-	if d.RemainingBytesCount() < 8 {
+	if !d.buffer.HasNBytes(8) {
 		rem := d.RemainingBytesCount()
 		return kafkaValue.Int64{}, d.wrapError(fmt.Errorf("Expected INT64 length to be 8 bytes, got %d bytes", rem))
 	}
@@ -96,43 +136,64 @@ func (d *Decoder) ReadInt64() (kafkaValue.Int64, DecoderError) {
 	return decodedInteger, nil
 }
 
-func (d *Decoder) ReadUnsignedVarint() (kafkaValue.UnsignedVarint, DecoderError) {
-	decodedInteger, numberOfBytesRead := binary.Uvarint(d.buffer.RemainingBytes())
+func (d *Decoder) ReadFloat64() (kafkaValue.Float64, DecoderError) {
+	if !d.buffer.HasNBytes(8) {
+		rem := d.RemainingBytesCount()
+		return kafkaValue.Float64{}, d.wrapError(fmt.Errorf("Expected FLOAT64 length to be 8 bytes, got %d bytes", rem))
+	}
 
-	// Moves the offset
-	d.buffer.MustReadNBytes(uint64(numberOfBytesRead))
+	decodedFloat := kafkaValue.Float64{
+		Value: math.Float64frombits(binary.BigEndian.Uint64(d.buffer.MustReadNBytes(8))),
+	}
+
+	return decodedFloat, nil
+}
+
+func (d *Decoder) ReadUnsignedVarint() (kafkaValue.UnsignedVarint, DecoderError) {
+	decodedInteger, numberOfBytesRead := binary.Uvarint(d.buffer.PeekNBytes(maxVarintBytesCount))
 
 	// binary.Uvarint returns 0 bytes read if buffer is too small
 	if numberOfBytesRead == 0 {
 		return kafkaValue.UnsignedVarint{}, d.wrapError(errors.New("Insufficient bytes to decode UNSIGNED_VARINT"))
 	}
 
-	// binary.Uvarint returns negative if malformed
+	// binary.Uvarint returns negative if malformed, the number of bytes read is -numberOfBytesRead
 	if numberOfBytesRead < 0 {
-		return kafkaValue.UnsignedVarint{}, d.wrapError(errors.New("Malformed UNSIGNED_VARINT encoding"))
+		return kafkaValue.UnsignedVarint{}, NewDecoderErrorForRange(
+			errors.New("Malformed UNSIGNED_VARINT encoding"),
+			int(d.buffer.Offset()),
+			int(d.buffer.Offset())-numberOfBytesRead-1,
+		)
 	}
 
+	// Move the offset
+	d.buffer.MustReadNBytes(uint64(numberOfBytesRead))
+
 	return kafkaValue.UnsignedVarint{
 		Value: decodedInteger,
 	}, nil
 }
 
 func (d *Decoder) ReadVarint() (kafkaValue.Varint, DecoderError) {
-	decodedInteger, numberOfBytesRead := binary.Varint(d.buffer.RemainingBytes())
-
-	// Move the offset
-	d.buffer.MustReadNBytes(uint64(numberOfBytesRead))
+	decodedInteger, numberOfBytesRead := binary.Varint(d.buffer.PeekNBytes(maxVarintBytesCount))
 
 	// binary.Varint returns 0 bytes read if buffer is too small
 	if numberOfBytesRead == 0 {
 		return kafkaValue.Varint{}, d.wrapError(errors.New("Insufficient bytes to decode VARINT"))
 	}
 
-	// binary.Varint returns negative if malformed
+	// binary.Varint returns negative if malformed, the number of bytes read is -numberOfBytesRead
 	if numberOfBytesRead < 0 {
-		return kafkaValue.Varint{}, d.wrapError(errors.New("Malformed VARINT encoding"))
+		return kafkaValue.Varint{}, NewDecoderErrorForRange(
+			errors.New("Malformed VARINT encoding"),
+			int(d.buffer.Offset()),
+			int(d.buffer.Offset())-numberOfBytesRead-1,
+		)
 	}
 
+	// Move the offset
+	d.buffer.MustReadNBytes(uint64(numberOfBytesRead))
+
 	return kafkaValue.Varint{
 		Value: decodedInteger,
 	}, nil
@@ -175,7 +236,7 @@ func (d *Decoder) ReadCompactString() (kafkaValue.CompactString, DecoderError) {
 		)
 	}
 
-	if d.RemainingBytesCount() < lengthValue.ActualLength() {
+	if !d.buffer.HasNBytes(lengthValue.ActualLength()) {
 		return kafkaValue.CompactString{}, d.wrapError(fmt.Errorf("Expected COMPACT_STRING contents to be %d bytes long, only got %d", lengthValue.ActualLength(), d.RemainingBytesCount()))
 	}
 
@@ -186,6 +247,166 @@ func (d *Decoder) ReadCompactString() (kafkaValue.CompactString, DecoderError) {
 	}, nil
 }
 
+func (d *Decoder) ReadString() (kafkaValue.String, DecoderError) {
+	lengthStartOffset := d.buffer.Offset()
+
+	lengthValue, err := d.ReadInt16()
+	if err != nil {
+		return kafkaValue.String{}, d.wrapError(err)
+	}
+
+	if lengthValue.Value < 0 {
+		return kafkaValue.String{}, NewDecoderErrorForRange(
+			fmt.Errorf("Expected STRING length to be non-negative, got %d", lengthValue.Value),
+			int(lengthStartOffset),
+			int(d.buffer.Offset()-1),
+		)
+	}
+
+	if !d.buffer.HasNBytes(uint64(lengthValue.Value)) {
+		return kafkaValue.String{}, d.wrapError(fmt.Errorf("Expected STRING contents to be %d bytes long, only got %d", lengthValue.Value, d.RemainingBytesCount()))
+	}
+
+	rawBytes := d.buffer.MustReadNBytes(uint64(lengthValue.Value))
+
+	return kafkaValue.String{
+		Value: string(rawBytes),
+	}, nil
+}
+
+func (d *Decoder) ReadNullableString() (kafkaValue.NullableString, DecoderError) {
+	lengthStartOffset := d.buffer.Offset()
+
+	lengthValue, err := d.ReadInt16()
+	if err != nil {
+		return kafkaValue.NullableString{}, d.wrapError(err)
+	}
+
+	if lengthValue.Value == -1 {
+		return kafkaValue.NullableString{}, nil
+	}
+
+	if lengthValue.Value < 0 {
+		return kafkaValue.NullableString{}, NewDecoderErrorForRange(
+			fmt.Errorf("Expected NULLABLE_STRING length to be -1 or a non-negative number, got %d", lengthValue.Value),
+			int(lengthStartOffset),
+			int(d.buffer.Offset()-1),
+		)
+	}
+
+	if !d.buffer.HasNBytes(uint64(lengthValue.Value)) {
+		return kafkaValue.NullableString{}, d.wrapError(fmt.Errorf("Expected NULLABLE_STRING contents to be %d bytes long, only got %d", lengthValue.Value, d.RemainingBytesCount()))
+	}
+
+	stringValue := string(d.buffer.MustReadNBytes(uint64(lengthValue.Value)))
+
+	return kafkaValue.NullableString{
+		Value: &stringValue,
+	}, nil
+}
+
+func (d *Decoder) ReadCompactBytes() (kafkaValue.CompactBytes, DecoderError) {
+	lengthStartOffset := d.buffer.Offset()
+
+	lengthValue, err := d.ReadUnsignedVarint()
+	if err != nil {
+		return kafkaValue.CompactBytes{}, d.wrapError(err)
+	}
+
+	if lengthValue.Value == 0 {
+		return kafkaValue.CompactBytes{}, NewDecoderErrorForRange(
+			fmt.Errorf("Compact bytes length cannot be 0"),
+			int(lengthStartOffset),
+			int(d.buffer.Offset()-1),
+		)
+	}
+
+	actualLength := lengthValue.Value - 1
+
+	if !d.buffer.HasNBytes(actualLength) {
+		return kafkaValue.CompactBytes{}, d.wrapError(fmt.Errorf("Expected COMPACT_BYTES contents to be %d bytes long, only got %d", actualLength, d.RemainingBytesCount()))
+	}
+
+	return kafkaValue.CompactBytes{
+		Value: d.buffer.MustReadNBytes(actualLength),
+	}, nil
+}
+
+func (d *Decoder) ReadCompactNullableBytes() (kafkaValue.CompactNullableBytes, DecoderError) {
+	lengthValue, err := d.ReadUnsignedVarint()
+	if err != nil {
+		return kafkaValue.CompactNullableBytes{}, d.wrapError(err)
+	}
+
+	if lengthValue.Value == 0 {
+		return kafkaValue.CompactNullableBytes{}, nil
+	}
+
+	actualLength := lengthValue.Value - 1
+
+	if !d.buffer.HasNBytes(actualLength) {
+		return kafkaValue.CompactNullableBytes{}, d.wrapError(fmt.Errorf("Expected COMPACT_NULLABLE_BYTES contents to be %d bytes long, only got %d", actualLength, d.RemainingBytesCount()))
+	}
+
+	return kafkaValue.CompactNullableBytes{
+		Value: d.buffer.MustReadNBytes(actualLength),
+	}, nil
+}
+
+func (d *Decoder) ReadBytes() (kafkaValue.Bytes, DecoderError) {
+	lengthStartOffset := d.buffer.Offset()
+
+	lengthValue, err := d.ReadInt32()
+	if err != nil {
+		return kafkaValue.Bytes{}, d.wrapError(err)
+	}
+
+	if lengthValue.Value < 0 {
+		return kafkaValue.Bytes{}, NewDecoderErrorForRange(
+			fmt.Errorf("Expected BYTES length to be non-negative, got %d", lengthValue.Value),
+			int(lengthStartOffset),
+			int(d.buffer.Offset()-1),
+		)
+	}
+
+	if !d.buffer.HasNBytes(uint64(lengthValue.Value)) {
+		return kafkaValue.Bytes{}, d.wrapError(fmt.Errorf("Expected BYTES contents to be %d bytes long, only got %d", lengthValue.Value, d.RemainingBytesCount()))
+	}
+
+	return kafkaValue.Bytes{
+		Value: d.buffer.MustReadNBytes(uint64(lengthValue.Value)),
+	}, nil
+}
+
+func (d *Decoder) ReadNullableBytes() (kafkaValue.NullableBytes, DecoderError) {
+	lengthStartOffset := d.buffer.Offset()
+
+	lengthValue, err := d.ReadInt32()
+	if err != nil {
+		return kafkaValue.NullableBytes{}, d.wrapError(err)
+	}
+
+	if lengthValue.Value == -1 {
+		return kafkaValue.NullableBytes{}, nil
+	}
+
+	if lengthValue.Value < 0 {
+		return kafkaValue.NullableBytes{}, NewDecoderErrorForRange(
+			fmt.Errorf("Expected NULLABLE_BYTES length to be -1 or a non-negative number, got %d", lengthValue.Value),
+			int(lengthStartOffset),
+			int(d.buffer.Offset()-1),
+		)
+	}
+
+	if !d.buffer.HasNBytes(uint64(lengthValue.Value)) {
+		return kafkaValue.NullableBytes{}, d.wrapError(fmt.Errorf("Expected NULLABLE_BYTES contents to be %d bytes long, only got %d", lengthValue.Value, d.RemainingBytesCount()))
+	}
+
+	return kafkaValue.NullableBytes{
+		Value: d.buffer.MustReadNBytes(uint64(lengthValue.Value)),
+	}, nil
+}
+
 func (d *Decoder) ReadCompactRecordSize() (kafkaValue.CompactRecordSize, DecoderError) {
 	unsignedVarInt, err := d.ReadUnsignedVarint()
 
@@ -203,7 +424,11 @@ func (d *Decoder) ReadCompactRecordSize() (kafkaValue.CompactRecordSize, Decoder
 // Furthermore, they need special format for printing (so decoder logs is easy to read (see String() for RawBytes))
 // So, need help implementing a better solution if exists.
 func (d *Decoder) ReadRawBytes(count int) (kafkaValue.RawBytes, DecoderError) {
-	if d.RemainingBytesCount() < uint64(count) {
+	if count < 0 {
+		return kafkaValue.RawBytes{}, d.wrapError(fmt.Errorf("Expected count of raw bytes to be non-negative, got %d", count))
+	}
+
+	if !d.buffer.HasNBytes(uint64(count)) {
 		return kafkaValue.RawBytes{}, d.wrapError(fmt.Errorf("Expected remaining bytes count for reading raw bytes to be %d, got %d", count, d.RemainingBytesCount()))
 	}
 
@@ -225,7 +450,7 @@ func (d *Decoder) ReadCompactNullableString() (kafkaValue.CompactNullableString,
 		return kafkaValue.CompactNullableString{}, nil
 	}
 
-	if d.RemainingBytesCount() < lengthValue.ActualLength() {
+	if !d.buffer.HasNBytes(lengthValue.ActualLength()) {
 		return kafkaValue.CompactNullableString{}, d.wrapError(fmt.Errorf("Expected COMPACT_NULLABLE_STRING contents to be %d bytes long, got only %d", lengthValue.ActualLength(), d.RemainingBytesCount()))
 	}
 
@@ -240,7 +465,7 @@ func (d *Decoder) ReadCompactNullableString() (kafkaValue.CompactNullableString,
 func (d *Decoder) ReadUUID() (kafkaValue.UUID, DecoderError) {
 	uuidBytesCount := 16
 
-	if d.RemainingBytesCount() < uint64(uuidBytesCount) {
+	if !d.buffer.HasNBytes(uint64(uuidBytesCount)) {
 		return kafkaValue.UUID{}, d.wrapError(fmt.Errorf("Expected UUID contents to be %d bytes long, got only %d", uuidBytesCount, d.RemainingBytesCount()))
 	}
 
@@ -261,53 +486,52 @@ func (d *Decoder) ReadUUID() (kafkaValue.UUID, DecoderError) {
 	}, nil
 }
 
-func (d *Decoder) ConsumeTagBuffer() DecoderError {
+// ReadTaggedFields reads a tag buffer, the data of each tagged field is returned without being decoded
+func (d *Decoder) ReadTaggedFields() (kafkaValue.TaggedFields, DecoderError) {
 	tagCount, err := d.ReadUnsignedVarint()
 	if err != nil {
-		return err
+		return kafkaValue.TaggedFields{}, err
 	}
 
-	// skip over any tagged fields without deserializing them
-	// as we don't currently support doing anything with them
-	for range tagCount.Value {
-		// ignore tag identifier
-		_, err := d.ReadUnsignedVarint()
+	taggedFields := kafkaValue.TaggedFields{}
 
+	for i := range tagCount.Value {
+		tagStartOffset := d.buffer.Offset()
+		tag, err := d.ReadUnsignedVarint()
 		if err != nil {
-			return err
+			return kafkaValue.TaggedFields{}, err
 		}
 
-		// value length
-		lengthStartOffset := d.buffer.Offset()
-		length, err := d.ReadUnsignedVarint()
-
-		if err != nil {
-			return err
-		} else if int(length.Value) < 0 {
-			return NewDecoderErrorForRange(
-				errors.New("Expected length of value in tag buffer to be non-negative"),
-				int(lengthStartOffset),
+		if i > 0 && tag.Value <= taggedFields.Fields[i-1].Tag {
+			return kafkaValue.TaggedFields{}, NewDecoderErrorForRange(
+				fmt.Errorf("Expected tags in tag buffer to be in increasing order, got tag %d after tag %d", tag.Value, taggedFields.Fields[i-1].Tag),
+				int(tagStartOffset),
 				int(d.buffer.Offset()-1),
 			)
 		}
 
-		// value
-		if err := d.consumeRawBytes(length.Value); err != nil {
-			return err
+		length, err := d.ReadUnsignedVarint()
+		if err != nil {
+			return kafkaValue.TaggedFields{}, err
 		}
-	}
-	return nil
-}
 
-func (d *Decoder) consumeRawBytes(length uint64) DecoderError {
-	if length > uint64(d.RemainingBytesCount()) {
-		return d.wrapError(fmt.Errorf("Expected length to be lesser than remaining bytes (%d), got %d", d.RemainingBytesCount(), length))
+		if length.Value > d.RemainingBytesCount() {
+			return kafkaValue.TaggedFields{}, d.wrapError(fmt.Errorf("Expected length of value in tag buffer to be lesser than remaining bytes (%d), got %d", d.RemainingBytesCount(), length.Value))
+		}
+
+		taggedFields.Fields = append(taggedFields.Fields, kafkaValue.TaggedField{
+			Tag:  tag.Value,
+			Data: d.buffer.MustReadNBytes(length.Value),
+		})
 	}
 
-	// Moves the offset
-	d.buffer.MustReadNBytes(length)
+	return taggedFields, nil
+}
 
-	return nil
+// ConsumeTagBuffer skips over a tag buffer without deserializing any of the tagged fields
+func (d *Decoder) ConsumeTagBuffer() DecoderError {
+	_, err := d.ReadTaggedFields()
+	return err
 }
 
 func (d *Decoder) wrapError(err error) *decoderErrorImpl {
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 )
 
@@ -25,6 +26,14 @@ func (re *Encoder) Bytes() []byte {
 
 // Primitive types
 
+func (re *Encoder) WriteBoolean(in bool) {
+	if in {
+		re.buffer.WriteByte(1)
+	} else {
+		re.buffer.WriteByte(0)
+	}
+}
+
 func (re *Encoder) WriteInt8(in int8) {
 	re.buffer.Write([]byte{byte(in)})
 }
@@ -35,6 +44,12 @@ func (re *Encoder) WriteInt16(in int16) {
 	re.buffer.Write(buf)
 }
 
+func (re *Encoder) WriteUint16(in uint16) {
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, in)
+	re.buffer.Write(buf)
+}
+
 func (re *Encoder) WriteInt32(in int32) {
 	buf := make([]byte, 4)
 	binary.BigEndian.PutUint32(buf, uint32(in))
@@ -47,6 +62,12 @@ func (re *Encoder) WriteInt64(in int64) {
 	re.buffer.Write(buf)
 }
 
+func (re *Encoder) WriteFloat64(in float64) {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, math.Float64bits(in))
+	re.buffer.Write(buf)
+}
+
 func (re *Encoder) WriteUvarint(in uint64) {
 	buf := make([]byte, binary.MaxVarintLen64)
 	n := binary.PutUvarint(buf, in)
@@ -77,6 +98,27 @@ func (re *Encoder) WriteCompactBytes(in []byte) {
 	re.WriteRawBytes(in)
 }
 
+func (re *Encoder) WriteCompactNullableBytes(in []byte) {
+	if in == nil {
+		re.WriteUvarint(0)
+		return
+	}
+	re.WriteCompactBytes(in)
+}
+
+func (re *Encoder) WriteBytes(in []byte) {
+	re.WriteInt32(int32(len(in)))
+	re.WriteRawBytes(in)
+}
+
+func (re *Encoder) WriteNullableBytes(in []byte) {
+	if in == nil {
+		re.WriteInt32(-1)
+		return
+	}
+	re.WriteBytes(in)
+}
+
 func (re *Encoder) WriteCompactNullableString(in *string) {
 	if in == nil {
 		re.WriteUvarint(0)
@@ -95,6 +137,14 @@ func (re *Encoder) WriteString(in string) {
 	re.buffer.WriteString(in)
 }
 
+func (re *Encoder) WriteNullableString(in *string) {
+	if in == nil {
+		re.WriteInt16(-1)
+		return
+	}
+	re.WriteString(*in)
+}
+
 func (re *Encoder) WriteCompactArrayOfInt32(arr []int32) {
 	if arr == nil {
 		// Null array is encoded as 0
@@ -0,0 +1,386 @@
+// Code generated by kafka_codegen from CreateTopicsResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// CreateTopicsResponse is generated from Kafka's message spec (API key 19, valid versions 0-7, flexible versions 5-7)
+type CreateTopicsResponse struct {
+	Header headers.ResponseHeader
+	Body   CreateTopicsResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r CreateTopicsResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeCreateTopicsResponseBody(r.Body, version, encoder)
+}
+
+// CreateTopicsResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func CreateTopicsResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (CreateTopicsResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (CreateTopicsResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("CreateTopicsResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 5)
+		if err != nil {
+			return CreateTopicsResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeCreateTopicsResponseBody(decoder, version)
+		if err != nil {
+			return CreateTopicsResponse{}, err
+		}
+
+		return CreateTopicsResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type CreateTopicsResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// Results for each topic we tried to create.
+	Topics []CreateTopicsResponseCreatableTopicResult
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeCreateTopicsResponseBody(message CreateTopicsResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 5
+
+	if version >= 2 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element CreateTopicsResponseCreatableTopicResult) {
+			encoder.PushPathContext(path)
+			encodeCreateTopicsResponseCreatableTopicResult(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 5 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeCreateTopicsResponseBody(decoder *field_decoder.FieldDecoder, version int16) (CreateTopicsResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 5
+
+	message := CreateTopicsResponseBody{}
+
+	if version >= 2 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return CreateTopicsResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (CreateTopicsResponseCreatableTopicResult, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (CreateTopicsResponseCreatableTopicResult, field_decoder.FieldDecoderError) {
+				return decodeCreateTopicsResponseCreatableTopicResult(decoder, version)
+			})
+		})
+		if err != nil {
+			return CreateTopicsResponseBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 5 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return CreateTopicsResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type CreateTopicsResponseCreatableTopicResult struct {
+	// The topic name.
+	Name value.String
+	// The unique topic ID.
+	TopicId value.UUID
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The error message, or null if there was no error.
+	ErrorMessage value.NullableString
+	// Optional topic config error returned if configs are not returned in the response.
+	TopicConfigErrorCode value.Int16
+	// Number of partitions of the topic.
+	NumPartitions value.Int32
+	// Replication factor of the topic.
+	ReplicationFactor value.Int16
+	// Configuration of the topic.
+	Configs []CreateTopicsResponseCreatableTopicConfigs
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeCreateTopicsResponseCreatableTopicResult(message CreateTopicsResponseCreatableTopicResult, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 5
+
+	{
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version >= 7 {
+		encoder.WriteUUIDField("TopicId", message.TopicId)
+	}
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 1 {
+		writeNullableString(encoder, "ErrorMessage", message.ErrorMessage, isFlexible)
+	}
+
+	if version >= 5 {
+		encoder.WriteInt32Field("NumPartitions", message.NumPartitions)
+	}
+
+	if version >= 5 {
+		encoder.WriteInt16Field("ReplicationFactor", message.ReplicationFactor)
+	}
+
+	if version >= 5 {
+		encodeArray(encoder, "Configs", message.Configs, isFlexible, version >= 5, func(encoder *field_encoder.FieldEncoder, path string, element CreateTopicsResponseCreatableTopicConfigs) {
+			encoder.PushPathContext(path)
+			encodeCreateTopicsResponseCreatableTopicConfigs(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 5 {
+		taggedFields := []value.TaggedField{}
+		{
+			taggedFields = append(taggedFields, encodeTaggedField(0, func(encoder *field_encoder.FieldEncoder) {
+				encoder.WriteInt16Field("TopicConfigErrorCode", message.TopicConfigErrorCode)
+			}))
+		}
+
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeCreateTopicsResponseCreatableTopicResult(decoder *field_decoder.FieldDecoder, version int16) (CreateTopicsResponseCreatableTopicResult, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 5
+
+	message := CreateTopicsResponseCreatableTopicResult{}
+
+	{
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version >= 7 {
+		fieldValue, err := readUUID(decoder, "TopicId")
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.TopicId = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readNullableString(decoder, "ErrorMessage", isFlexible)
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.ErrorMessage = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readInt32(decoder, "NumPartitions")
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.NumPartitions = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readInt16(decoder, "ReplicationFactor")
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.ReplicationFactor = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := decodeArray(decoder, "Configs", isFlexible, version >= 5, func(decoder *field_decoder.FieldDecoder, path string) (CreateTopicsResponseCreatableTopicConfigs, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (CreateTopicsResponseCreatableTopicConfigs, field_decoder.FieldDecoderError) {
+				return decodeCreateTopicsResponseCreatableTopicConfigs(decoder, version)
+			})
+		})
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.Configs = fieldValue
+	}
+
+	if version >= 5 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(map[uint64]field_decoder.KnownTaggedField{
+			0: {Name: "TopicConfigErrorCode", DecodeFunc: func(decoder *field_decoder.FieldDecoder) field_decoder.FieldDecoderError {
+				fieldValue, err := readInt16(decoder, "TopicConfigErrorCode")
+				if err != nil {
+					return err
+				}
+
+				message.TopicConfigErrorCode = fieldValue
+				return nil
+			}},
+		})
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicResult{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type CreateTopicsResponseCreatableTopicConfigs struct {
+	// The configuration name.
+	Name value.String
+	// The configuration value.
+	Value value.NullableString
+	// True if the configuration is read-only.
+	ReadOnly value.Boolean
+	// The configuration source.
+	ConfigSource value.Int8
+	// True if this configuration is sensitive.
+	IsSensitive value.Boolean
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeCreateTopicsResponseCreatableTopicConfigs(message CreateTopicsResponseCreatableTopicConfigs, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 5
+
+	if version >= 5 {
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "Value", message.Value, isFlexible)
+	}
+
+	if version >= 5 {
+		encoder.WriteBooleanField("ReadOnly", message.ReadOnly)
+	}
+
+	if version >= 5 {
+		encoder.WriteInt8Field("ConfigSource", message.ConfigSource)
+	}
+
+	if version >= 5 {
+		encoder.WriteBooleanField("IsSensitive", message.IsSensitive)
+	}
+
+	if version >= 5 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeCreateTopicsResponseCreatableTopicConfigs(decoder *field_decoder.FieldDecoder, version int16) (CreateTopicsResponseCreatableTopicConfigs, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 5
+
+	message := CreateTopicsResponseCreatableTopicConfigs{}
+
+	if version >= 5 {
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicConfigs{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "Value", isFlexible)
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicConfigs{}, err
+		}
+
+		message.Value = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readBoolean(decoder, "ReadOnly")
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicConfigs{}, err
+		}
+
+		message.ReadOnly = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readInt8(decoder, "ConfigSource")
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicConfigs{}, err
+		}
+
+		message.ConfigSource = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readBoolean(decoder, "IsSensitive")
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicConfigs{}, err
+		}
+
+		message.IsSensitive = fieldValue
+	}
+
+	if version >= 5 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return CreateTopicsResponseCreatableTopicConfigs{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,7 @@
+// Package generated_kafkaapi contains kafkaapi types, encoders and decoders generated from Kafka's JSON message specs.
+//
+// To add an API, copy its request and response specs from Apache Kafka's clients/src/main/resources/common/message
+// into internal/kafka_codegen/specs, and re-run go generate.
+package generated_kafkaapi
+
+//go:generate go run ../../cmd/kafka_codegen -specs ../../internal/kafka_codegen/specs -out .
@@ -0,0 +1,115 @@
+// Code generated by kafka_codegen from FindCoordinatorRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// FindCoordinatorRequest is generated from Kafka's message spec (API key 10, valid versions 0-4, flexible versions 3-4)
+type FindCoordinatorRequest struct {
+	Header headers.RequestHeader
+	Body   FindCoordinatorRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r FindCoordinatorRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r FindCoordinatorRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeFindCoordinatorRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeFindCoordinatorRequestBody decodes the body of a request with the given version
+func DecodeFindCoordinatorRequestBody(decoder *field_decoder.FieldDecoder, version int16) (FindCoordinatorRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeFindCoordinatorRequestBody(decoder, version)
+}
+
+type FindCoordinatorRequestBody struct {
+	// The coordinator key.
+	Key value.String
+	// The coordinator key type. (Group, transaction, etc.)
+	KeyType value.Int8
+	// The coordinator keys.
+	CoordinatorKeys []value.String
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeFindCoordinatorRequestBody(message FindCoordinatorRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 3
+
+	if version <= 3 {
+		writeString(encoder, "Key", message.Key, isFlexible)
+	}
+
+	if version >= 1 {
+		encoder.WriteInt8Field("KeyType", message.KeyType)
+	}
+
+	if version >= 4 {
+		encodeArray(encoder, "CoordinatorKeys", message.CoordinatorKeys, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element value.String) {
+			writeString(encoder, path, element, isFlexible)
+		})
+	}
+
+	if version >= 3 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeFindCoordinatorRequestBody(decoder *field_decoder.FieldDecoder, version int16) (FindCoordinatorRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 3
+
+	message := FindCoordinatorRequestBody{}
+
+	if version <= 3 {
+		fieldValue, err := readString(decoder, "Key", isFlexible)
+		if err != nil {
+			return FindCoordinatorRequestBody{}, err
+		}
+
+		message.Key = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readInt8(decoder, "KeyType")
+		if err != nil {
+			return FindCoordinatorRequestBody{}, err
+		}
+
+		message.KeyType = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := decodeArray(decoder, "CoordinatorKeys", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (value.String, field_decoder.FieldDecoderError) {
+			return readString(decoder, path, isFlexible)
+		})
+		if err != nil {
+			return FindCoordinatorRequestBody{}, err
+		}
+
+		message.CoordinatorKeys = fieldValue
+	}
+
+	if version >= 3 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return FindCoordinatorRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,314 @@
+// Code generated by kafka_codegen from FindCoordinatorResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// FindCoordinatorResponse is generated from Kafka's message spec (API key 10, valid versions 0-4, flexible versions 3-4)
+type FindCoordinatorResponse struct {
+	Header headers.ResponseHeader
+	Body   FindCoordinatorResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r FindCoordinatorResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeFindCoordinatorResponseBody(r.Body, version, encoder)
+}
+
+// FindCoordinatorResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func FindCoordinatorResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (FindCoordinatorResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (FindCoordinatorResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("FindCoordinatorResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 3)
+		if err != nil {
+			return FindCoordinatorResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeFindCoordinatorResponseBody(decoder, version)
+		if err != nil {
+			return FindCoordinatorResponse{}, err
+		}
+
+		return FindCoordinatorResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type FindCoordinatorResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The error message, or null if there was no error.
+	ErrorMessage value.NullableString
+	// The node id.
+	NodeId value.Int32
+	// The host name.
+	Host value.String
+	// The port.
+	Port value.Int32
+	// Each coordinator result in the response
+	Coordinators []FindCoordinatorResponseCoordinator
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeFindCoordinatorResponseBody(message FindCoordinatorResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 3
+
+	if version >= 1 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	if version <= 3 {
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 1 && version <= 3 {
+		writeNullableString(encoder, "ErrorMessage", message.ErrorMessage, isFlexible)
+	}
+
+	if version <= 3 {
+		encoder.WriteInt32Field("NodeId", message.NodeId)
+	}
+
+	if version <= 3 {
+		writeString(encoder, "Host", message.Host, isFlexible)
+	}
+
+	if version <= 3 {
+		encoder.WriteInt32Field("Port", message.Port)
+	}
+
+	if version >= 4 {
+		encodeArray(encoder, "Coordinators", message.Coordinators, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element FindCoordinatorResponseCoordinator) {
+			encoder.PushPathContext(path)
+			encodeFindCoordinatorResponseCoordinator(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 3 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeFindCoordinatorResponseBody(decoder *field_decoder.FieldDecoder, version int16) (FindCoordinatorResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 3
+
+	message := FindCoordinatorResponseBody{}
+
+	if version >= 1 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	if version <= 3 {
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 1 && version <= 3 {
+		fieldValue, err := readNullableString(decoder, "ErrorMessage", isFlexible)
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.ErrorMessage = fieldValue
+	}
+
+	if version <= 3 {
+		fieldValue, err := readInt32(decoder, "NodeId")
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.NodeId = fieldValue
+	}
+
+	if version <= 3 {
+		fieldValue, err := readString(decoder, "Host", isFlexible)
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.Host = fieldValue
+	}
+
+	if version <= 3 {
+		fieldValue, err := readInt32(decoder, "Port")
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.Port = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := decodeArray(decoder, "Coordinators", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (FindCoordinatorResponseCoordinator, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (FindCoordinatorResponseCoordinator, field_decoder.FieldDecoderError) {
+				return decodeFindCoordinatorResponseCoordinator(decoder, version)
+			})
+		})
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.Coordinators = fieldValue
+	}
+
+	if version >= 3 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return FindCoordinatorResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type FindCoordinatorResponseCoordinator struct {
+	// The coordinator key.
+	Key value.String
+	// The node id.
+	NodeId value.Int32
+	// The host name.
+	Host value.String
+	// The port.
+	Port value.Int32
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The error message, or null if there was no error.
+	ErrorMessage value.NullableString
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeFindCoordinatorResponseCoordinator(message FindCoordinatorResponseCoordinator, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 3
+
+	if version >= 4 {
+		writeString(encoder, "Key", message.Key, isFlexible)
+	}
+
+	if version >= 4 {
+		encoder.WriteInt32Field("NodeId", message.NodeId)
+	}
+
+	if version >= 4 {
+		writeString(encoder, "Host", message.Host, isFlexible)
+	}
+
+	if version >= 4 {
+		encoder.WriteInt32Field("Port", message.Port)
+	}
+
+	if version >= 4 {
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 4 {
+		writeNullableString(encoder, "ErrorMessage", message.ErrorMessage, isFlexible)
+	}
+
+	if version >= 3 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeFindCoordinatorResponseCoordinator(decoder *field_decoder.FieldDecoder, version int16) (FindCoordinatorResponseCoordinator, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 3
+
+	message := FindCoordinatorResponseCoordinator{}
+
+	if version >= 4 {
+		fieldValue, err := readString(decoder, "Key", isFlexible)
+		if err != nil {
+			return FindCoordinatorResponseCoordinator{}, err
+		}
+
+		message.Key = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readInt32(decoder, "NodeId")
+		if err != nil {
+			return FindCoordinatorResponseCoordinator{}, err
+		}
+
+		message.NodeId = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readString(decoder, "Host", isFlexible)
+		if err != nil {
+			return FindCoordinatorResponseCoordinator{}, err
+		}
+
+		message.Host = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readInt32(decoder, "Port")
+		if err != nil {
+			return FindCoordinatorResponseCoordinator{}, err
+		}
+
+		message.Port = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return FindCoordinatorResponseCoordinator{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readNullableString(decoder, "ErrorMessage", isFlexible)
+		if err != nil {
+			return FindCoordinatorResponseCoordinator{}, err
+		}
+
+		message.ErrorMessage = fieldValue
+	}
+
+	if version >= 3 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return FindCoordinatorResponseCoordinator{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,282 @@
+package generated_kafkaapi
+
+import (
+	"testing"
+
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTopicsRequestRoundtrip(t *testing.T) {
+	request := CreateTopicsRequest{
+		Header: headers.RequestHeader{ApiVersion: value.Int16{Value: 7}},
+		Body: CreateTopicsRequestBody{
+			Topics: []CreateTopicsRequestCreatableTopic{
+				{
+					Name:              value.String{Value: "foo"},
+					NumPartitions:     value.Int32{Value: 3},
+					ReplicationFactor: value.Int16{Value: 1},
+					Assignments:       []CreateTopicsRequestCreatableReplicaAssignment{},
+					Configs:           []CreateTopicsRequestCreatableTopicConfig{},
+				},
+			},
+			TimeoutMs:    value.Int32{Value: 5000},
+			ValidateOnly: value.Boolean{Value: true},
+		},
+	}
+
+	encoder := field_encoder.NewFieldEncoder()
+	request.EncodeBody(encoder)
+
+	decoder := field_decoder.NewFieldDecoder(encoder.Bytes())
+	decodedBody, err := DecodeCreateTopicsRequestBody(decoder, 7)
+
+	assert.Nil(t, err)
+	assert.Equal(t, request.Body, decodedBody)
+
+	paths := []string{}
+	for _, decodedField := range decoder.DecodedFields() {
+		paths = append(paths, decodedField.Path.String())
+	}
+
+	// Field names that are lowerCamelCase in the spec are exported
+	assert.Contains(t, paths, "Body.TimeoutMs")
+	assert.Contains(t, paths, "Body.ValidateOnly")
+}
+
+func TestCreateTopicsResponseRoundtrip(t *testing.T) {
+	for _, version := range []int16{4, 7} {
+		topic := CreateTopicsResponseCreatableTopicResult{
+			Name:         value.String{Value: "foo"},
+			ErrorCode:    value.Int16{Value: 0},
+			ErrorMessage: value.NullableString{Value: nil},
+		}
+
+		if version >= 5 {
+			topic.NumPartitions = value.Int32{Value: 3}
+			topic.ReplicationFactor = value.Int16{Value: 1}
+			topic.TopicConfigErrorCode = value.Int16{Value: 0}
+			topic.Configs = []CreateTopicsResponseCreatableTopicConfigs{
+				{Name: value.String{Value: "cleanup.policy"}, Value: value.NullableString{Value: new(string)}, ConfigSource: value.Int8{Value: 5}},
+			}
+		}
+
+		if version >= 7 {
+			topic.TopicId = value.UUID{Value: "71a59a51-8968-4f8b-937e-000000000001"}
+		}
+
+		response := CreateTopicsResponse{
+			Header: headers.ResponseHeader{CorrelationId: value.Int32{Value: 7}},
+			Body: CreateTopicsResponseBody{
+				ThrottleTimeMs: value.Int32{Value: 0},
+				Topics:         []CreateTopicsResponseCreatableTopicResult{topic},
+			},
+		}
+
+		encoder := field_encoder.NewFieldEncoder()
+		encoder.WriteInt32Field("CorrelationID", response.Header.CorrelationId)
+		if version >= 5 {
+			encoder.WriteEmptyTagBuffer()
+		}
+		response.EncodeBody(encoder, version)
+
+		decoder := field_decoder.NewFieldDecoder(encoder.Bytes())
+		decodedResponse, err := CreateTopicsResponseDecoder(version)(decoder)
+
+		assert.Nil(t, err, "version %d", version)
+		assert.Equal(t, uint64(0), decoder.RemainingBytesCount(), "version %d", version)
+		assert.Equal(t, response.Body, decodedResponse.Body, "version %d", version)
+	}
+}
+
+func TestMetadataRequestRoundtrip(t *testing.T) {
+	topicName := "foo"
+
+	for _, topics := range [][]MetadataRequestTopic{
+		{{TopicId: value.UUID{Value: "00000000-0000-0000-0000-000000000000"}, Name: value.NullableString{Value: &topicName}}},
+		// A null array requests all topics
+		nil,
+	} {
+		request := MetadataRequest{
+			Header: headers.RequestHeader{ApiVersion: value.Int16{Value: 12}},
+			Body: MetadataRequestBody{
+				Topics:                 topics,
+				AllowAutoTopicCreation: value.Boolean{Value: false},
+			},
+		}
+
+		encoder := field_encoder.NewFieldEncoder()
+		request.EncodeBody(encoder)
+
+		decoder := field_decoder.NewFieldDecoder(encoder.Bytes())
+		decodedBody, err := DecodeMetadataRequestBody(decoder, 12)
+
+		assert.Nil(t, err)
+		assert.Equal(t, request.Body, decodedBody)
+	}
+}
+
+func TestListOffsetsResponseRoundtrip(t *testing.T) {
+	for _, version := range []int16{0, 8} {
+		partition := ListOffsetsResponseListOffsetsPartitionResponse{
+			PartitionIndex: value.Int32{Value: 1},
+			ErrorCode:      value.Int16{Value: 0},
+		}
+
+		if version == 0 {
+			partition.OldStyleOffsets = []value.Int64{{Value: 3}}
+		} else {
+			partition.Timestamp = value.Int64{Value: 1726045973899}
+			partition.Offset = value.Int64{Value: 3}
+			partition.LeaderEpoch = value.Int32{Value: 0}
+		}
+
+		response := ListOffsetsResponse{
+			Header: headers.ResponseHeader{CorrelationId: value.Int32{Value: 7}},
+			Body: ListOffsetsResponseBody{
+				Topics: []ListOffsetsResponseListOffsetsTopicResponse{
+					{Name: value.String{Value: "foo"}, Partitions: []ListOffsetsResponseListOffsetsPartitionResponse{partition}},
+				},
+			},
+		}
+
+		encoder := field_encoder.NewFieldEncoder()
+		encoder.WriteInt32Field("CorrelationID", response.Header.CorrelationId)
+		if version >= 6 {
+			encoder.WriteEmptyTagBuffer()
+		}
+		response.EncodeBody(encoder, version)
+
+		decoder := field_decoder.NewFieldDecoder(encoder.Bytes())
+		decodedResponse, err := ListOffsetsResponseDecoder(version)(decoder)
+
+		assert.Nil(t, err, "version %d", version)
+		assert.Equal(t, uint64(0), decoder.RemainingBytesCount(), "version %d", version)
+		assert.Equal(t, response.Body, decodedResponse.Body, "version %d", version)
+	}
+}
+
+func TestOffsetFetchRequestRoundtrip(t *testing.T) {
+	request := OffsetFetchRequest{
+		Header: headers.RequestHeader{ApiVersion: value.Int16{Value: 9}},
+		Body: OffsetFetchRequestBody{
+			Groups: []OffsetFetchRequestGroup{
+				{
+					GroupId:     value.String{Value: "foo-group"},
+					MemberId:    value.NullableString{Value: nil},
+					MemberEpoch: value.Int32{Value: -1},
+					Topics: []OffsetFetchRequestTopics{
+						{Name: value.String{Value: "foo"}, PartitionIndexes: []value.Int32{{Value: 0}, {Value: 1}}},
+					},
+				},
+				{
+					GroupId:     value.String{Value: "bar-group"},
+					MemberId:    value.NullableString{Value: nil},
+					MemberEpoch: value.Int32{Value: -1},
+					// A null array fetches offsets for all topics
+					Topics: nil,
+				},
+			},
+			RequireStable: value.Boolean{Value: false},
+		},
+	}
+
+	encoder := field_encoder.NewFieldEncoder()
+	request.EncodeBody(encoder)
+
+	decoder := field_decoder.NewFieldDecoder(encoder.Bytes())
+	decodedBody, err := DecodeOffsetFetchRequestBody(decoder, 9)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, request.Body, decodedBody)
+}
+
+func TestOffsetFetchResponseRoundtrip(t *testing.T) {
+	metadata := "bar"
+
+	response := OffsetFetchResponse{
+		Header: headers.ResponseHeader{CorrelationId: value.Int32{Value: 7}},
+		Body: OffsetFetchResponseBody{
+			ThrottleTimeMs: value.Int32{Value: 0},
+			Groups: []OffsetFetchResponseGroup{
+				{
+					GroupId: value.String{Value: "foo-group"},
+					Topics: []OffsetFetchResponseTopics{
+						{
+							Name: value.String{Value: "foo"},
+							Partitions: []OffsetFetchResponsePartitions{
+								{
+									PartitionIndex:       value.Int32{Value: 1},
+									CommittedOffset:      value.Int64{Value: 3},
+									CommittedLeaderEpoch: value.Int32{Value: -1},
+									Metadata:             value.NullableString{Value: &metadata},
+									ErrorCode:            value.Int16{Value: 0},
+								},
+							},
+						},
+					},
+					ErrorCode: value.Int16{Value: 0},
+				},
+			},
+		},
+	}
+
+	encoder := field_encoder.NewFieldEncoder()
+	encoder.WriteInt32Field("CorrelationID", response.Header.CorrelationId)
+	encoder.WriteEmptyTagBuffer()
+	response.EncodeBody(encoder, 9)
+
+	decoder := field_decoder.NewFieldDecoder(encoder.Bytes())
+	decodedResponse, err := OffsetFetchResponseDecoder(9)(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, response.Body, decodedResponse.Body)
+}
+
+func TestJoinGroupResponseRoundtrip(t *testing.T) {
+	protocolType := "consumer"
+	protocolName := "range"
+
+	response := JoinGroupResponse{
+		Header: headers.ResponseHeader{CorrelationId: value.Int32{Value: 7}},
+		Body: JoinGroupResponseBody{
+			ThrottleTimeMs: value.Int32{Value: 0},
+			ErrorCode:      value.Int16{Value: 0},
+			GenerationId:   value.Int32{Value: 2},
+			ProtocolType:   value.NullableString{Value: &protocolType},
+			ProtocolName:   value.NullableString{Value: &protocolName},
+			Leader:         value.String{Value: "foo-1"},
+			SkipAssignment: value.Boolean{Value: false},
+			MemberId:       value.String{Value: "foo-1"},
+			Members: []JoinGroupResponseMember{
+				{
+					MemberId:        value.String{Value: "foo-1"},
+					GroupInstanceId: value.NullableString{Value: nil},
+					Metadata:        value.Bytes{Value: []byte{0, 0, 0, 0, 0, 1, 0, 3, 'b', 'a', 'r', 255, 255, 255, 255}},
+				},
+				{
+					MemberId:        value.String{Value: "foo-2"},
+					GroupInstanceId: value.NullableString{Value: nil},
+					Metadata:        value.Bytes{Value: []byte{0, 0, 0, 0, 0, 0, 255, 255, 255, 255}},
+				},
+			},
+		},
+	}
+
+	encoder := field_encoder.NewFieldEncoder()
+	encoder.WriteInt32Field("CorrelationID", response.Header.CorrelationId)
+	encoder.WriteEmptyTagBuffer()
+	response.EncodeBody(encoder, 9)
+
+	decoder := field_decoder.NewFieldDecoder(encoder.Bytes())
+	decodedResponse, err := JoinGroupResponseDecoder(9)(decoder)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), decoder.RemainingBytesCount())
+	assert.Equal(t, response.Body, decodedResponse.Body)
+}
@@ -0,0 +1,126 @@
+// Code generated by kafka_codegen from HeartbeatRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// HeartbeatRequest is generated from Kafka's message spec (API key 12, valid versions 0-4, flexible versions 4)
+type HeartbeatRequest struct {
+	Header headers.RequestHeader
+	Body   HeartbeatRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r HeartbeatRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r HeartbeatRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeHeartbeatRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeHeartbeatRequestBody decodes the body of a request with the given version
+func DecodeHeartbeatRequestBody(decoder *field_decoder.FieldDecoder, version int16) (HeartbeatRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeHeartbeatRequestBody(decoder, version)
+}
+
+type HeartbeatRequestBody struct {
+	// The group id.
+	GroupId value.String
+	// The generation of the group.
+	GenerationId value.Int32
+	// The member ID.
+	MemberId value.String
+	// The unique identifier of the consumer instance provided by end user.
+	GroupInstanceId value.NullableString
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeHeartbeatRequestBody(message HeartbeatRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	{
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	{
+		encoder.WriteInt32Field("GenerationId", message.GenerationId)
+	}
+
+	{
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 3 {
+		writeNullableString(encoder, "GroupInstanceId", message.GroupInstanceId, isFlexible)
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeHeartbeatRequestBody(decoder *field_decoder.FieldDecoder, version int16) (HeartbeatRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := HeartbeatRequestBody{}
+
+	{
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return HeartbeatRequestBody{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt32(decoder, "GenerationId")
+		if err != nil {
+			return HeartbeatRequestBody{}, err
+		}
+
+		message.GenerationId = fieldValue
+	}
+
+	{
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return HeartbeatRequestBody{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 3 {
+		fieldValue, err := readNullableString(decoder, "GroupInstanceId", isFlexible)
+		if err != nil {
+			return HeartbeatRequestBody{}, err
+		}
+
+		message.GroupInstanceId = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return HeartbeatRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
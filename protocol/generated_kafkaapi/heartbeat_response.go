@@ -0,0 +1,106 @@
+// Code generated by kafka_codegen from HeartbeatResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// HeartbeatResponse is generated from Kafka's message spec (API key 12, valid versions 0-4, flexible versions 4)
+type HeartbeatResponse struct {
+	Header headers.ResponseHeader
+	Body   HeartbeatResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r HeartbeatResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeHeartbeatResponseBody(r.Body, version, encoder)
+}
+
+// HeartbeatResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func HeartbeatResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (HeartbeatResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (HeartbeatResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("HeartbeatResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 4)
+		if err != nil {
+			return HeartbeatResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeHeartbeatResponseBody(decoder, version)
+		if err != nil {
+			return HeartbeatResponse{}, err
+		}
+
+		return HeartbeatResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type HeartbeatResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeHeartbeatResponseBody(message HeartbeatResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	if version >= 1 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeHeartbeatResponseBody(decoder *field_decoder.FieldDecoder, version int16) (HeartbeatResponseBody, field_decoder.FieldDecoderError) {
+	message := HeartbeatResponseBody{}
+
+	if version >= 1 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return HeartbeatResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return HeartbeatResponseBody{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return HeartbeatResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,255 @@
+// Code generated by kafka_codegen from JoinGroupRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// JoinGroupRequest is generated from Kafka's message spec (API key 11, valid versions 0-9, flexible versions 6-9)
+type JoinGroupRequest struct {
+	Header headers.RequestHeader
+	Body   JoinGroupRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r JoinGroupRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r JoinGroupRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeJoinGroupRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeJoinGroupRequestBody decodes the body of a request with the given version
+func DecodeJoinGroupRequestBody(decoder *field_decoder.FieldDecoder, version int16) (JoinGroupRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeJoinGroupRequestBody(decoder, version)
+}
+
+type JoinGroupRequestBody struct {
+	// The group identifier.
+	GroupId value.String
+	// The coordinator considers the consumer dead if it receives no heartbeat after this timeout in milliseconds.
+	SessionTimeoutMs value.Int32
+	// The maximum time in milliseconds that the coordinator will wait for each member to rejoin when rebalancing the group.
+	RebalanceTimeoutMs value.Int32
+	// The member id assigned by the group coordinator.
+	MemberId value.String
+	// The unique identifier of the consumer instance provided by end user.
+	GroupInstanceId value.NullableString
+	// The unique name the for class of protocols implemented by the group we want to join.
+	ProtocolType value.String
+	// The list of protocols that the member supports.
+	Protocols []JoinGroupRequestProtocol
+	// The reason why the member (re-)joins the group.
+	Reason value.NullableString
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeJoinGroupRequestBody(message JoinGroupRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	{
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	{
+		encoder.WriteInt32Field("SessionTimeoutMs", message.SessionTimeoutMs)
+	}
+
+	if version >= 1 {
+		encoder.WriteInt32Field("RebalanceTimeoutMs", message.RebalanceTimeoutMs)
+	}
+
+	{
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "GroupInstanceId", message.GroupInstanceId, isFlexible)
+	}
+
+	{
+		writeString(encoder, "ProtocolType", message.ProtocolType, isFlexible)
+	}
+
+	{
+		encodeArray(encoder, "Protocols", message.Protocols, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element JoinGroupRequestProtocol) {
+			encoder.PushPathContext(path)
+			encodeJoinGroupRequestProtocol(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		writeNullableString(encoder, "Reason", message.Reason, isFlexible)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeJoinGroupRequestBody(decoder *field_decoder.FieldDecoder, version int16) (JoinGroupRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := JoinGroupRequestBody{}
+
+	{
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt32(decoder, "SessionTimeoutMs")
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.SessionTimeoutMs = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readInt32(decoder, "RebalanceTimeoutMs")
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.RebalanceTimeoutMs = fieldValue
+	}
+
+	{
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "GroupInstanceId", isFlexible)
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.GroupInstanceId = fieldValue
+	}
+
+	{
+		fieldValue, err := readString(decoder, "ProtocolType", isFlexible)
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.ProtocolType = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Protocols", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (JoinGroupRequestProtocol, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (JoinGroupRequestProtocol, field_decoder.FieldDecoderError) {
+				return decodeJoinGroupRequestProtocol(decoder, version)
+			})
+		})
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.Protocols = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readNullableString(decoder, "Reason", isFlexible)
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.Reason = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return JoinGroupRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type JoinGroupRequestProtocol struct {
+	// The protocol name.
+	Name value.String
+	// The protocol metadata.
+	Metadata value.Bytes
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeJoinGroupRequestProtocol(message JoinGroupRequestProtocol, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	{
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	{
+		writeBytes(encoder, "Metadata", message.Metadata, isFlexible)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeJoinGroupRequestProtocol(decoder *field_decoder.FieldDecoder, version int16) (JoinGroupRequestProtocol, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := JoinGroupRequestProtocol{}
+
+	{
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return JoinGroupRequestProtocol{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	{
+		fieldValue, err := readBytes(decoder, "Metadata", isFlexible)
+		if err != nil {
+			return JoinGroupRequestProtocol{}, err
+		}
+
+		message.Metadata = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return JoinGroupRequestProtocol{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,299 @@
+// Code generated by kafka_codegen from JoinGroupResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// JoinGroupResponse is generated from Kafka's message spec (API key 11, valid versions 0-9, flexible versions 6-9)
+type JoinGroupResponse struct {
+	Header headers.ResponseHeader
+	Body   JoinGroupResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r JoinGroupResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeJoinGroupResponseBody(r.Body, version, encoder)
+}
+
+// JoinGroupResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func JoinGroupResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (JoinGroupResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (JoinGroupResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("JoinGroupResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 6)
+		if err != nil {
+			return JoinGroupResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeJoinGroupResponseBody(decoder, version)
+		if err != nil {
+			return JoinGroupResponse{}, err
+		}
+
+		return JoinGroupResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type JoinGroupResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The generation ID of the group.
+	GenerationId value.Int32
+	// The group protocol name.
+	ProtocolType value.NullableString
+	// The group protocol selected by the coordinator.
+	ProtocolName value.NullableString
+	// The leader of the group.
+	Leader value.String
+	// True if the leader must skip running the assignment.
+	SkipAssignment value.Boolean
+	// The member ID assigned by the group coordinator.
+	MemberId value.String
+	// The group members.
+	Members []JoinGroupResponseMember
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeJoinGroupResponseBody(message JoinGroupResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 2 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	{
+		encoder.WriteInt32Field("GenerationId", message.GenerationId)
+	}
+
+	if version >= 7 {
+		writeNullableString(encoder, "ProtocolType", message.ProtocolType, isFlexible)
+	}
+
+	{
+		writeNullableString(encoder, "ProtocolName", message.ProtocolName, isFlexible)
+	}
+
+	{
+		writeString(encoder, "Leader", message.Leader, isFlexible)
+	}
+
+	if version >= 9 {
+		encoder.WriteBooleanField("SkipAssignment", message.SkipAssignment)
+	}
+
+	{
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	{
+		encodeArray(encoder, "Members", message.Members, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element JoinGroupResponseMember) {
+			encoder.PushPathContext(path)
+			encodeJoinGroupResponseMember(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeJoinGroupResponseBody(decoder *field_decoder.FieldDecoder, version int16) (JoinGroupResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := JoinGroupResponseBody{}
+
+	if version >= 2 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt32(decoder, "GenerationId")
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.GenerationId = fieldValue
+	}
+
+	if version >= 7 {
+		fieldValue, err := readNullableString(decoder, "ProtocolType", isFlexible)
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.ProtocolType = fieldValue
+	}
+
+	{
+		fieldValue, err := readNullableString(decoder, "ProtocolName", isFlexible)
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.ProtocolName = fieldValue
+	}
+
+	{
+		fieldValue, err := readString(decoder, "Leader", isFlexible)
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.Leader = fieldValue
+	}
+
+	if version >= 9 {
+		fieldValue, err := readBoolean(decoder, "SkipAssignment")
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.SkipAssignment = fieldValue
+	}
+
+	{
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Members", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (JoinGroupResponseMember, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (JoinGroupResponseMember, field_decoder.FieldDecoderError) {
+				return decodeJoinGroupResponseMember(decoder, version)
+			})
+		})
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.Members = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return JoinGroupResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type JoinGroupResponseMember struct {
+	// The group member ID.
+	MemberId value.String
+	// The unique identifier of the consumer instance provided by end user.
+	GroupInstanceId value.NullableString
+	// The group member metadata.
+	Metadata value.Bytes
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeJoinGroupResponseMember(message JoinGroupResponseMember, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	{
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "GroupInstanceId", message.GroupInstanceId, isFlexible)
+	}
+
+	{
+		writeBytes(encoder, "Metadata", message.Metadata, isFlexible)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeJoinGroupResponseMember(decoder *field_decoder.FieldDecoder, version int16) (JoinGroupResponseMember, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := JoinGroupResponseMember{}
+
+	{
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return JoinGroupResponseMember{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "GroupInstanceId", isFlexible)
+		if err != nil {
+			return JoinGroupResponseMember{}, err
+		}
+
+		message.GroupInstanceId = fieldValue
+	}
+
+	{
+		fieldValue, err := readBytes(decoder, "Metadata", isFlexible)
+		if err != nil {
+			return JoinGroupResponseMember{}, err
+		}
+
+		message.Metadata = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return JoinGroupResponseMember{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
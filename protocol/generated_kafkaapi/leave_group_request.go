@@ -0,0 +1,195 @@
+// Code generated by kafka_codegen from LeaveGroupRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// LeaveGroupRequest is generated from Kafka's message spec (API key 13, valid versions 0-5, flexible versions 4-5)
+type LeaveGroupRequest struct {
+	Header headers.RequestHeader
+	Body   LeaveGroupRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r LeaveGroupRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r LeaveGroupRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeLeaveGroupRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeLeaveGroupRequestBody decodes the body of a request with the given version
+func DecodeLeaveGroupRequestBody(decoder *field_decoder.FieldDecoder, version int16) (LeaveGroupRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeLeaveGroupRequestBody(decoder, version)
+}
+
+type LeaveGroupRequestBody struct {
+	// The ID of the group to leave.
+	GroupId value.String
+	// The member ID to remove from the group.
+	MemberId value.String
+	// List of leaving member identities.
+	Members []LeaveGroupRequestMemberIdentity
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeLeaveGroupRequestBody(message LeaveGroupRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	{
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	if version <= 2 {
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 3 {
+		encodeArray(encoder, "Members", message.Members, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element LeaveGroupRequestMemberIdentity) {
+			encoder.PushPathContext(path)
+			encodeLeaveGroupRequestMemberIdentity(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeLeaveGroupRequestBody(decoder *field_decoder.FieldDecoder, version int16) (LeaveGroupRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := LeaveGroupRequestBody{}
+
+	{
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return LeaveGroupRequestBody{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	if version <= 2 {
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return LeaveGroupRequestBody{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 3 {
+		fieldValue, err := decodeArray(decoder, "Members", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (LeaveGroupRequestMemberIdentity, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (LeaveGroupRequestMemberIdentity, field_decoder.FieldDecoderError) {
+				return decodeLeaveGroupRequestMemberIdentity(decoder, version)
+			})
+		})
+		if err != nil {
+			return LeaveGroupRequestBody{}, err
+		}
+
+		message.Members = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return LeaveGroupRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type LeaveGroupRequestMemberIdentity struct {
+	// The member ID to remove from the group.
+	MemberId value.String
+	// The group instance ID to remove from the group.
+	GroupInstanceId value.NullableString
+	// The reason why the member left the group.
+	Reason value.NullableString
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeLeaveGroupRequestMemberIdentity(message LeaveGroupRequestMemberIdentity, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	if version >= 3 {
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 3 {
+		writeNullableString(encoder, "GroupInstanceId", message.GroupInstanceId, isFlexible)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "Reason", message.Reason, isFlexible)
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeLeaveGroupRequestMemberIdentity(decoder *field_decoder.FieldDecoder, version int16) (LeaveGroupRequestMemberIdentity, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := LeaveGroupRequestMemberIdentity{}
+
+	if version >= 3 {
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return LeaveGroupRequestMemberIdentity{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 3 {
+		fieldValue, err := readNullableString(decoder, "GroupInstanceId", isFlexible)
+		if err != nil {
+			return LeaveGroupRequestMemberIdentity{}, err
+		}
+
+		message.GroupInstanceId = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "Reason", isFlexible)
+		if err != nil {
+			return LeaveGroupRequestMemberIdentity{}, err
+		}
+
+		message.Reason = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return LeaveGroupRequestMemberIdentity{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,209 @@
+// Code generated by kafka_codegen from LeaveGroupResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// LeaveGroupResponse is generated from Kafka's message spec (API key 13, valid versions 0-5, flexible versions 4-5)
+type LeaveGroupResponse struct {
+	Header headers.ResponseHeader
+	Body   LeaveGroupResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r LeaveGroupResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeLeaveGroupResponseBody(r.Body, version, encoder)
+}
+
+// LeaveGroupResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func LeaveGroupResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (LeaveGroupResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (LeaveGroupResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("LeaveGroupResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 4)
+		if err != nil {
+			return LeaveGroupResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeLeaveGroupResponseBody(decoder, version)
+		if err != nil {
+			return LeaveGroupResponse{}, err
+		}
+
+		return LeaveGroupResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type LeaveGroupResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// List of leaving member responses.
+	Members []LeaveGroupResponseMemberResponse
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeLeaveGroupResponseBody(message LeaveGroupResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	if version >= 1 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 3 {
+		encodeArray(encoder, "Members", message.Members, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element LeaveGroupResponseMemberResponse) {
+			encoder.PushPathContext(path)
+			encodeLeaveGroupResponseMemberResponse(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeLeaveGroupResponseBody(decoder *field_decoder.FieldDecoder, version int16) (LeaveGroupResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := LeaveGroupResponseBody{}
+
+	if version >= 1 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return LeaveGroupResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return LeaveGroupResponseBody{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 3 {
+		fieldValue, err := decodeArray(decoder, "Members", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (LeaveGroupResponseMemberResponse, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (LeaveGroupResponseMemberResponse, field_decoder.FieldDecoderError) {
+				return decodeLeaveGroupResponseMemberResponse(decoder, version)
+			})
+		})
+		if err != nil {
+			return LeaveGroupResponseBody{}, err
+		}
+
+		message.Members = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return LeaveGroupResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type LeaveGroupResponseMemberResponse struct {
+	// The member ID to remove from the group.
+	MemberId value.String
+	// The group instance ID to remove from the group.
+	GroupInstanceId value.NullableString
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeLeaveGroupResponseMemberResponse(message LeaveGroupResponseMemberResponse, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	if version >= 3 {
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 3 {
+		writeNullableString(encoder, "GroupInstanceId", message.GroupInstanceId, isFlexible)
+	}
+
+	if version >= 3 {
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeLeaveGroupResponseMemberResponse(decoder *field_decoder.FieldDecoder, version int16) (LeaveGroupResponseMemberResponse, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := LeaveGroupResponseMemberResponse{}
+
+	if version >= 3 {
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return LeaveGroupResponseMemberResponse{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 3 {
+		fieldValue, err := readNullableString(decoder, "GroupInstanceId", isFlexible)
+		if err != nil {
+			return LeaveGroupResponseMemberResponse{}, err
+		}
+
+		message.GroupInstanceId = fieldValue
+	}
+
+	if version >= 3 {
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return LeaveGroupResponseMemberResponse{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return LeaveGroupResponseMemberResponse{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by kafka_codegen from ListOffsetsRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// ListOffsetsRequest is generated from Kafka's message spec (API key 2, valid versions 0-8, flexible versions 6-8)
+type ListOffsetsRequest struct {
+	Header headers.RequestHeader
+	Body   ListOffsetsRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r ListOffsetsRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r ListOffsetsRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeListOffsetsRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeListOffsetsRequestBody decodes the body of a request with the given version
+func DecodeListOffsetsRequestBody(decoder *field_decoder.FieldDecoder, version int16) (ListOffsetsRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeListOffsetsRequestBody(decoder, version)
+}
+
+type ListOffsetsRequestBody struct {
+	// The broker ID of the requester, or -1 if this request is being made by a normal consumer.
+	ReplicaId value.Int32
+	// This setting controls the visibility of transactional records. Using READ_UNCOMMITTED (isolation_level = 0) makes all records visible. With READ_COMMITTED (isolation_level = 1), non-transactional and COMMITTED transactional records are visible. To be more concrete, READ_COMMITTED returns all data from offsets smaller than the current LSO (last stable offset), and enables the inclusion of the list of aborted transactions in the result, which allows consumers to discard ABORTED transactional records
+	IsolationLevel value.Int8
+	// Each topic in the request.
+	Topics []ListOffsetsRequestListOffsetsTopic
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeListOffsetsRequestBody(message ListOffsetsRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	{
+		encoder.WriteInt32Field("ReplicaId", message.ReplicaId)
+	}
+
+	if version >= 2 {
+		encoder.WriteInt8Field("IsolationLevel", message.IsolationLevel)
+	}
+
+	{
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element ListOffsetsRequestListOffsetsTopic) {
+			encoder.PushPathContext(path)
+			encodeListOffsetsRequestListOffsetsTopic(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeListOffsetsRequestBody(decoder *field_decoder.FieldDecoder, version int16) (ListOffsetsRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := ListOffsetsRequestBody{}
+
+	{
+		fieldValue, err := readInt32(decoder, "ReplicaId")
+		if err != nil {
+			return ListOffsetsRequestBody{}, err
+		}
+
+		message.ReplicaId = fieldValue
+	}
+
+	if version >= 2 {
+		fieldValue, err := readInt8(decoder, "IsolationLevel")
+		if err != nil {
+			return ListOffsetsRequestBody{}, err
+		}
+
+		message.IsolationLevel = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (ListOffsetsRequestListOffsetsTopic, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (ListOffsetsRequestListOffsetsTopic, field_decoder.FieldDecoderError) {
+				return decodeListOffsetsRequestListOffsetsTopic(decoder, version)
+			})
+		})
+		if err != nil {
+			return ListOffsetsRequestBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return ListOffsetsRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type ListOffsetsRequestListOffsetsTopic struct {
+	// The topic name.
+	Name value.String
+	// Each partition in the request.
+	Partitions []ListOffsetsRequestListOffsetsPartition
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeListOffsetsRequestListOffsetsTopic(message ListOffsetsRequestListOffsetsTopic, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	{
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	{
+		encodeArray(encoder, "Partitions", message.Partitions, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element ListOffsetsRequestListOffsetsPartition) {
+			encoder.PushPathContext(path)
+			encodeListOffsetsRequestListOffsetsPartition(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeListOffsetsRequestListOffsetsTopic(decoder *field_decoder.FieldDecoder, version int16) (ListOffsetsRequestListOffsetsTopic, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := ListOffsetsRequestListOffsetsTopic{}
+
+	{
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return ListOffsetsRequestListOffsetsTopic{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Partitions", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (ListOffsetsRequestListOffsetsPartition, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (ListOffsetsRequestListOffsetsPartition, field_decoder.FieldDecoderError) {
+				return decodeListOffsetsRequestListOffsetsPartition(decoder, version)
+			})
+		})
+		if err != nil {
+			return ListOffsetsRequestListOffsetsTopic{}, err
+		}
+
+		message.Partitions = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return ListOffsetsRequestListOffsetsTopic{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type ListOffsetsRequestListOffsetsPartition struct {
+	// The partition index.
+	PartitionIndex value.Int32
+	// The current leader epoch.
+	CurrentLeaderEpoch value.Int32
+	// The current timestamp.
+	Timestamp value.Int64
+	// The maximum number of offsets to report.
+	MaxNumOffsets value.Int32
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeListOffsetsRequestListOffsetsPartition(message ListOffsetsRequestListOffsetsPartition, version int16, encoder *field_encoder.FieldEncoder) {
+	{
+		encoder.WriteInt32Field("PartitionIndex", message.PartitionIndex)
+	}
+
+	if version >= 4 {
+		encoder.WriteInt32Field("CurrentLeaderEpoch", message.CurrentLeaderEpoch)
+	}
+
+	{
+		encoder.WriteInt64Field("Timestamp", message.Timestamp)
+	}
+
+	if version <= 0 {
+		encoder.WriteInt32Field("MaxNumOffsets", message.MaxNumOffsets)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeListOffsetsRequestListOffsetsPartition(decoder *field_decoder.FieldDecoder, version int16) (ListOffsetsRequestListOffsetsPartition, field_decoder.FieldDecoderError) {
+	message := ListOffsetsRequestListOffsetsPartition{}
+
+	{
+		fieldValue, err := readInt32(decoder, "PartitionIndex")
+		if err != nil {
+			return ListOffsetsRequestListOffsetsPartition{}, err
+		}
+
+		message.PartitionIndex = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readInt32(decoder, "CurrentLeaderEpoch")
+		if err != nil {
+			return ListOffsetsRequestListOffsetsPartition{}, err
+		}
+
+		message.CurrentLeaderEpoch = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt64(decoder, "Timestamp")
+		if err != nil {
+			return ListOffsetsRequestListOffsetsPartition{}, err
+		}
+
+		message.Timestamp = fieldValue
+	}
+
+	if version <= 0 {
+		fieldValue, err := readInt32(decoder, "MaxNumOffsets")
+		if err != nil {
+			return ListOffsetsRequestListOffsetsPartition{}, err
+		}
+
+		message.MaxNumOffsets = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return ListOffsetsRequestListOffsetsPartition{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
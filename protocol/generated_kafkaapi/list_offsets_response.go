@@ -0,0 +1,311 @@
+// Code generated by kafka_codegen from ListOffsetsResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// ListOffsetsResponse is generated from Kafka's message spec (API key 2, valid versions 0-8, flexible versions 6-8)
+type ListOffsetsResponse struct {
+	Header headers.ResponseHeader
+	Body   ListOffsetsResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r ListOffsetsResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeListOffsetsResponseBody(r.Body, version, encoder)
+}
+
+// ListOffsetsResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func ListOffsetsResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (ListOffsetsResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (ListOffsetsResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("ListOffsetsResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 6)
+		if err != nil {
+			return ListOffsetsResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeListOffsetsResponseBody(decoder, version)
+		if err != nil {
+			return ListOffsetsResponse{}, err
+		}
+
+		return ListOffsetsResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type ListOffsetsResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// Each topic in the response.
+	Topics []ListOffsetsResponseListOffsetsTopicResponse
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeListOffsetsResponseBody(message ListOffsetsResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 2 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element ListOffsetsResponseListOffsetsTopicResponse) {
+			encoder.PushPathContext(path)
+			encodeListOffsetsResponseListOffsetsTopicResponse(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeListOffsetsResponseBody(decoder *field_decoder.FieldDecoder, version int16) (ListOffsetsResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := ListOffsetsResponseBody{}
+
+	if version >= 2 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return ListOffsetsResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (ListOffsetsResponseListOffsetsTopicResponse, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (ListOffsetsResponseListOffsetsTopicResponse, field_decoder.FieldDecoderError) {
+				return decodeListOffsetsResponseListOffsetsTopicResponse(decoder, version)
+			})
+		})
+		if err != nil {
+			return ListOffsetsResponseBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return ListOffsetsResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type ListOffsetsResponseListOffsetsTopicResponse struct {
+	// The topic name
+	Name value.String
+	// Each partition in the response.
+	Partitions []ListOffsetsResponseListOffsetsPartitionResponse
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeListOffsetsResponseListOffsetsTopicResponse(message ListOffsetsResponseListOffsetsTopicResponse, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	{
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	{
+		encodeArray(encoder, "Partitions", message.Partitions, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element ListOffsetsResponseListOffsetsPartitionResponse) {
+			encoder.PushPathContext(path)
+			encodeListOffsetsResponseListOffsetsPartitionResponse(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeListOffsetsResponseListOffsetsTopicResponse(decoder *field_decoder.FieldDecoder, version int16) (ListOffsetsResponseListOffsetsTopicResponse, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := ListOffsetsResponseListOffsetsTopicResponse{}
+
+	{
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return ListOffsetsResponseListOffsetsTopicResponse{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Partitions", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (ListOffsetsResponseListOffsetsPartitionResponse, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (ListOffsetsResponseListOffsetsPartitionResponse, field_decoder.FieldDecoderError) {
+				return decodeListOffsetsResponseListOffsetsPartitionResponse(decoder, version)
+			})
+		})
+		if err != nil {
+			return ListOffsetsResponseListOffsetsTopicResponse{}, err
+		}
+
+		message.Partitions = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return ListOffsetsResponseListOffsetsTopicResponse{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type ListOffsetsResponseListOffsetsPartitionResponse struct {
+	// The partition index.
+	PartitionIndex value.Int32
+	// The partition error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The result offsets.
+	OldStyleOffsets []value.Int64
+	// The timestamp associated with the returned offset.
+	Timestamp value.Int64
+	// The returned offset.
+	Offset      value.Int64
+	LeaderEpoch value.Int32
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeListOffsetsResponseListOffsetsPartitionResponse(message ListOffsetsResponseListOffsetsPartitionResponse, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	{
+		encoder.WriteInt32Field("PartitionIndex", message.PartitionIndex)
+	}
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version <= 0 {
+		encodeArray(encoder, "OldStyleOffsets", message.OldStyleOffsets, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element value.Int64) {
+			encoder.WriteInt64Field(path, element)
+		})
+	}
+
+	if version >= 1 {
+		encoder.WriteInt64Field("Timestamp", message.Timestamp)
+	}
+
+	if version >= 1 {
+		encoder.WriteInt64Field("Offset", message.Offset)
+	}
+
+	if version >= 4 {
+		encoder.WriteInt32Field("LeaderEpoch", message.LeaderEpoch)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeListOffsetsResponseListOffsetsPartitionResponse(decoder *field_decoder.FieldDecoder, version int16) (ListOffsetsResponseListOffsetsPartitionResponse, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := ListOffsetsResponseListOffsetsPartitionResponse{}
+
+	{
+		fieldValue, err := readInt32(decoder, "PartitionIndex")
+		if err != nil {
+			return ListOffsetsResponseListOffsetsPartitionResponse{}, err
+		}
+
+		message.PartitionIndex = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return ListOffsetsResponseListOffsetsPartitionResponse{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version <= 0 {
+		fieldValue, err := decodeArray(decoder, "OldStyleOffsets", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (value.Int64, field_decoder.FieldDecoderError) {
+			return readInt64(decoder, path)
+		})
+		if err != nil {
+			return ListOffsetsResponseListOffsetsPartitionResponse{}, err
+		}
+
+		message.OldStyleOffsets = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readInt64(decoder, "Timestamp")
+		if err != nil {
+			return ListOffsetsResponseListOffsetsPartitionResponse{}, err
+		}
+
+		message.Timestamp = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readInt64(decoder, "Offset")
+		if err != nil {
+			return ListOffsetsResponseListOffsetsPartitionResponse{}, err
+		}
+
+		message.Offset = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readInt32(decoder, "LeaderEpoch")
+		if err != nil {
+			return ListOffsetsResponseListOffsetsPartitionResponse{}, err
+		}
+
+		message.LeaderEpoch = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return ListOffsetsResponseListOffsetsPartitionResponse{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,195 @@
+// Code generated by kafka_codegen from MetadataRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// MetadataRequest is generated from Kafka's message spec (API key 3, valid versions 0-12, flexible versions 9-12)
+type MetadataRequest struct {
+	Header headers.RequestHeader
+	Body   MetadataRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r MetadataRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r MetadataRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeMetadataRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeMetadataRequestBody decodes the body of a request with the given version
+func DecodeMetadataRequestBody(decoder *field_decoder.FieldDecoder, version int16) (MetadataRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeMetadataRequestBody(decoder, version)
+}
+
+type MetadataRequestBody struct {
+	// The topics to fetch metadata for.
+	Topics []MetadataRequestTopic
+	// If this is true, the broker may auto-create topics that we requested which do not already exist, if it is configured to do so.
+	AllowAutoTopicCreation value.Boolean
+	// Whether to include cluster authorized operations.
+	IncludeClusterAuthorizedOperations value.Boolean
+	// Whether to include topic authorized operations.
+	IncludeTopicAuthorizedOperations value.Boolean
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeMetadataRequestBody(message MetadataRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 9
+
+	{
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, version >= 1, func(encoder *field_encoder.FieldEncoder, path string, element MetadataRequestTopic) {
+			encoder.PushPathContext(path)
+			encodeMetadataRequestTopic(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 4 {
+		encoder.WriteBooleanField("AllowAutoTopicCreation", message.AllowAutoTopicCreation)
+	}
+
+	if version >= 8 && version <= 10 {
+		encoder.WriteBooleanField("IncludeClusterAuthorizedOperations", message.IncludeClusterAuthorizedOperations)
+	}
+
+	if version >= 8 {
+		encoder.WriteBooleanField("IncludeTopicAuthorizedOperations", message.IncludeTopicAuthorizedOperations)
+	}
+
+	if version >= 9 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeMetadataRequestBody(decoder *field_decoder.FieldDecoder, version int16) (MetadataRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 9
+
+	message := MetadataRequestBody{}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, version >= 1, func(decoder *field_decoder.FieldDecoder, path string) (MetadataRequestTopic, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (MetadataRequestTopic, field_decoder.FieldDecoderError) {
+				return decodeMetadataRequestTopic(decoder, version)
+			})
+		})
+		if err != nil {
+			return MetadataRequestBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 4 {
+		fieldValue, err := readBoolean(decoder, "AllowAutoTopicCreation")
+		if err != nil {
+			return MetadataRequestBody{}, err
+		}
+
+		message.AllowAutoTopicCreation = fieldValue
+	}
+
+	if version >= 8 && version <= 10 {
+		fieldValue, err := readBoolean(decoder, "IncludeClusterAuthorizedOperations")
+		if err != nil {
+			return MetadataRequestBody{}, err
+		}
+
+		message.IncludeClusterAuthorizedOperations = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readBoolean(decoder, "IncludeTopicAuthorizedOperations")
+		if err != nil {
+			return MetadataRequestBody{}, err
+		}
+
+		message.IncludeTopicAuthorizedOperations = fieldValue
+	}
+
+	if version >= 9 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return MetadataRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type MetadataRequestTopic struct {
+	// The topic id.
+	TopicId value.UUID
+	// The topic name.
+	Name value.NullableString
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeMetadataRequestTopic(message MetadataRequestTopic, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 9
+
+	if version >= 10 {
+		encoder.WriteUUIDField("TopicId", message.TopicId)
+	}
+
+	{
+		writeNullableString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version >= 9 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeMetadataRequestTopic(decoder *field_decoder.FieldDecoder, version int16) (MetadataRequestTopic, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 9
+
+	message := MetadataRequestTopic{}
+
+	if version >= 10 {
+		fieldValue, err := readUUID(decoder, "TopicId")
+		if err != nil {
+			return MetadataRequestTopic{}, err
+		}
+
+		message.TopicId = fieldValue
+	}
+
+	{
+		fieldValue, err := readNullableString(decoder, "Name", isFlexible)
+		if err != nil {
+			return MetadataRequestTopic{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version >= 9 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return MetadataRequestTopic{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
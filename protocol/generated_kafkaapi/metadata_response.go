@@ -0,0 +1,554 @@
+// Code generated by kafka_codegen from MetadataResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// MetadataResponse is generated from Kafka's message spec (API key 3, valid versions 0-12, flexible versions 9-12)
+type MetadataResponse struct {
+	Header headers.ResponseHeader
+	Body   MetadataResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r MetadataResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeMetadataResponseBody(r.Body, version, encoder)
+}
+
+// MetadataResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func MetadataResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (MetadataResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (MetadataResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("MetadataResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 9)
+		if err != nil {
+			return MetadataResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeMetadataResponseBody(decoder, version)
+		if err != nil {
+			return MetadataResponse{}, err
+		}
+
+		return MetadataResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type MetadataResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// A list of brokers present in the cluster.
+	Brokers []MetadataResponseBroker
+	// The cluster ID that responding broker belongs to.
+	ClusterId value.NullableString
+	// The ID of the controller broker.
+	ControllerId value.Int32
+	// Each topic in the response.
+	Topics []MetadataResponseTopic
+	// 32-bit bitfield to represent authorized operations for the cluster.
+	ClusterAuthorizedOperations value.Int32
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeMetadataResponseBody(message MetadataResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 9
+
+	if version >= 3 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encodeArray(encoder, "Brokers", message.Brokers, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element MetadataResponseBroker) {
+			encoder.PushPathContext(path)
+			encodeMetadataResponseBroker(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 2 {
+		writeNullableString(encoder, "ClusterId", message.ClusterId, isFlexible)
+	}
+
+	if version >= 1 {
+		encoder.WriteInt32Field("ControllerId", message.ControllerId)
+	}
+
+	{
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element MetadataResponseTopic) {
+			encoder.PushPathContext(path)
+			encodeMetadataResponseTopic(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 && version <= 10 {
+		encoder.WriteInt32Field("ClusterAuthorizedOperations", message.ClusterAuthorizedOperations)
+	}
+
+	if version >= 9 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeMetadataResponseBody(decoder *field_decoder.FieldDecoder, version int16) (MetadataResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 9
+
+	message := MetadataResponseBody{}
+
+	if version >= 3 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return MetadataResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Brokers", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (MetadataResponseBroker, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (MetadataResponseBroker, field_decoder.FieldDecoderError) {
+				return decodeMetadataResponseBroker(decoder, version)
+			})
+		})
+		if err != nil {
+			return MetadataResponseBody{}, err
+		}
+
+		message.Brokers = fieldValue
+	}
+
+	if version >= 2 {
+		fieldValue, err := readNullableString(decoder, "ClusterId", isFlexible)
+		if err != nil {
+			return MetadataResponseBody{}, err
+		}
+
+		message.ClusterId = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readInt32(decoder, "ControllerId")
+		if err != nil {
+			return MetadataResponseBody{}, err
+		}
+
+		message.ControllerId = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (MetadataResponseTopic, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (MetadataResponseTopic, field_decoder.FieldDecoderError) {
+				return decodeMetadataResponseTopic(decoder, version)
+			})
+		})
+		if err != nil {
+			return MetadataResponseBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 8 && version <= 10 {
+		fieldValue, err := readInt32(decoder, "ClusterAuthorizedOperations")
+		if err != nil {
+			return MetadataResponseBody{}, err
+		}
+
+		message.ClusterAuthorizedOperations = fieldValue
+	}
+
+	if version >= 9 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return MetadataResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type MetadataResponseBroker struct {
+	// The broker ID.
+	NodeId value.Int32
+	// The broker hostname.
+	Host value.String
+	// The broker port.
+	Port value.Int32
+	// The rack of the broker, or null if it has not been assigned to a rack.
+	Rack value.NullableString
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeMetadataResponseBroker(message MetadataResponseBroker, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 9
+
+	{
+		encoder.WriteInt32Field("NodeId", message.NodeId)
+	}
+
+	{
+		writeString(encoder, "Host", message.Host, isFlexible)
+	}
+
+	{
+		encoder.WriteInt32Field("Port", message.Port)
+	}
+
+	if version >= 1 {
+		writeNullableString(encoder, "Rack", message.Rack, isFlexible)
+	}
+
+	if version >= 9 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeMetadataResponseBroker(decoder *field_decoder.FieldDecoder, version int16) (MetadataResponseBroker, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 9
+
+	message := MetadataResponseBroker{}
+
+	{
+		fieldValue, err := readInt32(decoder, "NodeId")
+		if err != nil {
+			return MetadataResponseBroker{}, err
+		}
+
+		message.NodeId = fieldValue
+	}
+
+	{
+		fieldValue, err := readString(decoder, "Host", isFlexible)
+		if err != nil {
+			return MetadataResponseBroker{}, err
+		}
+
+		message.Host = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt32(decoder, "Port")
+		if err != nil {
+			return MetadataResponseBroker{}, err
+		}
+
+		message.Port = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readNullableString(decoder, "Rack", isFlexible)
+		if err != nil {
+			return MetadataResponseBroker{}, err
+		}
+
+		message.Rack = fieldValue
+	}
+
+	if version >= 9 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return MetadataResponseBroker{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type MetadataResponseTopic struct {
+	// The topic error, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The topic name. Null for non-existing topics queried by ID. This is never null when ErrorCode is zero. One of Name and TopicId is always populated.
+	Name value.NullableString
+	// The topic id. Zero for non-existing topics queried by name. This is never zero when ErrorCode is zero. One of Name and TopicId is always populated.
+	TopicId value.UUID
+	// True if the topic is internal.
+	IsInternal value.Boolean
+	// Each partition in the topic.
+	Partitions []MetadataResponsePartition
+	// 32-bit bitfield to represent authorized operations for this topic.
+	TopicAuthorizedOperations value.Int32
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeMetadataResponseTopic(message MetadataResponseTopic, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 9
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	{
+		writeNullableString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version >= 10 {
+		encoder.WriteUUIDField("TopicId", message.TopicId)
+	}
+
+	if version >= 1 {
+		encoder.WriteBooleanField("IsInternal", message.IsInternal)
+	}
+
+	{
+		encodeArray(encoder, "Partitions", message.Partitions, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element MetadataResponsePartition) {
+			encoder.PushPathContext(path)
+			encodeMetadataResponsePartition(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		encoder.WriteInt32Field("TopicAuthorizedOperations", message.TopicAuthorizedOperations)
+	}
+
+	if version >= 9 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeMetadataResponseTopic(decoder *field_decoder.FieldDecoder, version int16) (MetadataResponseTopic, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 9
+
+	message := MetadataResponseTopic{}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return MetadataResponseTopic{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	{
+		fieldValue, err := readNullableString(decoder, "Name", isFlexible)
+		if err != nil {
+			return MetadataResponseTopic{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version >= 10 {
+		fieldValue, err := readUUID(decoder, "TopicId")
+		if err != nil {
+			return MetadataResponseTopic{}, err
+		}
+
+		message.TopicId = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readBoolean(decoder, "IsInternal")
+		if err != nil {
+			return MetadataResponseTopic{}, err
+		}
+
+		message.IsInternal = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Partitions", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (MetadataResponsePartition, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (MetadataResponsePartition, field_decoder.FieldDecoderError) {
+				return decodeMetadataResponsePartition(decoder, version)
+			})
+		})
+		if err != nil {
+			return MetadataResponseTopic{}, err
+		}
+
+		message.Partitions = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readInt32(decoder, "TopicAuthorizedOperations")
+		if err != nil {
+			return MetadataResponseTopic{}, err
+		}
+
+		message.TopicAuthorizedOperations = fieldValue
+	}
+
+	if version >= 9 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return MetadataResponseTopic{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type MetadataResponsePartition struct {
+	// The partition error, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The partition index.
+	PartitionIndex value.Int32
+	// The ID of the leader broker.
+	LeaderId value.Int32
+	// The leader epoch of this partition.
+	LeaderEpoch value.Int32
+	// The set of all nodes that host this partition.
+	ReplicaNodes []value.Int32
+	// The set of nodes that are in sync with the leader for this partition.
+	IsrNodes []value.Int32
+	// The set of offline replicas of this partition.
+	OfflineReplicas []value.Int32
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeMetadataResponsePartition(message MetadataResponsePartition, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 9
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	{
+		encoder.WriteInt32Field("PartitionIndex", message.PartitionIndex)
+	}
+
+	{
+		encoder.WriteInt32Field("LeaderId", message.LeaderId)
+	}
+
+	if version >= 7 {
+		encoder.WriteInt32Field("LeaderEpoch", message.LeaderEpoch)
+	}
+
+	{
+		encodeArray(encoder, "ReplicaNodes", message.ReplicaNodes, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element value.Int32) {
+			encoder.WriteInt32Field(path, element)
+		})
+	}
+
+	{
+		encodeArray(encoder, "IsrNodes", message.IsrNodes, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element value.Int32) {
+			encoder.WriteInt32Field(path, element)
+		})
+	}
+
+	if version >= 5 {
+		encodeArray(encoder, "OfflineReplicas", message.OfflineReplicas, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element value.Int32) {
+			encoder.WriteInt32Field(path, element)
+		})
+	}
+
+	if version >= 9 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeMetadataResponsePartition(decoder *field_decoder.FieldDecoder, version int16) (MetadataResponsePartition, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 9
+
+	message := MetadataResponsePartition{}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt32(decoder, "PartitionIndex")
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.PartitionIndex = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt32(decoder, "LeaderId")
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.LeaderId = fieldValue
+	}
+
+	if version >= 7 {
+		fieldValue, err := readInt32(decoder, "LeaderEpoch")
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.LeaderEpoch = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "ReplicaNodes", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (value.Int32, field_decoder.FieldDecoderError) {
+			return readInt32(decoder, path)
+		})
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.ReplicaNodes = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "IsrNodes", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (value.Int32, field_decoder.FieldDecoderError) {
+			return readInt32(decoder, path)
+		})
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.IsrNodes = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := decodeArray(decoder, "OfflineReplicas", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (value.Int32, field_decoder.FieldDecoderError) {
+			return readInt32(decoder, path)
+		})
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.OfflineReplicas = fieldValue
+	}
+
+	if version >= 9 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return MetadataResponsePartition{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
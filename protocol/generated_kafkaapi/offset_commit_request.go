@@ -0,0 +1,339 @@
+// Code generated by kafka_codegen from OffsetCommitRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// OffsetCommitRequest is generated from Kafka's message spec (API key 8, valid versions 0-9, flexible versions 8-9)
+type OffsetCommitRequest struct {
+	Header headers.RequestHeader
+	Body   OffsetCommitRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r OffsetCommitRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r OffsetCommitRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeOffsetCommitRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeOffsetCommitRequestBody decodes the body of a request with the given version
+func DecodeOffsetCommitRequestBody(decoder *field_decoder.FieldDecoder, version int16) (OffsetCommitRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeOffsetCommitRequestBody(decoder, version)
+}
+
+type OffsetCommitRequestBody struct {
+	// The unique group identifier.
+	GroupId value.String
+	// The generation of the group if using the classic group protocol or the member epoch if using the consumer protocol.
+	GenerationIdOrMemberEpoch value.Int32
+	// The member ID assigned by the group coordinator.
+	MemberId value.String
+	// The unique identifier of the consumer instance provided by end user.
+	GroupInstanceId value.NullableString
+	// The time period in ms to retain the offset.
+	RetentionTimeMs value.Int64
+	// The topics to commit offsets for.
+	Topics []OffsetCommitRequestTopic
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetCommitRequestBody(message OffsetCommitRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 8
+
+	{
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	if version >= 1 {
+		encoder.WriteInt32Field("GenerationIdOrMemberEpoch", message.GenerationIdOrMemberEpoch)
+	}
+
+	if version >= 1 {
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 7 {
+		writeNullableString(encoder, "GroupInstanceId", message.GroupInstanceId, isFlexible)
+	}
+
+	if version >= 2 && version <= 4 {
+		encoder.WriteInt64Field("RetentionTimeMs", message.RetentionTimeMs)
+	}
+
+	{
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetCommitRequestTopic) {
+			encoder.PushPathContext(path)
+			encodeOffsetCommitRequestTopic(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetCommitRequestBody(decoder *field_decoder.FieldDecoder, version int16) (OffsetCommitRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 8
+
+	message := OffsetCommitRequestBody{}
+
+	{
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return OffsetCommitRequestBody{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readInt32(decoder, "GenerationIdOrMemberEpoch")
+		if err != nil {
+			return OffsetCommitRequestBody{}, err
+		}
+
+		message.GenerationIdOrMemberEpoch = fieldValue
+	}
+
+	if version >= 1 {
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return OffsetCommitRequestBody{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 7 {
+		fieldValue, err := readNullableString(decoder, "GroupInstanceId", isFlexible)
+		if err != nil {
+			return OffsetCommitRequestBody{}, err
+		}
+
+		message.GroupInstanceId = fieldValue
+	}
+
+	if version >= 2 && version <= 4 {
+		fieldValue, err := readInt64(decoder, "RetentionTimeMs")
+		if err != nil {
+			return OffsetCommitRequestBody{}, err
+		}
+
+		message.RetentionTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetCommitRequestTopic, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetCommitRequestTopic, field_decoder.FieldDecoderError) {
+				return decodeOffsetCommitRequestTopic(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetCommitRequestBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 8 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetCommitRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetCommitRequestTopic struct {
+	// The topic name.
+	Name value.String
+	// Each partition to commit offsets for.
+	Partitions []OffsetCommitRequestPartition
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetCommitRequestTopic(message OffsetCommitRequestTopic, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 8
+
+	{
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	{
+		encodeArray(encoder, "Partitions", message.Partitions, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetCommitRequestPartition) {
+			encoder.PushPathContext(path)
+			encodeOffsetCommitRequestPartition(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetCommitRequestTopic(decoder *field_decoder.FieldDecoder, version int16) (OffsetCommitRequestTopic, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 8
+
+	message := OffsetCommitRequestTopic{}
+
+	{
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return OffsetCommitRequestTopic{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Partitions", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetCommitRequestPartition, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetCommitRequestPartition, field_decoder.FieldDecoderError) {
+				return decodeOffsetCommitRequestPartition(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetCommitRequestTopic{}, err
+		}
+
+		message.Partitions = fieldValue
+	}
+
+	if version >= 8 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetCommitRequestTopic{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetCommitRequestPartition struct {
+	// The partition index.
+	PartitionIndex value.Int32
+	// The message offset to be committed.
+	CommittedOffset value.Int64
+	// The leader epoch of this partition.
+	CommittedLeaderEpoch value.Int32
+	// The timestamp of the commit.
+	CommitTimestamp value.Int64
+	// Any associated metadata the client wants to keep.
+	CommittedMetadata value.NullableString
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetCommitRequestPartition(message OffsetCommitRequestPartition, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 8
+
+	{
+		encoder.WriteInt32Field("PartitionIndex", message.PartitionIndex)
+	}
+
+	{
+		encoder.WriteInt64Field("CommittedOffset", message.CommittedOffset)
+	}
+
+	if version >= 6 {
+		encoder.WriteInt32Field("CommittedLeaderEpoch", message.CommittedLeaderEpoch)
+	}
+
+	if version == 1 {
+		encoder.WriteInt64Field("CommitTimestamp", message.CommitTimestamp)
+	}
+
+	{
+		writeNullableString(encoder, "CommittedMetadata", message.CommittedMetadata, isFlexible)
+	}
+
+	if version >= 8 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetCommitRequestPartition(decoder *field_decoder.FieldDecoder, version int16) (OffsetCommitRequestPartition, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 8
+
+	message := OffsetCommitRequestPartition{}
+
+	{
+		fieldValue, err := readInt32(decoder, "PartitionIndex")
+		if err != nil {
+			return OffsetCommitRequestPartition{}, err
+		}
+
+		message.PartitionIndex = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt64(decoder, "CommittedOffset")
+		if err != nil {
+			return OffsetCommitRequestPartition{}, err
+		}
+
+		message.CommittedOffset = fieldValue
+	}
+
+	if version >= 6 {
+		fieldValue, err := readInt32(decoder, "CommittedLeaderEpoch")
+		if err != nil {
+			return OffsetCommitRequestPartition{}, err
+		}
+
+		message.CommittedLeaderEpoch = fieldValue
+	}
+
+	if version == 1 {
+		fieldValue, err := readInt64(decoder, "CommitTimestamp")
+		if err != nil {
+			return OffsetCommitRequestPartition{}, err
+		}
+
+		message.CommitTimestamp = fieldValue
+	}
+
+	{
+		fieldValue, err := readNullableString(decoder, "CommittedMetadata", isFlexible)
+		if err != nil {
+			return OffsetCommitRequestPartition{}, err
+		}
+
+		message.CommittedMetadata = fieldValue
+	}
+
+	if version >= 8 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetCommitRequestPartition{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,244 @@
+// Code generated by kafka_codegen from OffsetCommitResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// OffsetCommitResponse is generated from Kafka's message spec (API key 8, valid versions 0-9, flexible versions 8-9)
+type OffsetCommitResponse struct {
+	Header headers.ResponseHeader
+	Body   OffsetCommitResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r OffsetCommitResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeOffsetCommitResponseBody(r.Body, version, encoder)
+}
+
+// OffsetCommitResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func OffsetCommitResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (OffsetCommitResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (OffsetCommitResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("OffsetCommitResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 8)
+		if err != nil {
+			return OffsetCommitResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeOffsetCommitResponseBody(decoder, version)
+		if err != nil {
+			return OffsetCommitResponse{}, err
+		}
+
+		return OffsetCommitResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type OffsetCommitResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// The responses for each topic.
+	Topics []OffsetCommitResponseTopic
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetCommitResponseBody(message OffsetCommitResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 8
+
+	if version >= 3 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetCommitResponseTopic) {
+			encoder.PushPathContext(path)
+			encodeOffsetCommitResponseTopic(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetCommitResponseBody(decoder *field_decoder.FieldDecoder, version int16) (OffsetCommitResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 8
+
+	message := OffsetCommitResponseBody{}
+
+	if version >= 3 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return OffsetCommitResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetCommitResponseTopic, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetCommitResponseTopic, field_decoder.FieldDecoderError) {
+				return decodeOffsetCommitResponseTopic(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetCommitResponseBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 8 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetCommitResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetCommitResponseTopic struct {
+	// The topic name.
+	Name value.String
+	// The responses for each partition in the topic.
+	Partitions []OffsetCommitResponsePartition
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetCommitResponseTopic(message OffsetCommitResponseTopic, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 8
+
+	{
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	{
+		encodeArray(encoder, "Partitions", message.Partitions, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetCommitResponsePartition) {
+			encoder.PushPathContext(path)
+			encodeOffsetCommitResponsePartition(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetCommitResponseTopic(decoder *field_decoder.FieldDecoder, version int16) (OffsetCommitResponseTopic, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 8
+
+	message := OffsetCommitResponseTopic{}
+
+	{
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return OffsetCommitResponseTopic{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Partitions", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetCommitResponsePartition, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetCommitResponsePartition, field_decoder.FieldDecoderError) {
+				return decodeOffsetCommitResponsePartition(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetCommitResponseTopic{}, err
+		}
+
+		message.Partitions = fieldValue
+	}
+
+	if version >= 8 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetCommitResponseTopic{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetCommitResponsePartition struct {
+	// The partition index.
+	PartitionIndex value.Int32
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetCommitResponsePartition(message OffsetCommitResponsePartition, version int16, encoder *field_encoder.FieldEncoder) {
+	{
+		encoder.WriteInt32Field("PartitionIndex", message.PartitionIndex)
+	}
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 8 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetCommitResponsePartition(decoder *field_decoder.FieldDecoder, version int16) (OffsetCommitResponsePartition, field_decoder.FieldDecoderError) {
+	message := OffsetCommitResponsePartition{}
+
+	{
+		fieldValue, err := readInt32(decoder, "PartitionIndex")
+		if err != nil {
+			return OffsetCommitResponsePartition{}, err
+		}
+
+		message.PartitionIndex = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return OffsetCommitResponsePartition{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 8 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetCommitResponsePartition{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
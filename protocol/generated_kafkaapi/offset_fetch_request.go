@@ -0,0 +1,371 @@
+// Code generated by kafka_codegen from OffsetFetchRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// OffsetFetchRequest is generated from Kafka's message spec (API key 9, valid versions 0-9, flexible versions 6-9)
+type OffsetFetchRequest struct {
+	Header headers.RequestHeader
+	Body   OffsetFetchRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r OffsetFetchRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r OffsetFetchRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeOffsetFetchRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeOffsetFetchRequestBody decodes the body of a request with the given version
+func DecodeOffsetFetchRequestBody(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeOffsetFetchRequestBody(decoder, version)
+}
+
+type OffsetFetchRequestBody struct {
+	// The group to fetch offsets for.
+	GroupId value.String
+	// Each topic we would like to fetch offsets for, or null to fetch offsets for all topics.
+	Topics []OffsetFetchRequestTopic
+	// Each group we would like to fetch offsets for
+	Groups []OffsetFetchRequestGroup
+	// Whether broker should hold on returning unstable offsets but set a retriable error code for the partitions.
+	RequireStable value.Boolean
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchRequestBody(message OffsetFetchRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version <= 7 {
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	if version <= 7 {
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, version >= 2 && version <= 7, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchRequestTopic) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchRequestTopic(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		encodeArray(encoder, "Groups", message.Groups, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchRequestGroup) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchRequestGroup(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 7 {
+		encoder.WriteBooleanField("RequireStable", message.RequireStable)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchRequestBody(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchRequestBody{}
+
+	if version <= 7 {
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return OffsetFetchRequestBody{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	if version <= 7 {
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, version >= 2 && version <= 7, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchRequestTopic, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchRequestTopic, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchRequestTopic(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchRequestBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := decodeArray(decoder, "Groups", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchRequestGroup, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchRequestGroup, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchRequestGroup(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchRequestBody{}, err
+		}
+
+		message.Groups = fieldValue
+	}
+
+	if version >= 7 {
+		fieldValue, err := readBoolean(decoder, "RequireStable")
+		if err != nil {
+			return OffsetFetchRequestBody{}, err
+		}
+
+		message.RequireStable = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchRequestTopic struct {
+	// The topic name.
+	Name value.String
+	// The partition indexes we would like to fetch offsets for.
+	PartitionIndexes []value.Int32
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchRequestTopic(message OffsetFetchRequestTopic, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version <= 7 {
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version <= 7 {
+		encodeArray(encoder, "PartitionIndexes", message.PartitionIndexes, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element value.Int32) {
+			encoder.WriteInt32Field(path, element)
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchRequestTopic(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchRequestTopic, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchRequestTopic{}
+
+	if version <= 7 {
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return OffsetFetchRequestTopic{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version <= 7 {
+		fieldValue, err := decodeArray(decoder, "PartitionIndexes", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (value.Int32, field_decoder.FieldDecoderError) {
+			return readInt32(decoder, path)
+		})
+		if err != nil {
+			return OffsetFetchRequestTopic{}, err
+		}
+
+		message.PartitionIndexes = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchRequestTopic{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchRequestGroup struct {
+	// The group ID.
+	GroupId value.String
+	// The member ID assigned by the group coordinator if using the new consumer protocol (KIP-848).
+	MemberId value.NullableString
+	// The member epoch if using the new consumer protocol (KIP-848).
+	MemberEpoch value.Int32
+	// Each topic we would like to fetch offsets for, or null to fetch offsets for all topics.
+	Topics []OffsetFetchRequestTopics
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchRequestGroup(message OffsetFetchRequestGroup, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 8 {
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	if version >= 9 {
+		writeNullableString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 9 {
+		encoder.WriteInt32Field("MemberEpoch", message.MemberEpoch)
+	}
+
+	if version >= 8 {
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, version >= 8, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchRequestTopics) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchRequestTopics(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchRequestGroup(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchRequestGroup, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchRequestGroup{}
+
+	if version >= 8 {
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return OffsetFetchRequestGroup{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	if version >= 9 {
+		fieldValue, err := readNullableString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return OffsetFetchRequestGroup{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 9 {
+		fieldValue, err := readInt32(decoder, "MemberEpoch")
+		if err != nil {
+			return OffsetFetchRequestGroup{}, err
+		}
+
+		message.MemberEpoch = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, version >= 8, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchRequestTopics, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchRequestTopics, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchRequestTopics(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchRequestGroup{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchRequestGroup{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchRequestTopics struct {
+	// The topic name.
+	Name value.String
+	// The partition indexes we would like to fetch offsets for.
+	PartitionIndexes []value.Int32
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchRequestTopics(message OffsetFetchRequestTopics, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 8 {
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version >= 8 {
+		encodeArray(encoder, "PartitionIndexes", message.PartitionIndexes, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element value.Int32) {
+			encoder.WriteInt32Field(path, element)
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchRequestTopics(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchRequestTopics, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchRequestTopics{}
+
+	if version >= 8 {
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return OffsetFetchRequestTopics{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := decodeArray(decoder, "PartitionIndexes", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (value.Int32, field_decoder.FieldDecoderError) {
+			return readInt32(decoder, path)
+		})
+		if err != nil {
+			return OffsetFetchRequestTopics{}, err
+		}
+
+		message.PartitionIndexes = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchRequestTopics{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -0,0 +1,590 @@
+// Code generated by kafka_codegen from OffsetFetchResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// OffsetFetchResponse is generated from Kafka's message spec (API key 9, valid versions 0-9, flexible versions 6-9)
+type OffsetFetchResponse struct {
+	Header headers.ResponseHeader
+	Body   OffsetFetchResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r OffsetFetchResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeOffsetFetchResponseBody(r.Body, version, encoder)
+}
+
+// OffsetFetchResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func OffsetFetchResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (OffsetFetchResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (OffsetFetchResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("OffsetFetchResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 6)
+		if err != nil {
+			return OffsetFetchResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeOffsetFetchResponseBody(decoder, version)
+		if err != nil {
+			return OffsetFetchResponse{}, err
+		}
+
+		return OffsetFetchResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type OffsetFetchResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// The responses per topic.
+	Topics []OffsetFetchResponseTopic
+	// The top-level error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The responses per group id.
+	Groups []OffsetFetchResponseGroup
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchResponseBody(message OffsetFetchResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 3 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	if version <= 7 {
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchResponseTopic) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchResponseTopic(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 2 && version <= 7 {
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 8 {
+		encodeArray(encoder, "Groups", message.Groups, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchResponseGroup) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchResponseGroup(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchResponseBody(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchResponseBody{}
+
+	if version >= 3 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return OffsetFetchResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	if version <= 7 {
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchResponseTopic, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchResponseTopic, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchResponseTopic(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchResponseBody{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 2 && version <= 7 {
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return OffsetFetchResponseBody{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := decodeArray(decoder, "Groups", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchResponseGroup, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchResponseGroup, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchResponseGroup(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchResponseBody{}, err
+		}
+
+		message.Groups = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchResponseTopic struct {
+	// The topic name.
+	Name value.String
+	// The responses per partition
+	Partitions []OffsetFetchResponsePartition
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchResponseTopic(message OffsetFetchResponseTopic, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version <= 7 {
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version <= 7 {
+		encodeArray(encoder, "Partitions", message.Partitions, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchResponsePartition) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchResponsePartition(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchResponseTopic(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchResponseTopic, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchResponseTopic{}
+
+	if version <= 7 {
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return OffsetFetchResponseTopic{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version <= 7 {
+		fieldValue, err := decodeArray(decoder, "Partitions", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchResponsePartition, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchResponsePartition, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchResponsePartition(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchResponseTopic{}, err
+		}
+
+		message.Partitions = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchResponseTopic{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchResponsePartition struct {
+	// The partition index.
+	PartitionIndex value.Int32
+	// The committed message offset.
+	CommittedOffset value.Int64
+	// The leader epoch.
+	CommittedLeaderEpoch value.Int32
+	// The partition metadata.
+	Metadata value.NullableString
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchResponsePartition(message OffsetFetchResponsePartition, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version <= 7 {
+		encoder.WriteInt32Field("PartitionIndex", message.PartitionIndex)
+	}
+
+	if version <= 7 {
+		encoder.WriteInt64Field("CommittedOffset", message.CommittedOffset)
+	}
+
+	if version >= 5 && version <= 7 {
+		encoder.WriteInt32Field("CommittedLeaderEpoch", message.CommittedLeaderEpoch)
+	}
+
+	if version <= 7 {
+		writeNullableString(encoder, "Metadata", message.Metadata, isFlexible)
+	}
+
+	if version <= 7 {
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchResponsePartition(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchResponsePartition, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchResponsePartition{}
+
+	if version <= 7 {
+		fieldValue, err := readInt32(decoder, "PartitionIndex")
+		if err != nil {
+			return OffsetFetchResponsePartition{}, err
+		}
+
+		message.PartitionIndex = fieldValue
+	}
+
+	if version <= 7 {
+		fieldValue, err := readInt64(decoder, "CommittedOffset")
+		if err != nil {
+			return OffsetFetchResponsePartition{}, err
+		}
+
+		message.CommittedOffset = fieldValue
+	}
+
+	if version >= 5 && version <= 7 {
+		fieldValue, err := readInt32(decoder, "CommittedLeaderEpoch")
+		if err != nil {
+			return OffsetFetchResponsePartition{}, err
+		}
+
+		message.CommittedLeaderEpoch = fieldValue
+	}
+
+	if version <= 7 {
+		fieldValue, err := readNullableString(decoder, "Metadata", isFlexible)
+		if err != nil {
+			return OffsetFetchResponsePartition{}, err
+		}
+
+		message.Metadata = fieldValue
+	}
+
+	if version <= 7 {
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return OffsetFetchResponsePartition{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchResponsePartition{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchResponseGroup struct {
+	// The group ID.
+	GroupId value.String
+	// The responses per topic.
+	Topics []OffsetFetchResponseTopics
+	// The group-level error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchResponseGroup(message OffsetFetchResponseGroup, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 8 {
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	if version >= 8 {
+		encodeArray(encoder, "Topics", message.Topics, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchResponseTopics) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchResponseTopics(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 8 {
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchResponseGroup(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchResponseGroup, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchResponseGroup{}
+
+	if version >= 8 {
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return OffsetFetchResponseGroup{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := decodeArray(decoder, "Topics", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchResponseTopics, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchResponseTopics, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchResponseTopics(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchResponseGroup{}, err
+		}
+
+		message.Topics = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return OffsetFetchResponseGroup{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchResponseGroup{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchResponseTopics struct {
+	// The topic name.
+	Name value.String
+	// The responses per partition
+	Partitions []OffsetFetchResponsePartitions
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchResponseTopics(message OffsetFetchResponseTopics, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 8 {
+		writeString(encoder, "Name", message.Name, isFlexible)
+	}
+
+	if version >= 8 {
+		encodeArray(encoder, "Partitions", message.Partitions, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element OffsetFetchResponsePartitions) {
+			encoder.PushPathContext(path)
+			encodeOffsetFetchResponsePartitions(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchResponseTopics(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchResponseTopics, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchResponseTopics{}
+
+	if version >= 8 {
+		fieldValue, err := readString(decoder, "Name", isFlexible)
+		if err != nil {
+			return OffsetFetchResponseTopics{}, err
+		}
+
+		message.Name = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := decodeArray(decoder, "Partitions", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (OffsetFetchResponsePartitions, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (OffsetFetchResponsePartitions, field_decoder.FieldDecoderError) {
+				return decodeOffsetFetchResponsePartitions(decoder, version)
+			})
+		})
+		if err != nil {
+			return OffsetFetchResponseTopics{}, err
+		}
+
+		message.Partitions = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchResponseTopics{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type OffsetFetchResponsePartitions struct {
+	// The partition index.
+	PartitionIndex value.Int32
+	// The committed message offset.
+	CommittedOffset value.Int64
+	// The leader epoch.
+	CommittedLeaderEpoch value.Int32
+	// The partition metadata.
+	Metadata value.NullableString
+	// The partition-level error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeOffsetFetchResponsePartitions(message OffsetFetchResponsePartitions, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 6
+
+	if version >= 8 {
+		encoder.WriteInt32Field("PartitionIndex", message.PartitionIndex)
+	}
+
+	if version >= 8 {
+		encoder.WriteInt64Field("CommittedOffset", message.CommittedOffset)
+	}
+
+	if version >= 8 {
+		encoder.WriteInt32Field("CommittedLeaderEpoch", message.CommittedLeaderEpoch)
+	}
+
+	if version >= 8 {
+		writeNullableString(encoder, "Metadata", message.Metadata, isFlexible)
+	}
+
+	if version >= 8 {
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 6 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeOffsetFetchResponsePartitions(decoder *field_decoder.FieldDecoder, version int16) (OffsetFetchResponsePartitions, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 6
+
+	message := OffsetFetchResponsePartitions{}
+
+	if version >= 8 {
+		fieldValue, err := readInt32(decoder, "PartitionIndex")
+		if err != nil {
+			return OffsetFetchResponsePartitions{}, err
+		}
+
+		message.PartitionIndex = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readInt64(decoder, "CommittedOffset")
+		if err != nil {
+			return OffsetFetchResponsePartitions{}, err
+		}
+
+		message.CommittedOffset = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readInt32(decoder, "CommittedLeaderEpoch")
+		if err != nil {
+			return OffsetFetchResponsePartitions{}, err
+		}
+
+		message.CommittedLeaderEpoch = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readNullableString(decoder, "Metadata", isFlexible)
+		if err != nil {
+			return OffsetFetchResponsePartitions{}, err
+		}
+
+		message.Metadata = fieldValue
+	}
+
+	if version >= 8 {
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return OffsetFetchResponsePartitions{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 6 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return OffsetFetchResponsePartitions{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
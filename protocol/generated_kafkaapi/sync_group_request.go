@@ -0,0 +1,240 @@
+// Code generated by kafka_codegen from SyncGroupRequest.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// SyncGroupRequest is generated from Kafka's message spec (API key 14, valid versions 0-5, flexible versions 4-5)
+type SyncGroupRequest struct {
+	Header headers.RequestHeader
+	Body   SyncGroupRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r SyncGroupRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
+
+// EncodeBody encodes the body using the version in the request header
+func (r SyncGroupRequest) EncodeBody(encoder *field_encoder.FieldEncoder) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeSyncGroupRequestBody(r.Body, r.Header.ApiVersion.Value, encoder)
+}
+
+// DecodeSyncGroupRequestBody decodes the body of a request with the given version
+func DecodeSyncGroupRequestBody(decoder *field_decoder.FieldDecoder, version int16) (SyncGroupRequestBody, field_decoder.FieldDecoderError) {
+	decoder.PushPathContext("Body")
+	defer decoder.PopPathContext()
+	return decodeSyncGroupRequestBody(decoder, version)
+}
+
+type SyncGroupRequestBody struct {
+	// The unique group identifier.
+	GroupId value.String
+	// The generation of the group.
+	GenerationId value.Int32
+	// The member ID assigned by the group.
+	MemberId value.String
+	// The unique identifier of the consumer instance provided by end user.
+	GroupInstanceId value.NullableString
+	// The group protocol type.
+	ProtocolType value.NullableString
+	// The group protocol name.
+	ProtocolName value.NullableString
+	// Each assignment.
+	Assignments []SyncGroupRequestAssignment
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeSyncGroupRequestBody(message SyncGroupRequestBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	{
+		writeString(encoder, "GroupId", message.GroupId, isFlexible)
+	}
+
+	{
+		encoder.WriteInt32Field("GenerationId", message.GenerationId)
+	}
+
+	{
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	if version >= 3 {
+		writeNullableString(encoder, "GroupInstanceId", message.GroupInstanceId, isFlexible)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "ProtocolType", message.ProtocolType, isFlexible)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "ProtocolName", message.ProtocolName, isFlexible)
+	}
+
+	{
+		encodeArray(encoder, "Assignments", message.Assignments, isFlexible, false, func(encoder *field_encoder.FieldEncoder, path string, element SyncGroupRequestAssignment) {
+			encoder.PushPathContext(path)
+			encodeSyncGroupRequestAssignment(element, version, encoder)
+			encoder.PopPathContext()
+		})
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeSyncGroupRequestBody(decoder *field_decoder.FieldDecoder, version int16) (SyncGroupRequestBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := SyncGroupRequestBody{}
+
+	{
+		fieldValue, err := readString(decoder, "GroupId", isFlexible)
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.GroupId = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt32(decoder, "GenerationId")
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.GenerationId = fieldValue
+	}
+
+	{
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	if version >= 3 {
+		fieldValue, err := readNullableString(decoder, "GroupInstanceId", isFlexible)
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.GroupInstanceId = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "ProtocolType", isFlexible)
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.ProtocolType = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "ProtocolName", isFlexible)
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.ProtocolName = fieldValue
+	}
+
+	{
+		fieldValue, err := decodeArray(decoder, "Assignments", isFlexible, false, func(decoder *field_decoder.FieldDecoder, path string) (SyncGroupRequestAssignment, field_decoder.FieldDecoderError) {
+			return decodeInPathContext(decoder, path, func(decoder *field_decoder.FieldDecoder) (SyncGroupRequestAssignment, field_decoder.FieldDecoderError) {
+				return decodeSyncGroupRequestAssignment(decoder, version)
+			})
+		})
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.Assignments = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return SyncGroupRequestBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
+
+type SyncGroupRequestAssignment struct {
+	// The ID of the member to assign.
+	MemberId value.String
+	// The member assignment.
+	Assignment value.Bytes
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeSyncGroupRequestAssignment(message SyncGroupRequestAssignment, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	{
+		writeString(encoder, "MemberId", message.MemberId, isFlexible)
+	}
+
+	{
+		writeBytes(encoder, "Assignment", message.Assignment, isFlexible)
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeSyncGroupRequestAssignment(decoder *field_decoder.FieldDecoder, version int16) (SyncGroupRequestAssignment, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := SyncGroupRequestAssignment{}
+
+	{
+		fieldValue, err := readString(decoder, "MemberId", isFlexible)
+		if err != nil {
+			return SyncGroupRequestAssignment{}, err
+		}
+
+		message.MemberId = fieldValue
+	}
+
+	{
+		fieldValue, err := readBytes(decoder, "Assignment", isFlexible)
+		if err != nil {
+			return SyncGroupRequestAssignment{}, err
+		}
+
+		message.Assignment = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return SyncGroupRequestAssignment{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
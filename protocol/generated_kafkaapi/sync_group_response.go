@@ -0,0 +1,155 @@
+// Code generated by kafka_codegen from SyncGroupResponse.json. DO NOT EDIT.
+
+package generated_kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/internal/field_decoder"
+	"github.com/codecrafters-io/kafka-tester/internal/field_encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// SyncGroupResponse is generated from Kafka's message spec (API key 14, valid versions 0-5, flexible versions 4-5)
+type SyncGroupResponse struct {
+	Header headers.ResponseHeader
+	Body   SyncGroupResponseBody
+}
+
+// EncodeBody encodes the body using the given version
+func (r SyncGroupResponse) EncodeBody(encoder *field_encoder.FieldEncoder, version int16) {
+	encoder.PushPathContext("Body")
+	defer encoder.PopPathContext()
+	encodeSyncGroupResponseBody(r.Body, version, encoder)
+}
+
+// SyncGroupResponseDecoder returns a function that decodes a response with the given version, it can be used as the DecodeFunc of a ResponseAsserter
+func SyncGroupResponseDecoder(version int16) func(*field_decoder.FieldDecoder) (SyncGroupResponse, field_decoder.FieldDecoderError) {
+	return func(decoder *field_decoder.FieldDecoder) (SyncGroupResponse, field_decoder.FieldDecoderError) {
+		decoder.PushPathContext("SyncGroupResponse")
+		defer decoder.PopPathContext()
+
+		header, err := decodeResponseHeader(decoder, version >= 4)
+		if err != nil {
+			return SyncGroupResponse{}, err
+		}
+
+		decoder.PushPathContext("Body")
+		defer decoder.PopPathContext()
+
+		body, err := decodeSyncGroupResponseBody(decoder, version)
+		if err != nil {
+			return SyncGroupResponse{}, err
+		}
+
+		return SyncGroupResponse{
+			Header: header,
+			Body:   body,
+		}, nil
+	}
+}
+
+type SyncGroupResponseBody struct {
+	// The duration in milliseconds for which the request was throttled due to a quota violation, or zero if the request did not violate any quota.
+	ThrottleTimeMs value.Int32
+	// The error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// The group protocol type.
+	ProtocolType value.NullableString
+	// The group protocol name.
+	ProtocolName value.NullableString
+	// The member assignment.
+	Assignment value.Bytes
+	// UnknownTaggedFields are tagged fields that aren't in the spec, they are preserved as raw bytes
+	UnknownTaggedFields value.TaggedFields
+}
+
+func encodeSyncGroupResponseBody(message SyncGroupResponseBody, version int16, encoder *field_encoder.FieldEncoder) {
+	isFlexible := version >= 4
+
+	if version >= 1 {
+		encoder.WriteInt32Field("ThrottleTimeMs", message.ThrottleTimeMs)
+	}
+
+	{
+		encoder.WriteInt16Field("ErrorCode", message.ErrorCode)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "ProtocolType", message.ProtocolType, isFlexible)
+	}
+
+	if version >= 5 {
+		writeNullableString(encoder, "ProtocolName", message.ProtocolName, isFlexible)
+	}
+
+	{
+		writeBytes(encoder, "Assignment", message.Assignment, isFlexible)
+	}
+
+	if version >= 4 {
+		taggedFields := []value.TaggedField{}
+		writeTaggedFields(encoder, taggedFields, message.UnknownTaggedFields)
+	}
+}
+
+func decodeSyncGroupResponseBody(decoder *field_decoder.FieldDecoder, version int16) (SyncGroupResponseBody, field_decoder.FieldDecoderError) {
+	isFlexible := version >= 4
+
+	message := SyncGroupResponseBody{}
+
+	if version >= 1 {
+		fieldValue, err := readInt32(decoder, "ThrottleTimeMs")
+		if err != nil {
+			return SyncGroupResponseBody{}, err
+		}
+
+		message.ThrottleTimeMs = fieldValue
+	}
+
+	{
+		fieldValue, err := readInt16(decoder, "ErrorCode")
+		if err != nil {
+			return SyncGroupResponseBody{}, err
+		}
+
+		message.ErrorCode = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "ProtocolType", isFlexible)
+		if err != nil {
+			return SyncGroupResponseBody{}, err
+		}
+
+		message.ProtocolType = fieldValue
+	}
+
+	if version >= 5 {
+		fieldValue, err := readNullableString(decoder, "ProtocolName", isFlexible)
+		if err != nil {
+			return SyncGroupResponseBody{}, err
+		}
+
+		message.ProtocolName = fieldValue
+	}
+
+	{
+		fieldValue, err := readBytes(decoder, "Assignment", isFlexible)
+		if err != nil {
+			return SyncGroupResponseBody{}, err
+		}
+
+		message.Assignment = fieldValue
+	}
+
+	if version >= 4 {
+		unknownTaggedFields, err := decoder.ReadTaggedFieldsField(nil)
+		if err != nil {
+			return SyncGroupResponseBody{}, err
+		}
+
+		message.UnknownTaggedFields = unknownTaggedFields
+	}
+
+	return message, nil
+}
@@ -1,12 +1,14 @@
 package kafka_client
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/tls"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"net"
-	"syscall"
 	"time"
 
 	"github.com/codecrafters-io/kafka-tester/internal/kafka_executable"
@@ -17,6 +19,9 @@ import (
 type Response struct {
 	RawBytes []byte
 	Payload  []byte
+
+	// Timing records when the request was sent and when the response arrived
+	Timing RequestTiming
 }
 
 func (r *Response) createFrom(rawBytes []byte) Response {
@@ -41,20 +46,91 @@ type KafkaClientCallbacks struct {
 	AfterConnectRetriesExceeded func(addr string)
 }
 
+// DefaultResponseTimeout is how long Receive waits for a complete response unless the client is configured otherwise.
+const DefaultResponseTimeout = 5 * time.Second
+
+// tlsHandshakeTimeout is how long ConnectWithRetries waits for the TLS handshake to complete.
+const tlsHandshakeTimeout = 5 * time.Second
+
+// unframedResponseIdleTimeout is how long we wait for more bytes when the message size can't be trusted.
+const unframedResponseIdleTimeout = 100 * time.Millisecond
+
+// leftoverBytesProbeTimeout bounds the read that checks for bytes left over from the previous response before a request
+// is sent. The read returns immediately if bytes have already arrived, so this is only spent when there are none.
+const leftoverBytesProbeTimeout = time.Millisecond
+
 // Client represents a single connection to the Kafka broker.
 type Client struct {
 	Addr      string
 	Conn      net.Conn
 	Callbacks KafkaClientCallbacks
+
+	// ResponseTimeout is the deadline for receiving a complete response, measured from when Receive is called.
+	ResponseTimeout time.Duration
+
+	// IgnoreMessageSize makes Receive read until the connection goes idle instead of trusting message_size.
+	// This is only meant for the early stages, where message_size is allowed to be any value.
+	IgnoreMessageSize bool
+
+	// Fragmentation, if set, makes Send split each request into small chunks instead of writing it in one call.
+	Fragmentation *Fragmentation
+
+	// TLSConfig, if set, makes ConnectWithRetries perform a TLS handshake after the TCP connection is established.
+	TLSConfig *tls.Config
+
+	reader *bufio.Reader
+
+	// inFlightRequests are requests that have been sent, but whose responses haven't been received yet (oldest first)
+	inFlightRequests []inFlightRequest
+
+	// receivedResponseCount is the number of responses received on this connection, used to explain connection errors
+	receivedResponseCount int
+
+	// lastResponseBytes is the last response received while no other request was in flight, bytes that arrive after it
+	// (and before the next request is sent) can't belong to any other response
+	lastResponseBytes []byte
+}
+
+// Fragmentation configures how Send splits a request into chunks.
+type Fragmentation struct {
+	ChunkSize          int
+	DelayBetweenChunks time.Duration
+}
+
+type inFlightRequest struct {
+	correlationId int32
+	apiName       string
+	sentAt        time.Time
+}
+
+// PipelinedRequest is a single request sent using SendAndReceivePipelined.
+type PipelinedRequest struct {
+	Message []byte
+	ApiKey  int16
 }
 
 // NewFromAddr creates and returns a Client targeting the given host:port address.
 // This does not attempt to actually connect, you have to call Open() for that.
 func NewFromAddr(addr string, callbacks KafkaClientCallbacks) *Client {
 	return &Client{
-		Addr:      addr,
-		Callbacks: callbacks,
+		Addr:            addr,
+		Callbacks:       callbacks,
+		ResponseTimeout: DefaultResponseTimeout,
+	}
+}
+
+func (c *Client) performTLSHandshake(conn net.Conn) (net.Conn, error) {
+	tlsConn := tls.Client(conn, c.TLSConfig)
+
+	tlsConn.SetDeadline(time.Now().Add(tlsHandshakeTimeout))
+	defer tlsConn.SetDeadline(time.Time{})
+
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, &TLSHandshakeError{Addr: c.Addr, Err: err}
 	}
+
+	return tlsConn, nil
 }
 
 func (c *Client) ConnectWithRetries(executable *kafka_executable.KafkaExecutable, logger *logger.Logger) error {
@@ -91,7 +167,17 @@ func (c *Client) ConnectWithRetries(executable *kafka_executable.KafkaExecutable
 		}
 	}
 
+	if c.TLSConfig != nil {
+		tlsConn, err := c.performTLSHandshake(conn)
+		if err != nil {
+			return err
+		}
+
+		conn = tlsConn
+	}
+
 	c.Conn = conn
+	c.reader = bufio.NewReader(conn)
 	c.Callbacks.AfterConnected(c.Addr)
 
 	return nil
@@ -121,9 +207,139 @@ func (c *Client) SendAndReceive(message []byte, apiKey int16, stageLogger *logge
 	return response, nil
 }
 
+// SendAndReceivePipelined sends all requests back-to-back before reading any response,
+// and then receives the responses in the order the requests were sent.
+func (c *Client) SendAndReceivePipelined(requests []PipelinedRequest, stageLogger *logger.Logger) ([]Response, error) {
+	for _, request := range requests {
+		if err := c.Send(request.Message, utils.APIKeyToName(request.ApiKey), stageLogger); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.ReceiveInFlight(stageLogger)
+}
+
+// ReceiveInFlight receives a response for every request that has been sent but not yet received.
+//
+// Brokers must respond to requests on a connection in the order they were sent, so each response is
+// matched to its request by correlation ID. Responses that arrive out of order or are missing are reported as errors.
+func (c *Client) ReceiveInFlight(stageLogger *logger.Logger) ([]Response, error) {
+	expectedRequests := make([]inFlightRequest, len(c.inFlightRequests))
+	copy(expectedRequests, c.inFlightRequests)
+
+	responses := []Response{}
+
+	for i, expectedRequest := range expectedRequests {
+		response, err := c.Receive(expectedRequest.apiName, stageLogger)
+		if err != nil {
+			var framingError *FramingError
+			var connectionError *ConnectionError
+			if errors.As(err, &framingError) || errors.As(err, &connectionError) {
+				return responses, err
+			}
+
+			return responses, fmt.Errorf("Expected %d responses, only received %d (%v)", len(expectedRequests), i, err)
+		}
+
+		if len(response.Payload) < 4 {
+			return responses, fmt.Errorf("Expected response #%d to start with a correlation ID, only found %d bytes", i+1, len(response.Payload))
+		}
+
+		actualCorrelationId := int32(binary.BigEndian.Uint32(response.Payload[:4]))
+
+		if actualCorrelationId != expectedRequest.correlationId {
+			for j, otherRequest := range expectedRequests {
+				if otherRequest.correlationId == actualCorrelationId {
+					return responses, fmt.Errorf("Expected response #%d to have correlation ID %d, got %d (correlation ID of request #%d). Responses must be sent in the order requests were received", i+1, expectedRequest.correlationId, actualCorrelationId, j+1)
+				}
+			}
+
+			return responses, fmt.Errorf("Expected response #%d to have correlation ID %d, got %d which doesn't match any in-flight request", i+1, expectedRequest.correlationId, actualCorrelationId)
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
 func (c *Client) Send(message []byte, apiName string, stageLogger *logger.Logger) error {
+	if err := c.checkForLeftoverBytes(stageLogger); err != nil {
+		return err
+	}
+
 	c.Callbacks.BeforeMessageSend(message, apiName)
 
+	var err error
+	if c.Fragmentation != nil {
+		err = c.writeFragmented(message)
+	} else {
+		err = c.write(message)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.inFlightRequests = append(c.inFlightRequests, inFlightRequest{
+		correlationId: getCorrelationId(message),
+		apiName:       apiName,
+		sentAt:        time.Now(),
+	})
+
+	return nil
+}
+
+// SendCoalesced writes all requests to the connection using a single Write call,
+// so that the broker is likely to receive multiple requests in a single read.
+func (c *Client) SendCoalesced(requests []PipelinedRequest, stageLogger *logger.Logger) error {
+	if err := c.checkForLeftoverBytes(stageLogger); err != nil {
+		return err
+	}
+
+	var coalescedMessage bytes.Buffer
+
+	for _, request := range requests {
+		c.Callbacks.BeforeMessageSend(request.Message, utils.APIKeyToName(request.ApiKey))
+		coalescedMessage.Write(request.Message)
+	}
+
+	if err := c.write(coalescedMessage.Bytes()); err != nil {
+		return err
+	}
+
+	sentAt := time.Now()
+
+	for _, request := range requests {
+		c.inFlightRequests = append(c.inFlightRequests, inFlightRequest{
+			correlationId: getCorrelationId(request.Message),
+			apiName:       utils.APIKeyToName(request.ApiKey),
+			sentAt:        sentAt,
+		})
+	}
+
+	return nil
+}
+
+// writeFragmented writes the message in chunks of Fragmentation.ChunkSize bytes, waiting
+// Fragmentation.DelayBetweenChunks between each write.
+func (c *Client) writeFragmented(message []byte) error {
+	for start := 0; start < len(message); start += c.Fragmentation.ChunkSize {
+		if start > 0 {
+			time.Sleep(c.Fragmentation.DelayBetweenChunks)
+		}
+
+		end := min(start+c.Fragmentation.ChunkSize, len(message))
+
+		if err := c.write(message[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) write(message []byte) error {
 	// Set a deadline for the write operation
 	err := c.Conn.SetWriteDeadline(time.Now().Add(100 * time.Millisecond))
 	if err != nil {
@@ -140,63 +356,222 @@ func (c *Client) Send(message []byte, apiName string, stageLogger *logger.Logger
 			return fmt.Errorf("write operation timed out")
 		}
 
-		return fmt.Errorf("error writing to connection: %v", err)
+		return fmt.Errorf("error writing to connection: %w", err)
 	}
 
 	return nil
 }
 
 func (c *Client) Receive(apiName string, stageLogger *logger.Logger) (response Response, err error) {
+	return c.ReceiveWithTimeout(apiName, c.ResponseTimeout, stageLogger)
+}
+
+// ReceiveWithTimeout reads a single length-prefixed response from the connection.
+//
+// Exactly message_size bytes are read after the 4-byte size field. If the connection closes (or the timeout
+// expires) before that, or if extra bytes are found after the message, a FramingError is returned.
+func (c *Client) ReceiveWithTimeout(apiName string, timeout time.Duration, stageLogger *logger.Logger) (response Response, err error) {
 	defer func() {
 		// Reset connection read deadline
 		c.Conn.SetReadDeadline(time.Time{})
 
-		// If response was successfully received, invoke callback
-		if err == nil {
+		// If response was received (even if it was framed incorrectly), invoke callback
+		var framingError *FramingError
+		if err == nil || errors.As(err, &framingError) {
 			c.Callbacks.AfterResponseReceived(response, apiName)
 		}
+
+		if errors.As(err, &framingError) {
+			framingError.logHexdump(stageLogger)
+		}
+	}()
+
+	var request inFlightRequest
+	if len(c.inFlightRequests) > 0 {
+		request = c.inFlightRequests[0]
+		c.inFlightRequests = c.inFlightRequests[1:]
+	}
+
+	var firstByteAt time.Time
+
+	defer func() {
+		if len(response.RawBytes) > 0 {
+			response.Timing.SentAt = request.sentAt
+			if response.Timing.FirstByteAt.IsZero() {
+				response.Timing.FirstByteAt = firstByteAt
+			}
+			if response.Timing.LastByteAt.IsZero() {
+				response.Timing.LastByteAt = time.Now()
+			}
+		}
+
+		if err == nil {
+			c.receivedResponseCount++
+		}
 	}()
 
+	if c.IgnoreMessageSize {
+		return c.receiveIgnoringMessageSize(apiName, timeout)
+	}
+
+	if err := c.Conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return response, fmt.Errorf("failed to set read deadline: %v", err)
+	}
+
+	messageSizeBytes := make([]byte, 4)
+	n, err := io.ReadFull(c.reader, messageSizeBytes)
+	if n > 0 {
+		firstByteAt = time.Now()
+	}
+
+	if err != nil {
+		if n == 0 {
+			return response, newConnectionErrorBeforeResponse(apiName, err, c.receivedResponseCount, timeout)
+		}
+
+		response = response.createFrom(messageSizeBytes[:n])
+		return response, &FramingError{
+			Message:       fmt.Sprintf("Expected 4-byte integer (message size), only found %d bytes", n),
+			ReceivedBytes: response.RawBytes,
+			StartOffset:   n,
+			EndOffset:     n,
+		}
+	}
+
+	messageSize := int32(binary.BigEndian.Uint32(messageSizeBytes))
+	if messageSize < 0 {
+		response = response.createFrom(messageSizeBytes)
+		return response, &FramingError{
+			Message:       fmt.Sprintf("Expected message size to be a non-negative integer, got %d", messageSize),
+			ReceivedBytes: response.RawBytes,
+			StartOffset:   0,
+			EndOffset:     3,
+		}
+	}
+
+	if messageSize > MaxMessageSize {
+		response = response.createFrom(messageSizeBytes)
+		return response, &FramingError{
+			Message:       fmt.Sprintf("Expected message size to be at most %d bytes, got %d", MaxMessageSize, messageSize),
+			ReceivedBytes: response.RawBytes,
+			StartOffset:   0,
+			EndOffset:     3,
+		}
+	}
+
+	message, err := AppendMessageBody(messageSizeBytes, c.reader, int(messageSize))
+	if err != nil {
+		response = response.createFrom(message)
+		return response, newMissingBytesFramingError(response.RawBytes, messageSize, err, timeout)
+	}
+
+	response = response.createFrom(message)
+
+	// If no other request is in flight, bytes after the message can't belong to any other response. Only bytes that were
+	// read along with the message are checked here, bytes that arrive later are found before the next request is sent.
+	if len(c.inFlightRequests) == 0 {
+		if trailingBytes := c.readBufferedBytes(); len(trailingBytes) > 0 {
+			response.RawBytes = append(response.RawBytes, trailingBytes...)
+			return response, newTrailingBytesFramingError(message, trailingBytes)
+		}
+
+		c.lastResponseBytes = message
+	}
+
+	return response, nil
+}
+
+// checkForLeftoverBytes returns a FramingError if bytes arrived after the last response, while no request was in flight
+func (c *Client) checkForLeftoverBytes(stageLogger *logger.Logger) error {
+	lastResponseBytes := c.lastResponseBytes
+	c.lastResponseBytes = nil
+
+	if lastResponseBytes == nil || len(c.inFlightRequests) > 0 {
+		return nil
+	}
+
+	if err := c.Conn.SetReadDeadline(time.Now().Add(leftoverBytesProbeTimeout)); err != nil {
+		return nil
+	}
+
+	defer c.Conn.SetReadDeadline(time.Time{})
+
+	// Errors are ignored, a closed connection is reported when the next response is read
+	if _, err := c.reader.Peek(1); err != nil {
+		return nil
+	}
+
+	framingError := newTrailingBytesFramingError(lastResponseBytes, c.readBufferedBytes())
+	framingError.logHexdump(stageLogger)
+
+	return framingError
+}
+
+// readBufferedBytes returns the bytes that have been read from the connection but not consumed, and consumes them
+func (c *Client) readBufferedBytes() []byte {
+	buffered, _ := c.reader.Peek(c.reader.Buffered())
+	bufferedBytes := make([]byte, len(buffered))
+	copy(bufferedBytes, buffered)
+	c.reader.Discard(len(bufferedBytes))
+
+	return bufferedBytes
+}
+
+// receiveIgnoringMessageSize is used by stages where message_size is allowed to be any value.
+// Since the response can't be framed, we read everything that arrives until the connection goes idle, or is closed
+// after the response has been sent.
+func (c *Client) receiveIgnoringMessageSize(apiName string, timeout time.Duration) (response Response, err error) {
 	var entireMessage bytes.Buffer
 
-	// We wait for the initial bytes outside of the loop because in some stages, Kafka is not guaranteed to send response within the
-	// usual deadline used below (100MS)
-	lengthResponse := make([]byte, 4)
-	n, err := c.Conn.Read(lengthResponse)
+	// We wait for the initial bytes without a short deadline because Kafka is not guaranteed to
+	// respond within the idle window used below
+	if err := c.Conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return response, fmt.Errorf("failed to set read deadline: %v", err)
+	}
+
+	firstByte, err := c.reader.ReadByte()
 	if err != nil {
-		return response, fmt.Errorf("error reading from connection: %v", err)
+		return response, newConnectionErrorBeforeResponse(apiName, err, c.receivedResponseCount, timeout)
 	}
 
-	entireMessage.Write(lengthResponse[:n])
+	firstByteAt := time.Now()
+	lastByteAt := firstByteAt
 
-	// Set a time of 100MS for connection deadline
-	err = c.Conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	entireMessage.WriteByte(firstByte)
 
 	for {
+		if err := c.Conn.SetReadDeadline(time.Now().Add(unframedResponseIdleTimeout)); err != nil {
+			return response, fmt.Errorf("failed to set read deadline: %v", err)
+		}
+
 		tempBuffer := make([]byte, 4096)
-		n, err := c.Conn.Read(tempBuffer)
+		n, err := c.reader.Read(tempBuffer)
 
 		if n > 0 {
 			entireMessage.Write(tempBuffer[:n])
+			lastByteAt = time.Now()
 		}
 
 		if err != nil {
-			// All message has been read
-			if err == io.EOF || errors.Is(err, syscall.ECONNRESET) {
-				return response.createFrom(entireMessage.Bytes()), nil
+			// The response can't be framed, so the connection ending after some bytes were received ends the response
+			if isConnectionClosedError(err) || isConnectionResetError(err) || isTimeoutError(err) {
+				response = response.createFrom(entireMessage.Bytes())
+				response.Timing.FirstByteAt = firstByteAt
+				response.Timing.LastByteAt = lastByteAt
+				return response, nil
 			}
 
-			// Connection deadline has been reached, we have read all the response there is.
-			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
-
-				// In tcp stream, we cannot guarantee when all the message will be received.
-				// We could have stopped at reading 'messagelgnth' bytes like the previous approach
-				// But we also need to check for cases where the user's implementation may send extra bytes
-				// So, we wait for 100MS and read everything that's available.
-				// Any errors will be surfaced later by assertions.
-				return response.createFrom(entireMessage.Bytes()), nil
-			}
 			return response, fmt.Errorf("error reading from connection: %v", err)
 		}
 	}
 }
+
+// getCorrelationId returns the correlation ID from an encoded request.
+// Every request header starts with message_size (4 bytes), api_key (2 bytes), api_version (2 bytes) and correlation_id (4 bytes).
+func getCorrelationId(message []byte) int32 {
+	if len(message) < 12 {
+		return 0
+	}
+
+	return int32(binary.BigEndian.Uint32(message[8:12]))
+}
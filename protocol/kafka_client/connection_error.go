@@ -0,0 +1,111 @@
+package kafka_client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"time"
+)
+
+// ConnectionErrorKind is how the connection ended before a response was received.
+type ConnectionErrorKind int
+
+const (
+	ConnectionClosed ConnectionErrorKind = iota
+	ConnectionReset
+	ConnectionTimedOut
+	// ConnectionTLSAlert means the broker sent a TLS alert, for eg. after rejecting a client certificate with TLS 1.3
+	ConnectionTLSAlert
+)
+
+// ConnectionError is returned by Receive when the connection ends before any bytes of a response are received.
+type ConnectionError struct {
+	Kind    ConnectionErrorKind
+	Message string
+	Hint    string
+}
+
+func (e *ConnectionError) Error() string {
+	if e.Hint == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s\nHint: %s", e.Message, e.Hint)
+}
+
+func isConnectionClosedError(err error) bool {
+	return err == io.EOF || err == io.ErrUnexpectedEOF
+}
+
+func isConnectionResetError(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET)
+}
+
+func isTimeoutError(err error) bool {
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
+
+func isTLSAlertError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "remote error"
+}
+
+// TLSHandshakeError is returned by ConnectWithRetries when the TLS handshake with the broker fails.
+type TLSHandshakeError struct {
+	Addr string
+	Err  error
+}
+
+func (e *TLSHandshakeError) Error() string {
+	return fmt.Sprintf("TLS handshake with broker at %s failed: %v", e.Addr, e.Err)
+}
+
+func (e *TLSHandshakeError) Unwrap() error {
+	return e.Err
+}
+
+// TimedOut is true if the broker didn't complete the handshake in time, instead of rejecting it.
+func (e *TLSHandshakeError) TimedOut() bool {
+	var netErr net.Error
+	return errors.As(e.Err, &netErr) && netErr.Timeout()
+}
+
+// newConnectionErrorBeforeResponse explains why no bytes of a response were received.
+// receivedResponseCount is the number of responses received on this connection before this one.
+func newConnectionErrorBeforeResponse(apiName string, readErr error, receivedResponseCount int, timeout time.Duration) error {
+	switch {
+	case isConnectionClosedError(readErr) && receivedResponseCount > 0:
+		return &ConnectionError{
+			Kind:    ConnectionClosed,
+			Message: fmt.Sprintf("Expected a response to the %s request, but the connection was closed after responding to %d previous request(s)", apiName, receivedResponseCount),
+			Hint:    "Clients send multiple requests over the same connection. Keep the connection open after sending a response, until the client closes it.",
+		}
+	case isConnectionClosedError(readErr):
+		return &ConnectionError{
+			Kind:    ConnectionClosed,
+			Message: fmt.Sprintf("Expected a response to the %s request, but the connection was closed before any bytes were received", apiName),
+			Hint:    "Make sure your program writes the response before closing the connection, and that it didn't crash while handling the request.",
+		}
+	case isConnectionResetError(readErr):
+		return &ConnectionError{
+			Kind:    ConnectionReset,
+			Message: fmt.Sprintf("Expected a response to the %s request, but the connection was reset before any bytes were received", apiName),
+			Hint:    "A connection is reset if it's closed while unread request bytes are still buffered, or if your program crashed.",
+		}
+	case isTimeoutError(readErr):
+		return &ConnectionError{
+			Kind:    ConnectionTimedOut,
+			Message: fmt.Sprintf("Expected a response to the %s request within %s, but no bytes were received", apiName, timeout),
+		}
+	case isTLSAlertError(readErr):
+		return &ConnectionError{
+			Kind:    ConnectionTLSAlert,
+			Message: fmt.Sprintf("Expected a response to the %s request, but the broker sent a TLS alert (%v)", apiName, readErr),
+		}
+	default:
+		return fmt.Errorf("error reading from connection: %v", readErr)
+	}
+}
@@ -0,0 +1,73 @@
+package kafka_client
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/codecrafters-io/kafka-tester/internal/inspectable_hex_dump"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+// FramingError is returned by Receive when the bytes received don't match the message_size of the response, and by Send
+// when bytes arrived after the previous response.
+type FramingError struct {
+	Message       string
+	ReceivedBytes []byte
+
+	// StartOffset and EndOffset point to the bytes that are highlighted in the hexdump
+	StartOffset int
+	EndOffset   int
+}
+
+func (e *FramingError) Error() string {
+	return e.Message
+}
+
+func (e *FramingError) logHexdump(logger *logger.Logger) {
+	if len(e.ReceivedBytes) == 0 {
+		return
+	}
+
+	logger.Errorln("Received bytes:")
+	logger.Errorln(inspectable_hex_dump.NewInspectableHexDump(e.ReceivedBytes).FormatWithHighlightedRange(e.StartOffset, e.EndOffset))
+}
+
+func newMissingBytesFramingError(receivedBytes []byte, messageSize int32, readErr error, timeout time.Duration) *FramingError {
+	receivedMessageSize := len(receivedBytes) - 4
+
+	reason := fmt.Sprintf("error reading from connection: %v", readErr)
+	if isConnectionClosedError(readErr) {
+		reason = "the connection was closed"
+	} else if isConnectionResetError(readErr) {
+		reason = "the connection was reset"
+	} else if isTimeoutError(readErr) {
+		reason = fmt.Sprintf("no more bytes were received within %s", timeout)
+	}
+
+	message := fmt.Sprintf("Expected message to be %d bytes long (message size), only found %d bytes before %s", messageSize, receivedMessageSize, reason)
+
+	if int(messageSize) == receivedMessageSize+4 {
+		message += "\nHint: The Message Size field should not count itself."
+	} else if isConnectionResetError(readErr) {
+		message += "\nHint: The connection was reset while the response was being sent. This happens if the connection is closed while unread request bytes are still buffered."
+	}
+
+	return &FramingError{
+		Message:       message,
+		ReceivedBytes: receivedBytes,
+		StartOffset:   0,
+		EndOffset:     3,
+	}
+}
+
+func newTrailingBytesFramingError(message []byte, trailingBytes []byte) *FramingError {
+	receivedBytes := append(slices.Clone(message), trailingBytes...)
+
+	return &FramingError{
+		Message:       fmt.Sprintf("Expected message to be %d bytes long (message size), found %d extra bytes after it", len(message)-4, len(trailingBytes)),
+		ReceivedBytes: receivedBytes,
+		StartOffset:   len(message),
+		EndOffset:     len(receivedBytes) - 1,
+	}
+}
@@ -0,0 +1,33 @@
+package kafka_client
+
+import (
+	"io"
+	"slices"
+)
+
+// MaxMessageSize is the largest message_size accepted in a response. It matches the default socket.request.max.bytes
+// of Kafka brokers (100 MiB), no response to a request sent by the tester comes close to it.
+const MaxMessageSize = 100 * 1024 * 1024
+
+// messageReadChunkSize bounds how much memory is allocated ahead of the bytes that have actually been received.
+const messageReadChunkSize = 64 * 1024
+
+// AppendMessageBody reads messageSize bytes from src in chunks and appends them to dst, so that a wrong message_size
+// doesn't make us allocate memory for bytes that never arrive. If src ends early, dst is returned with the bytes read
+// so far, along with the error.
+func AppendMessageBody(dst []byte, src io.Reader, messageSize int) ([]byte, error) {
+	for remaining := messageSize; remaining > 0; {
+		chunkSize := min(remaining, messageReadChunkSize)
+		dst = slices.Grow(dst, chunkSize)
+
+		n, err := io.ReadFull(src, dst[len(dst):len(dst)+chunkSize])
+		dst = dst[:len(dst)+n]
+		remaining -= n
+
+		if err != nil {
+			return dst, err
+		}
+	}
+
+	return dst, nil
+}
@@ -0,0 +1,20 @@
+package kafka_client
+
+import "time"
+
+// RequestTiming records when a request was sent, and when the first and last bytes of its response were received.
+type RequestTiming struct {
+	SentAt      time.Time
+	FirstByteAt time.Time
+	LastByteAt  time.Time
+}
+
+// TimeToFirstByte is the time between the request being sent and the first byte of the response arriving.
+func (t RequestTiming) TimeToFirstByte() time.Duration {
+	return t.FirstByteAt.Sub(t.SentAt)
+}
+
+// TimeToLastByte is the time between the request being sent and the entire response arriving.
+func (t RequestTiming) TimeToLastByte() time.Duration {
+	return t.LastByteAt.Sub(t.SentAt)
+}
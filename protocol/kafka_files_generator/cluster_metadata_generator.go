@@ -11,12 +11,14 @@ import (
 )
 
 type ClusterMetadataGenerator struct {
-	generatedTopicsData []*GeneratedTopicData
+	generatedTopicsData        []*GeneratedTopicData
+	userScramCredentialRecords []kafkaapi.UserScramCredentialRecord
 }
 
-func NewClusterMetadataGenerator(generatedTopicsData []*GeneratedTopicData) *ClusterMetadataGenerator {
+func NewClusterMetadataGenerator(generatedTopicsData []*GeneratedTopicData, userScramCredentialRecords []kafkaapi.UserScramCredentialRecord) *ClusterMetadataGenerator {
 	return &ClusterMetadataGenerator{
-		generatedTopicsData: generatedTopicsData,
+		generatedTopicsData:        generatedTopicsData,
+		userScramCredentialRecords: userScramCredentialRecords,
 	}
 }
 
@@ -73,6 +75,12 @@ func (g *ClusterMetadataGenerator) writeLogFile() error {
 	recordBatches = append(recordBatches, recordBatch1)
 	baseOffset += int64(len(recordBatch1.Records))
 
+	if len(g.userScramCredentialRecords) > 0 {
+		recordBatch := g.getUserScramCredentialsRecordBatch(baseOffset)
+		recordBatches = append(recordBatches, recordBatch)
+		baseOffset += int64(len(recordBatch.Records))
+	}
+
 	// Process each topic and its partitions
 	for _, topicData := range g.generatedTopicsData {
 		// Create topic record
@@ -96,11 +104,11 @@ func (g *ClusterMetadataGenerator) writeLogFile() error {
 				Data: &kafkaapi.PartitionRecord{
 					PartitionId:      int32(generatedRecordBatchByPartition.PartitionId),
 					TopicUUID:        topicData.UUID,
-					Replicas:         []int32{1},
-					ISReplicas:       []int32{1},
+					Replicas:         []int32{NODE_ID},
+					ISReplicas:       []int32{NODE_ID},
 					RemovingReplicas: []int32{},
 					AddingReplicas:   []int32{},
-					Leader:           1,
+					Leader:           NODE_ID,
 					LeaderEpoch:      0,
 					PartitionEpoch:   0,
 					DirectoryUUIDs:   []string{DIRECTORY_UUID},
@@ -170,6 +178,45 @@ func (g *ClusterMetadataGenerator) writeLogFile() error {
 	return nil
 }
 
+func (g *ClusterMetadataGenerator) getUserScramCredentialsRecordBatch(baseOffset int64) kafkaapi.RecordBatch {
+	var records []kafkaapi.Record
+
+	for _, userScramCredentialRecord := range g.userScramCredentialRecords {
+		payload := kafkaapi.ClusterMetadataPayload{
+			FrameVersion: 1,
+			Type:         11,
+			Version:      0,
+			Data:         &userScramCredentialRecord,
+		}
+
+		records = append(records, kafkaapi.Record{
+			Attributes:     value.Int8{Value: 0},
+			TimestampDelta: value.Varint{Value: 0},
+			OffsetDelta:    value.Varint{Value: 0},
+			Key:            value.RawBytes{},
+			Value:          value.RawBytes{Value: GetEncodedBytes(payload)},
+			Headers:        []kafkaapi.RecordHeader{},
+		})
+	}
+
+	recordBatch := kafkaapi.RecordBatch{
+		BaseOffset:           value.Int64{Value: baseOffset},
+		PartitionLeaderEpoch: value.Int32{Value: 1},
+		Magic:                value.Int8{Value: 2},
+		Attributes:           value.Int16{Value: 0},
+		LastOffsetDelta:      value.Int32{Value: int32(len(records) - 1)},
+		FirstTimestamp:       value.Int64{Value: 1726045950000},
+		MaxTimestamp:         value.Int64{Value: 1726045950000},
+		ProducerId:           value.Int64{Value: -1},
+		ProducerEpoch:        value.Int16{Value: -1},
+		BaseSequence:         value.Int32{Value: -1},
+		Records:              records,
+	}
+
+	recordBatch.SetCRC()
+	return recordBatch
+}
+
 func (g *ClusterMetadataGenerator) writePartitionMetadata() error {
 	content := fmt.Sprintf("version: %d\ntopic_id: %s", 0, CLUSTER_METADATA_TOPIC_ID)
 	metadataFilePath := path.Join(KRAFT_LOG_DIRECTORY, CLUSTER_METADATA_DIRECTORY, PARTITION_METADATA_FILE_NAME)
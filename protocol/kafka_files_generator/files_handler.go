@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
@@ -29,8 +30,13 @@ type GeneratedLogDirectoryData struct {
 
 // FilesHandler allows creation of multiple topics/partitions at once
 type FilesHandler struct {
+	listenerAddress              ListenerAddress
 	logDirectoryGenerationConfig *LogDirectoryGenerationConfig
 	generatedLogDirectoryData    *GeneratedLogDirectoryData
+	tlsGenerationConfig          *TLSGenerationConfig
+	generatedTLSData             *GeneratedTLSData
+	saslGenerationConfig         *SASLGenerationConfig
+	generatedSASLData            *GeneratedSASLData
 	logger                       *logger.Logger
 }
 
@@ -38,10 +44,36 @@ func NewFilesHandler(logger *logger.Logger) *FilesHandler {
 	filesHandlerLogger := logger.Clone()
 	filesHandlerLogger.UpdateLastSecondaryPrefix("Files Handler")
 	return &FilesHandler{
-		logger: filesHandlerLogger,
+		listenerAddress: newLocalListenerAddress(DEFAULT_LISTENER_PORT),
+		logger:          filesHandlerLogger,
 	}
 }
 
+// GetListenerAddress returns the address of the PLAINTEXT listener written to server.properties
+func (f *FilesHandler) GetListenerAddress() ListenerAddress {
+	return f.listenerAddress
+}
+
+// WithListenerPort changes the port of the PLAINTEXT listener (DEFAULT_LISTENER_PORT by default)
+func (f *FilesHandler) WithListenerPort(port int) *FilesHandler {
+	if port == CONTROLLER_LISTENER_PORT || port == SSL_LISTENER_PORT || port == SASL_LISTENER_PORT {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Listener port %d is reserved for another listener", port))
+	}
+
+	f.listenerAddress = newLocalListenerAddress(port)
+	return f
+}
+
+// GetSSLListenerAddress returns the address of the SSL listener, only present if AddTLSGenerationConfig was used
+func (f *FilesHandler) GetSSLListenerAddress() ListenerAddress {
+	return newLocalListenerAddress(SSL_LISTENER_PORT)
+}
+
+// GetSASLListenerAddress returns the address of the SASL_PLAINTEXT listener, only present if AddSASLGenerationConfig was used
+func (f *FilesHandler) GetSASLListenerAddress() ListenerAddress {
+	return newLocalListenerAddress(SASL_LISTENER_PORT)
+}
+
 func (f *FilesHandler) GetGeneratedLogDirectoryData() *GeneratedLogDirectoryData {
 	return f.generatedLogDirectoryData
 }
@@ -51,6 +83,28 @@ func (f *FilesHandler) AddLogDirectoryGenerationConfig(logDirectoryGenerationCon
 	return f
 }
 
+func (f *FilesHandler) GetGeneratedTLSData() *GeneratedTLSData {
+	return f.generatedTLSData
+}
+
+// AddTLSGenerationConfig makes GenerateServerConfiguration add an SSL listener (on SSL_LISTENER_PORT)
+func (f *FilesHandler) AddTLSGenerationConfig(tlsGenerationConfig TLSGenerationConfig) *FilesHandler {
+	f.tlsGenerationConfig = &tlsGenerationConfig
+	return f
+}
+
+func (f *FilesHandler) GetGeneratedSASLData() *GeneratedSASLData {
+	return f.generatedSASLData
+}
+
+// AddSASLGenerationConfig makes GenerateServerConfiguration add a SASL_PLAINTEXT listener (on SASL_LISTENER_PORT)
+//
+// SCRAM credentials are stored in the cluster metadata log, so GenerateServerConfigAndLogDirs must be used for SCRAM.
+func (f *FilesHandler) AddSASLGenerationConfig(saslGenerationConfig SASLGenerationConfig) *FilesHandler {
+	f.saslGenerationConfig = &saslGenerationConfig
+	return f
+}
+
 func (f *FilesHandler) GenerateServerConfigAndLogDirs() error {
 	if err := f.GenerateServerConfiguration(); err != nil {
 		return err
@@ -62,6 +116,21 @@ func (f *FilesHandler) GenerateServerConfigAndLogDirs() error {
 func (f *FilesHandler) GenerateServerConfiguration() (err error) {
 	f.logger.Debugf("Generating server configuration files")
 
+	// Write /tmp/kafka-tls/*.pem
+	if f.tlsGenerationConfig != nil {
+		f.generatedTLSData, err = f.tlsGenerationConfig.Generate(f.logger)
+		if err != nil {
+			return fmt.Errorf("failed to generate TLS files: %w", err)
+		}
+	}
+
+	if f.saslGenerationConfig != nil {
+		f.generatedSASLData, err = f.saslGenerationConfig.Generate(f.logger)
+		if err != nil {
+			return fmt.Errorf("failed to generate SASL credentials: %w", err)
+		}
+	}
+
 	// Write /tmp/server.properties
 	err = f.writeKraftServerProperties()
 	if err != nil {
@@ -100,6 +169,10 @@ func (f *FilesHandler) generateLogDirectories() error {
 		panic("Codecrafters Internal Error - GenerateServerConfigAndLogDirectory called without LogDirectoryGenerationConfig")
 	}
 
+	if f.generatedSASLData != nil {
+		f.logDirectoryGenerationConfig.userScramCredentialRecords = f.generatedSASLData.userScramCredentialRecords
+	}
+
 	generatedLogDirectoryData, err := f.logDirectoryGenerationConfig.Generate(f.logger)
 
 	if err != nil {
@@ -114,13 +187,37 @@ func (f *FilesHandler) generateLogDirectories() error {
 func (f *FilesHandler) writeKraftServerProperties() error {
 	filePath := SERVER_PROPERTIES_FILE_PATH
 
-	kraftServerProperties := `process.roles=broker,controller
+	listeners := []string{
+		f.listenerAddress.listenerPropertyValue("PLAINTEXT"),
+		newLocalListenerAddress(CONTROLLER_LISTENER_PORT).listenerPropertyValue("CONTROLLER"),
+	}
+
+	if f.tlsGenerationConfig != nil {
+		listeners = append(listeners, f.GetSSLListenerAddress().listenerPropertyValue("SSL"))
+	}
+
+	if f.generatedSASLData != nil {
+		listeners = append(listeners, f.GetSASLListenerAddress().listenerPropertyValue("SASL_PLAINTEXT"))
+	}
+
+	kraftServerProperties := fmt.Sprintf(`process.roles=broker,controller
 node.id=1
-controller.quorum.voters=1@localhost:9093
-listeners=PLAINTEXT://:9092,CONTROLLER://:9093
+controller.quorum.voters=1@localhost:%d
+listeners=%s
 controller.listener.names=CONTROLLER
 listener.security.protocol.map=CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT,SSL:SSL,SASL_PLAINTEXT:SASL_PLAINTEXT,SASL_SSL:SASL_SSL
-log.dirs=/tmp/kraft-combined-logs`
+log.dirs=/tmp/kraft-combined-logs
+offsets.topic.num.partitions=%d
+offsets.topic.replication.factor=1
+group.initial.rebalance.delay.ms=0`, CONTROLLER_LISTENER_PORT, strings.Join(listeners, ","), CONSUMER_OFFSETS_TOPIC_PARTITIONS_COUNT)
+
+	if f.tlsGenerationConfig != nil {
+		kraftServerProperties += "\n" + f.getSSLServerProperties()
+	}
+
+	if f.generatedSASLData != nil {
+		kraftServerProperties += "\n" + f.generatedSASLData.getServerProperties()
+	}
 
 	err := os.WriteFile(filePath, []byte(kraftServerProperties), 0644)
 
@@ -132,6 +229,23 @@ log.dirs=/tmp/kraft-combined-logs`
 	return nil
 }
 
+func (f *FilesHandler) getSSLServerProperties() string {
+	clientAuth := "none"
+	if f.tlsGenerationConfig.RequireClientCertificate {
+		clientAuth = "required"
+	}
+
+	return fmt.Sprintf(`ssl.keystore.type=PEM
+ssl.keystore.location=%s
+ssl.truststore.type=PEM
+ssl.truststore.location=%s
+ssl.client.auth=%s`,
+		path.Join(TLS_DIRECTORY, SERVER_KEYSTORE_FILE_NAME),
+		path.Join(TLS_DIRECTORY, SERVER_TRUSTSTORE_FILE_NAME),
+		clientAuth,
+	)
+}
+
 func (f *FilesHandler) writeMetaProperties(clusterID, directoryID string, nodeID, version int) error {
 	content := fmt.Sprintf("#\n#%s\ncluster.id=%s\ndirectory.id=%s\nnode.id=%d\nversion=%d\n",
 		time.Now().Format("Mon Jan 02 15:04:05 MST 2006"), clusterID, directoryID, nodeID, version)
@@ -0,0 +1,31 @@
+package kafka_files_generator
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// ListenerAddress is the address a broker listener accepts connections on.
+//
+// FilesHandler writes it to server.properties, and clients use the same value to connect.
+type ListenerAddress struct {
+	Host string
+	Port int
+}
+
+func newLocalListenerAddress(port int) ListenerAddress {
+	return ListenerAddress{
+		Host: "localhost",
+		Port: port,
+	}
+}
+
+func (a ListenerAddress) String() string {
+	return net.JoinHostPort(a.Host, strconv.Itoa(a.Port))
+}
+
+// listenerPropertyValue returns the value used for this listener in the "listeners" property (for eg. PLAINTEXT://:9092)
+func (a ListenerAddress) listenerPropertyValue(listenerName string) string {
+	return fmt.Sprintf("%s://:%d", listenerName, a.Port)
+}
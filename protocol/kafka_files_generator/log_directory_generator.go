@@ -1,9 +1,15 @@
 package kafka_files_generator
 
-import "github.com/codecrafters-io/tester-utils/logger"
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
 
 type LogDirectoryGenerationConfig struct {
 	TopicGenerationConfigList []TopicGenerationConfig
+
+	// userScramCredentialRecords is set by FilesHandler if SASL is configured
+	userScramCredentialRecords []kafkaapi.UserScramCredentialRecord
 }
 
 func (c *LogDirectoryGenerationConfig) Generate(logger *logger.Logger) (*GeneratedLogDirectoryData, error) {
@@ -22,7 +28,7 @@ func (c *LogDirectoryGenerationConfig) Generate(logger *logger.Logger) (*Generat
 	}
 
 	// generate cluster metadata as well
-	clusterMetaDataGenerator := NewClusterMetadataGenerator(allGeneratedTopicsData)
+	clusterMetaDataGenerator := NewClusterMetadataGenerator(allGeneratedTopicsData, c.userScramCredentialRecords)
 	err := clusterMetaDataGenerator.Generate()
 
 	if err != nil {
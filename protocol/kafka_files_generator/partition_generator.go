@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
 	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
@@ -22,8 +23,26 @@ type PartitionMetadata struct {
 type PartitionGenerationConfig struct {
 	PartitionId int
 	Logs        []string
+	// Headers are attached to the record of every log, nil headers are written as a null array
+	Headers []kafkaapi.RecordHeader
+	// CompressionCodec is used to compress the records of every batch, batches aren't compressed by default
+	CompressionCodec compression.Codec
+	// IsTransactional writes the batches as part of a transaction, followed by a control batch with TransactionEndMarker
+	IsTransactional bool
+	// TransactionEndMarker is the marker that ends the transaction, either kafkaapi.ControlRecordTypeCommit or kafkaapi.ControlRecordTypeAbort
+	TransactionEndMarker kafkaapi.ControlRecordType
 }
 
+// transactionalProducerId is the producer ID used for transactional batches and their transaction markers
+const transactionalProducerId = 1000
+
+const (
+	// generatedLogsBaseTimestamp is the timestamp of the first batch in every partition
+	generatedLogsBaseTimestamp = 1726045973899
+	// generatedLogsTimestampInterval is the gap (in ms) between the timestamps of consecutive batches, so that they can be looked up by timestamp
+	generatedLogsTimestampInterval = 1000
+)
+
 func (c *PartitionGenerationConfig) Generate(metadata PartitionMetadata, logger *logger.Logger) (kafkaapi.RecordBatches, error) {
 	// Create directory first
 	partitionDirPath := path.Join(
@@ -101,11 +120,11 @@ func (c *PartitionGenerationConfig) generateRecordBatchFromLogs(logs []string) k
 			BaseOffset:           value.Int64{Value: int64(i)},
 			PartitionLeaderEpoch: value.Int32{Value: 0},
 			Magic:                value.Int8{Value: 2},
-			Attributes:           value.Int16{Value: 0},
+			Attributes:           value.Int16{Value: compression.WithCodec(c.getBatchAttributes(), c.CompressionCodec)},
 			LastOffsetDelta:      value.Int32{Value: 0},
-			FirstTimestamp:       value.Int64{Value: 1726045973899},
-			MaxTimestamp:         value.Int64{Value: 1726045973899},
-			ProducerId:           value.Int64{Value: 0},
+			FirstTimestamp:       value.Int64{Value: getGeneratedBatchTimestamp(i)},
+			MaxTimestamp:         value.Int64{Value: getGeneratedBatchTimestamp(i)},
+			ProducerId:           value.Int64{Value: c.getProducerId()},
 			ProducerEpoch:        value.Int16{Value: 0},
 			BaseSequence:         value.Int32{Value: 0},
 			Records: []kafkaapi.Record{
@@ -114,11 +133,55 @@ func (c *PartitionGenerationConfig) generateRecordBatchFromLogs(logs []string) k
 					TimestampDelta: value.Varint{Value: 0},
 					Key:            value.RawBytes{},
 					Value:          value.RawBytes{Value: []byte(message)},
-					Headers:        nil,
+					Headers:        c.Headers,
 				},
 			},
 		})
 	}
 
+	if c.IsTransactional {
+		recordBatches = append(recordBatches, c.generateTransactionMarkerBatch(int64(len(logs))))
+	}
+
 	return recordBatches
 }
+
+func (c *PartitionGenerationConfig) generateTransactionMarkerBatch(baseOffset int64) kafkaapi.RecordBatch {
+	return kafkaapi.RecordBatch{
+		BaseOffset:           value.Int64{Value: baseOffset},
+		PartitionLeaderEpoch: value.Int32{Value: 0},
+		Magic:                value.Int8{Value: 2},
+		Attributes:           value.Int16{Value: kafkaapi.RecordBatchIsTransactionalAttribute | kafkaapi.RecordBatchIsControlAttribute},
+		LastOffsetDelta:      value.Int32{Value: 0},
+		FirstTimestamp:       value.Int64{Value: getGeneratedBatchTimestamp(int(baseOffset))},
+		MaxTimestamp:         value.Int64{Value: getGeneratedBatchTimestamp(int(baseOffset))},
+		ProducerId:           value.Int64{Value: transactionalProducerId},
+		ProducerEpoch:        value.Int16{Value: 0},
+		// Control batches don't have sequence numbers
+		BaseSequence: value.Int32{Value: -1},
+		Records: []kafkaapi.Record{
+			kafkaapi.NewTransactionMarkerRecord(c.TransactionEndMarker, 0),
+		},
+	}
+}
+
+func (c *PartitionGenerationConfig) getBatchAttributes() int16 {
+	if c.IsTransactional {
+		return kafkaapi.RecordBatchIsTransactionalAttribute
+	}
+
+	return 0
+}
+
+func (c *PartitionGenerationConfig) getProducerId() int64 {
+	if c.IsTransactional {
+		return transactionalProducerId
+	}
+
+	return 0
+}
+
+// getGeneratedBatchTimestamp returns the timestamp of the batchIndex-th batch in a partition
+func getGeneratedBatchTimestamp(batchIndex int) int64 {
+	return generatedLogsBaseTimestamp + int64(batchIndex)*generatedLogsTimestampInterval
+}
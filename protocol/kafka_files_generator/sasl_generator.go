@@ -0,0 +1,87 @@
+package kafka_files_generator
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi"
+	"github.com/codecrafters-io/kafka-tester/protocol/sasl"
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+const (
+	// SCRAM_ITERATIONS is the minimum iteration count Kafka accepts for SCRAM-SHA-256
+	SCRAM_ITERATIONS = 4096
+
+	// SCRAM_SHA_256_MECHANISM_TYPE is how SCRAM-SHA-256 is identified in UserScramCredentialRecord
+	SCRAM_SHA_256_MECHANISM_TYPE = 1
+)
+
+type SASLUser struct {
+	Username string
+	Password string
+}
+
+type SASLGenerationConfig struct {
+	// Users are the credentials accepted by the broker, for both PLAIN and SCRAM-SHA-256
+	Users []SASLUser
+}
+
+type GeneratedSASLData struct {
+	Users []SASLUser
+
+	userScramCredentialRecords []kafkaapi.UserScramCredentialRecord
+}
+
+// Generate derives the SCRAM-SHA-256 credentials for each user.
+// These are written to the cluster metadata log along with topics, so SCRAM only works if log directories are generated.
+func (c *SASLGenerationConfig) Generate(logger *logger.Logger) (*GeneratedSASLData, error) {
+	var userScramCredentialRecords []kafkaapi.UserScramCredentialRecord
+
+	for _, user := range c.Users {
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, fmt.Errorf("could not generate salt for SCRAM credential: %w", err)
+		}
+
+		credential, err := sasl.NewScramSha256Credential(user.Password, salt, SCRAM_ITERATIONS)
+		if err != nil {
+			return nil, fmt.Errorf("could not derive SCRAM credential for %s: %w", user.Username, err)
+		}
+
+		userScramCredentialRecords = append(userScramCredentialRecords, kafkaapi.UserScramCredentialRecord{
+			Name:       user.Username,
+			Mechanism:  SCRAM_SHA_256_MECHANISM_TYPE,
+			Salt:       credential.Salt,
+			StoredKey:  credential.StoredKey,
+			ServerKey:  credential.ServerKey,
+			Iterations: int32(credential.Iterations),
+		})
+
+		logger.Debugf("Generated SASL credentials for %s", user.Username)
+	}
+
+	return &GeneratedSASLData{
+		Users:                      c.Users,
+		userScramCredentialRecords: userScramCredentialRecords,
+	}, nil
+}
+
+// getServerProperties returns the properties for the SASL_PLAINTEXT listener, PLAIN users are configured inline via JAAS
+func (d *GeneratedSASLData) getServerProperties() string {
+	var plainJaasConfig strings.Builder
+	plainJaasConfig.WriteString("org.apache.kafka.common.security.plain.PlainLoginModule required")
+
+	for _, user := range d.Users {
+		plainJaasConfig.WriteString(fmt.Sprintf(` user_%s="%s"`, user.Username, user.Password))
+	}
+
+	plainJaasConfig.WriteString(";")
+
+	return fmt.Sprintf(`sasl.enabled.mechanisms=PLAIN,SCRAM-SHA-256
+listener.name.sasl_plaintext.plain.sasl.jaas.config=%s
+listener.name.sasl_plaintext.scram-sha-256.sasl.jaas.config=org.apache.kafka.common.security.scram.ScramLoginModule required;`,
+		plainJaasConfig.String(),
+	)
+}
@@ -0,0 +1,180 @@
+package kafka_files_generator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"time"
+
+	"github.com/codecrafters-io/tester-utils/logger"
+)
+
+type TLSGenerationConfig struct {
+	// RequireClientCertificate makes the broker reject clients that don't present a certificate signed by the CA (mTLS)
+	RequireClientCertificate bool
+}
+
+// GeneratedTLSData holds what a client needs to connect to the SSL listener
+type GeneratedTLSData struct {
+	CACertificatePool        *x509.CertPool
+	ClientCertificate        tls.Certificate
+	RequireClientCertificate bool
+}
+
+// ClientTLSConfig returns the configuration a client uses to connect to the SSL listener.
+// If withClientCertificate is true, the client presents a certificate signed by the generated CA.
+func (d *GeneratedTLSData) ClientTLSConfig(withClientCertificate bool) *tls.Config {
+	tlsConfig := &tls.Config{
+		RootCAs:    d.CACertificatePool,
+		ServerName: "localhost",
+	}
+
+	if withClientCertificate {
+		tlsConfig.Certificates = []tls.Certificate{d.ClientCertificate}
+	}
+
+	return tlsConfig
+}
+
+// Generate creates a self-signed CA, and uses it to sign a server and a client certificate.
+// The server's keystore & truststore are written as PEM files to TLS_DIRECTORY.
+func (c *TLSGenerationConfig) Generate(logger *logger.Logger) (*GeneratedTLSData, error) {
+	if err := os.MkdirAll(TLS_DIRECTORY, 0755); err != nil {
+		return nil, fmt.Errorf("could not create TLS directory at %s: %w", TLS_DIRECTORY, err)
+	}
+
+	caKey, caCertificate, err := generateCACertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	serverKey, serverCertificateBytes, err := generateSignedCertificate(caKey, caCertificate, "kafka-broker", x509.ExtKeyUsageServerAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	clientKey, clientCertificateBytes, err := generateSignedCertificate(caKey, caCertificate, "kafka-tester", x509.ExtKeyUsageClientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	caCertificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCertificate.Raw})
+
+	// The keystore contains the private key followed by the certificate chain
+	serverKeyPEM, err := encodePrivateKeyAsPEM(serverKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverKeystorePEM := append(serverKeyPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: serverCertificateBytes})...)
+	serverKeystorePEM = append(serverKeystorePEM, caCertificatePEM...)
+
+	if err := writeTLSFile(SERVER_KEYSTORE_FILE_NAME, serverKeystorePEM, logger); err != nil {
+		return nil, err
+	}
+
+	if err := writeTLSFile(SERVER_TRUSTSTORE_FILE_NAME, caCertificatePEM, logger); err != nil {
+		return nil, err
+	}
+
+	caCertificatePool := x509.NewCertPool()
+	caCertificatePool.AddCert(caCertificate)
+
+	return &GeneratedTLSData{
+		CACertificatePool: caCertificatePool,
+		ClientCertificate: tls.Certificate{
+			Certificate: [][]byte{clientCertificateBytes},
+			PrivateKey:  clientKey,
+		},
+		RequireClientCertificate: c.RequireClientCertificate,
+	}, nil
+}
+
+func generateCACertificate() (*ecdsa.PrivateKey, *x509.Certificate, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate CA key: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kafka-tester-ca"},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	caCertificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create CA certificate: %w", err)
+	}
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	return caKey, caCertificate, nil
+}
+
+func generateSignedCertificate(caKey *ecdsa.PrivateKey, caCertificate *x509.Certificate, commonName string, extKeyUsage x509.ExtKeyUsage) (*ecdsa.PrivateKey, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate key for %s: %w", commonName, err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate serial number for %s: %w", commonName, err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-1 * time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, caCertificate, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate for %s: %w", commonName, err)
+	}
+
+	return key, certificateBytes, nil
+}
+
+// encodePrivateKeyAsPEM encodes the key as unencrypted PKCS#8, which is what Kafka's PEM keystore expects
+func encodePrivateKeyAsPEM(key *ecdsa.PrivateKey) ([]byte, error) {
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}), nil
+}
+
+func writeTLSFile(fileName string, contents []byte, logger *logger.Logger) error {
+	filePath := path.Join(TLS_DIRECTORY, fileName)
+
+	if err := os.WriteFile(filePath, contents, 0644); err != nil {
+		return fmt.Errorf("error writing file to %s: %w", filePath, err)
+	}
+
+	logger.Debugf("Wrote %s", filePath)
+	return nil
+}
@@ -17,6 +17,17 @@ const (
 	SERVER_PROPERTIES_FILE_PATH    = "/tmp/server.properties"
 	KAFKA_CLEAN_SHUTDOWN_FILE_NAME = ".kafka_cleanshutdown"
 	META_PROPERTIES_FILE_NAME      = "meta.properties"
+	TLS_DIRECTORY                  = "/tmp/kafka-tls"
+	SERVER_KEYSTORE_FILE_NAME      = "server.keystore.pem"
+	SERVER_TRUSTSTORE_FILE_NAME    = "server.truststore.pem"
+	DEFAULT_LISTENER_PORT          = 9092
+	CONTROLLER_LISTENER_PORT       = 9093
+	SSL_LISTENER_PORT              = 9094
+	SASL_LISTENER_PORT             = 9095
+
+	// Committed offsets are stored in this topic, the broker that leads its partition for a group is the group's coordinator
+	CONSUMER_OFFSETS_TOPIC_NAME             = "__consumer_offsets"
+	CONSUMER_OFFSETS_TOPIC_PARTITIONS_COUNT = 1
 
 	// TODO Future: Experiment with multiple values of these constants to see if they can be randomized
 	DIRECTORY_UUID            = "10000000-0000-4000-8000-000000000001"
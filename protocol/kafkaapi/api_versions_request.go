@@ -12,6 +12,8 @@ type ApiVersionsRequestBody struct {
 	ClientSoftwareName value.CompactString
 	// ClientSoftwareVersion contains the version of the client.
 	ClientSoftwareVersion value.CompactString
+	// TaggedFields are written to the tag buffer at the end of the body.
+	TaggedFields value.TaggedFields
 }
 type ApiVersionsRequest struct {
 	Header headers.RequestHeader
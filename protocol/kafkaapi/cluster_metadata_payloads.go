@@ -84,6 +84,29 @@ func (p *PartitionRecord) GetEncodedBytes() []byte {
 	return encoder.Bytes()
 }
 
+type UserScramCredentialRecord struct {
+	Name       string
+	Mechanism  int8
+	Salt       []byte
+	StoredKey  []byte
+	ServerKey  []byte
+	Iterations int32
+}
+
+func (u *UserScramCredentialRecord) isPayloadRecord() {}
+
+func (u *UserScramCredentialRecord) GetEncodedBytes() []byte {
+	encoder := encoder.NewEncoder()
+	encoder.WriteCompactString(u.Name)
+	encoder.WriteInt8(u.Mechanism)
+	encoder.WriteCompactBytes(u.Salt)
+	encoder.WriteCompactBytes(u.StoredKey)
+	encoder.WriteCompactBytes(u.ServerKey)
+	encoder.WriteInt32(u.Iterations)
+	encoder.WriteUvarint(0) // tag buffer
+	return encoder.Bytes()
+}
+
 func (p ClusterMetadataPayload) Encode(encoder *encoder.Encoder) {
 	encoder.WriteInt8(p.FrameVersion)
 	encoder.WriteInt8(p.Type)
@@ -0,0 +1,55 @@
+package kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
+)
+
+// ConsumerProtocolType is the protocol type that consumers use when joining a group.
+// The broker doesn't decode subscriptions or assignments, they're only passed between group members.
+const ConsumerProtocolType = "consumer"
+
+// ConsumerProtocolSubscription is the metadata that a member sends for each protocol in a JoinGroup request
+type ConsumerProtocolSubscription struct {
+	Topics []string
+}
+
+func (s ConsumerProtocolSubscription) Encode(pe *encoder.Encoder) {
+	// Version 0 is the only version that every assignor understands
+	pe.WriteInt16(0)
+
+	pe.WriteInt32(int32(len(s.Topics)))
+	for _, topic := range s.Topics {
+		pe.WriteString(topic)
+	}
+
+	// UserData
+	pe.WriteNullableBytes(nil)
+}
+
+// ConsumerProtocolTopicPartitions are the partitions of a topic that are assigned to a member
+type ConsumerProtocolTopicPartitions struct {
+	Topic      string
+	Partitions []int32
+}
+
+// ConsumerProtocolAssignment is computed by the group leader, and sent to each member in a SyncGroup response
+type ConsumerProtocolAssignment struct {
+	AssignedPartitions []ConsumerProtocolTopicPartitions
+}
+
+func (a ConsumerProtocolAssignment) Encode(pe *encoder.Encoder) {
+	pe.WriteInt16(0)
+
+	pe.WriteInt32(int32(len(a.AssignedPartitions)))
+	for _, assignedPartitions := range a.AssignedPartitions {
+		pe.WriteString(assignedPartitions.Topic)
+
+		pe.WriteInt32(int32(len(assignedPartitions.Partitions)))
+		for _, partition := range assignedPartitions.Partitions {
+			pe.WriteInt32(partition)
+		}
+	}
+
+	// UserData
+	pe.WriteNullableBytes(nil)
+}
@@ -0,0 +1,69 @@
+package kafkaapi
+
+import (
+	"fmt"
+
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// ControlRecordType is stored in the key of control records, COMMIT and ABORT records are transaction markers
+type ControlRecordType int16
+
+const (
+	ControlRecordTypeAbort  ControlRecordType = 0
+	ControlRecordTypeCommit ControlRecordType = 1
+)
+
+func (t ControlRecordType) String() string {
+	switch t {
+	case ControlRecordTypeAbort:
+		return "ABORT"
+	case ControlRecordTypeCommit:
+		return "COMMIT"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", int16(t))
+	}
+}
+
+// IsTransactionMarker returns true for the control record types that end a transaction
+func (t ControlRecordType) IsTransactionMarker() bool {
+	return t == ControlRecordTypeAbort || t == ControlRecordTypeCommit
+}
+
+// ControlRecordKey is the key of every control record
+type ControlRecordKey struct {
+	Version value.Int16
+	Type    value.Int16
+}
+
+func (k ControlRecordKey) Encode(pe *encoder.Encoder) {
+	pe.WriteInt16(k.Version.Value)
+	pe.WriteInt16(k.Type.Value)
+}
+
+// EndTransactionMarker is the value of COMMIT and ABORT control records
+type EndTransactionMarker struct {
+	Version          value.Int16
+	CoordinatorEpoch value.Int32
+}
+
+func (m EndTransactionMarker) Encode(pe *encoder.Encoder) {
+	pe.WriteInt16(m.Version.Value)
+	pe.WriteInt32(m.CoordinatorEpoch.Value)
+}
+
+// NewTransactionMarkerRecord returns the control record that commits or aborts a transaction
+func NewTransactionMarkerRecord(markerType ControlRecordType, coordinatorEpoch int32) Record {
+	keyEncoder := encoder.NewEncoder()
+	ControlRecordKey{Type: value.Int16{Value: int16(markerType)}}.Encode(keyEncoder)
+
+	valueEncoder := encoder.NewEncoder()
+	EndTransactionMarker{CoordinatorEpoch: value.Int32{Value: coordinatorEpoch}}.Encode(valueEncoder)
+
+	return Record{
+		Key:     value.RawBytes{Value: keyEncoder.Bytes()},
+		Value:   value.RawBytes{Value: valueEncoder.Bytes()},
+		Headers: []RecordHeader{},
+	}
+}
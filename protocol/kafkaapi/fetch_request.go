@@ -6,11 +6,15 @@ import (
 )
 
 type ForgottenTopic struct {
+	// Name is only sent in versions 7-12, later versions use UUID
+	Name         value.CompactString
 	UUID         value.UUID
 	PartitionIds []value.Int32
 }
 
 type FetchRequestBody struct {
+	// ReplicaId is only sent in versions 0-14, consumers always use -1
+	ReplicaId       value.Int32
 	MaxWaitMS       value.Int32
 	MinBytes        value.Int32
 	MaxBytes        value.Int32
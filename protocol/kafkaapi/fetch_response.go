@@ -18,18 +18,22 @@ type FetchResponseBody struct {
 }
 
 type TopicResponse struct {
+	// Name is only present in versions 0-12, later versions use UUID
+	Name               value.CompactString
 	UUID               value.UUID
 	PartitionResponses []PartitionResponse
 }
 
 type PartitionResponse struct {
-	Id                   value.Int32
-	ErrorCode            value.Int16
-	HighWatermark        value.Int64
-	LastStableOffset     value.Int64
-	LogStartOffset       value.Int64
-	AbortedTransactions  []AbortedTransaction
-	RecordBatches        []RecordBatch
+	Id                  value.Int32
+	ErrorCode           value.Int16
+	HighWatermark       value.Int64
+	LastStableOffset    value.Int64
+	LogStartOffset      value.Int64
+	AbortedTransactions []AbortedTransaction
+	RecordBatches       []RecordBatch
+	// MessageSet holds the messages of logs that were written in the legacy format (magic 0 or 1)
+	MessageSet           MessageSet
 	PreferredReadReplica value.Int32
 }
 
@@ -14,4 +14,6 @@ type RequestHeader struct {
 	CorrelationId value.Int32
 	// ClientId defines the client ID for the request
 	ClientId value.String
+	// TaggedFields are written to the tag buffer of flexible request headers
+	TaggedFields value.TaggedFields
 }
@@ -0,0 +1,82 @@
+package kafkaapi
+
+import (
+	"hash/crc32"
+
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
+	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+// MessageSet is the format that logs and Produce/Fetch records used before record batches (ref. https://kafka.apache.org/documentation/#messageset)
+type MessageSet []LegacyMessage
+
+func (ms MessageSet) Encode(pe *encoder.Encoder) {
+	for i := range ms {
+		ms[i].Encode(pe)
+	}
+}
+
+// LegacyMessage is a message with magic 0 or 1, RecordBatch is used for magic 2
+type LegacyMessage struct {
+	Offset      value.Int64
+	MessageSize value.Int32
+	CRC         value.Int32
+	Magic       value.Int8
+	Attributes  value.Int8
+	// Timestamp is only present when Magic is 1
+	Timestamp value.Int64
+	Key       value.RawBytes
+	Value     value.RawBytes
+
+	// InnerMessages are decoded from Value when the message is compressed, Value is encoded as-is either way
+	InnerMessages MessageSet
+}
+
+func (m *LegacyMessage) Encode(pe *encoder.Encoder) {
+	propertiesBytes := m.GetPropertiesAsBytes()
+
+	m.CRC = value.Int32{
+		Value: int32(crc32.ChecksumIEEE(propertiesBytes)),
+	}
+
+	m.MessageSize = value.Int32{
+		Value: int32(len(propertiesBytes) + 4), // CRC
+	}
+
+	pe.WriteInt64(m.Offset.Value)
+	pe.WriteInt32(m.MessageSize.Value)
+	pe.WriteInt32(m.CRC.Value)
+	pe.WriteRawBytes(propertiesBytes)
+}
+
+func (m *LegacyMessage) IsCRCValueOk() bool {
+	return m.CRC == m.GetComputedCRCValue()
+}
+
+// GetComputedCRCValue returns the CRC32 (IEEE) of the message, unlike record batches which use CRC32C
+func (m *LegacyMessage) GetComputedCRCValue() value.Int32 {
+	return value.Int32{
+		Value: int32(crc32.ChecksumIEEE(m.GetPropertiesAsBytes())),
+	}
+}
+
+func (m *LegacyMessage) GetCompressionCodec() compression.Codec {
+	return compression.CodecFromAttributes(int16(m.Attributes.Value))
+}
+
+// GetPropertiesAsBytes returns the bytes covered by the CRC, everything from the magic byte onwards
+func (m *LegacyMessage) GetPropertiesAsBytes() []byte {
+	propertiesEncoder := encoder.NewEncoder()
+	propertiesEncoder.WriteInt8(m.Magic.Value)
+	propertiesEncoder.WriteInt8(m.Attributes.Value)
+
+	if m.Magic.Value >= 1 {
+		propertiesEncoder.WriteInt64(m.Timestamp.Value)
+	}
+
+	propertiesEncoder.WriteNullableBytes(m.Key.Value)
+	propertiesEncoder.WriteNullableBytes(m.Value.Value)
+
+	return propertiesEncoder.Bytes()
+}
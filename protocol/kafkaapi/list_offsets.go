@@ -0,0 +1,11 @@
+package kafkaapi
+
+// ListOffsets requests use these timestamps to look up offsets that aren't found by a record timestamp
+const (
+	// ListOffsetsLatestTimestamp looks up the offset of the next record that will be written to the partition
+	ListOffsetsLatestTimestamp int64 = -1
+	// ListOffsetsEarliestTimestamp looks up the offset of the first record in the partition
+	ListOffsetsEarliestTimestamp int64 = -2
+	// ListOffsetsMaxTimestamp looks up the offset of the record with the largest timestamp (v7+)
+	ListOffsetsMaxTimestamp int64 = -3
+)
@@ -37,30 +37,17 @@ func (r *Record) getPropertiesAsBytes() []byte {
 
 	// Special encoding that does not belong to any data type and is only present inside Records
 	// similar to protobuf encoding. It is mentioned in the Kafka docs here:  https://kafka.apache.org/documentation/#recordheader
-	if r.Key.Value == nil {
-		propertiesEncoder.WriteVarint(-1)
-	} else {
-		propertiesEncoder.WriteVarint(int64(len(r.Key.Value)))
-		propertiesEncoder.WriteRawBytes(r.Key.Value)
-	}
+	writeVarintPrefixedBytes(propertiesEncoder, r.Key.Value)
+	writeVarintPrefixedBytes(propertiesEncoder, r.Value.Value)
 
-	if r.Value.Value == nil {
+	if r.Headers == nil {
 		propertiesEncoder.WriteVarint(-1)
 	} else {
-		propertiesEncoder.WriteVarint(int64(len(r.Value.Value)))
-		propertiesEncoder.WriteRawBytes(r.Value.Value)
+		propertiesEncoder.WriteVarint(int64(len(r.Headers)))
 	}
 
-	// We don't use headers in the stages
-	// So, we can panic if it's not empty
-	if len(r.Headers) > 0 {
-		panic("Codecrafters Internal Error - Record Headers is neither empty nor nil")
-	}
-
-	if r.Headers == nil {
-		propertiesEncoder.WriteVarint(-1)
-	} else {
-		propertiesEncoder.WriteVarint(0)
+	for _, header := range r.Headers {
+		header.Encode(propertiesEncoder)
 	}
 
 	return propertiesEncoder.Bytes()
@@ -70,3 +57,19 @@ type RecordHeader struct {
 	Key   value.RawBytes
 	Value value.RawBytes
 }
+
+func (h RecordHeader) Encode(pe *encoder.Encoder) {
+	writeVarintPrefixedBytes(pe, h.Key.Value)
+	writeVarintPrefixedBytes(pe, h.Value.Value)
+}
+
+// writeVarintPrefixedBytes writes the length as a varint followed by the bytes, a length of -1 is used for nil
+func writeVarintPrefixedBytes(pe *encoder.Encoder, bytes []byte) {
+	if bytes == nil {
+		pe.WriteVarint(-1)
+		return
+	}
+
+	pe.WriteVarint(int64(len(bytes)))
+	pe.WriteRawBytes(bytes)
+}
@@ -3,6 +3,7 @@ package kafkaapi
 import (
 	"hash/crc32"
 
+	"github.com/codecrafters-io/kafka-tester/protocol/compression"
 	"github.com/codecrafters-io/kafka-tester/protocol/encoder"
 	"github.com/codecrafters-io/kafka-tester/protocol/value"
 )
@@ -19,6 +20,13 @@ func (rbs RecordBatches) Encode(pe *encoder.Encoder) {
 	}
 }
 
+const (
+	// RecordBatchIsTransactionalAttribute is set in the attributes of batches written by a transactional producer
+	RecordBatchIsTransactionalAttribute int16 = 0x10
+	// RecordBatchIsControlAttribute is set in the attributes of batches that hold a control record, like a transaction marker
+	RecordBatchIsControlAttribute int16 = 0x20
+)
+
 type RecordBatch struct {
 	BaseOffset           value.Int64
 	BatchLength          value.Int32
@@ -33,6 +41,10 @@ type RecordBatch struct {
 	ProducerEpoch        value.Int16
 	BaseSequence         value.Int32
 	Records              []Record
+
+	// CompressedRecords holds the records of a compressed batch as they were decoded, so that the CRC of a decoded
+	// batch is computed over the same bytes. It's left empty when building batches, Records are compressed instead.
+	CompressedRecords value.RawBytes
 }
 
 func (rb *RecordBatch) Encode(pe *encoder.Encoder) {
@@ -97,11 +109,44 @@ func (rb *RecordBatch) GetPropertiesAsBytes() []byte {
 	propertiesEncoder.WriteInt32(rb.BaseSequence.Value)
 	propertiesEncoder.WriteInt32(int32(len(rb.Records)))
 
-	for i, record := range rb.Records {
-		record.OffsetDelta = value.Varint{Value: int64(i)}
-		record.Encode(propertiesEncoder)
+	// The record count stays uncompressed, only the records that follow it are compressed
+	if rb.GetCompressionCodec() == compression.None {
+		rb.encodeRecords(propertiesEncoder)
+	} else {
+		propertiesEncoder.WriteRawBytes(rb.GetCompressedRecords())
 	}
 
 	propertiesEncoderBytes := propertiesEncoder.Bytes()
 	return propertiesEncoderBytes
 }
+
+func (rb *RecordBatch) IsTransactional() bool {
+	return rb.Attributes.Value&RecordBatchIsTransactionalAttribute != 0
+}
+
+func (rb *RecordBatch) IsControl() bool {
+	return rb.Attributes.Value&RecordBatchIsControlAttribute != 0
+}
+
+func (rb *RecordBatch) GetCompressionCodec() compression.Codec {
+	return compression.CodecFromAttributes(rb.Attributes.Value)
+}
+
+// GetCompressedRecords returns the records compressed with the codec in Attributes
+func (rb *RecordBatch) GetCompressedRecords() []byte {
+	if rb.CompressedRecords.Value != nil {
+		return rb.CompressedRecords.Value
+	}
+
+	recordsEncoder := encoder.NewEncoder()
+	rb.encodeRecords(recordsEncoder)
+
+	return compression.Compress(rb.GetCompressionCodec(), recordsEncoder.Bytes())
+}
+
+func (rb *RecordBatch) encodeRecords(pe *encoder.Encoder) {
+	for i, record := range rb.Records {
+		record.OffsetDelta = value.Varint{Value: int64(i)}
+		record.Encode(pe)
+	}
+}
@@ -0,0 +1,23 @@
+package kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type SaslAuthenticateRequestBody struct {
+	// Version defines the protocol version to use for encode and decode
+	Version value.Int16
+	// AuthBytes contains the SASL authentication bytes from the client, as defined by the SASL mechanism.
+	AuthBytes value.CompactBytes
+}
+
+type SaslAuthenticateRequest struct {
+	Header headers.RequestHeader
+	Body   SaslAuthenticateRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r SaslAuthenticateRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
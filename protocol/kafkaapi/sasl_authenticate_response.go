@@ -0,0 +1,24 @@
+package kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type SaslAuthenticateResponse struct {
+	Header headers.ResponseHeader
+	Body   SaslAuthenticateResponseBody
+}
+
+type SaslAuthenticateResponseBody struct {
+	// Version defines the protocol version to use for encode and decode
+	Version int16
+	// ErrorCode contains the error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// ErrorMessage contains the error message, or null if there was no error.
+	ErrorMessage value.CompactNullableString
+	// AuthBytes contains the SASL authentication bytes from the server, as defined by the SASL mechanism.
+	AuthBytes value.CompactBytes
+	// SessionLifetimeMs contains the number of milliseconds after which only re-authentication over the existing connection to create a new session can occur.
+	SessionLifetimeMs value.Int64
+}
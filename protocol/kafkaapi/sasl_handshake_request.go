@@ -0,0 +1,23 @@
+package kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type SaslHandshakeRequestBody struct {
+	// Version defines the protocol version to use for encode and decode
+	Version value.Int16
+	// Mechanism contains the SASL mechanism chosen by the client.
+	Mechanism value.String
+}
+
+type SaslHandshakeRequest struct {
+	Header headers.RequestHeader
+	Body   SaslHandshakeRequestBody
+}
+
+// GetHeader implements the RequestI interface
+func (r SaslHandshakeRequest) GetHeader() headers.RequestHeader {
+	return r.Header
+}
@@ -0,0 +1,20 @@
+package kafkaapi
+
+import (
+	"github.com/codecrafters-io/kafka-tester/protocol/kafkaapi/headers"
+	"github.com/codecrafters-io/kafka-tester/protocol/value"
+)
+
+type SaslHandshakeResponse struct {
+	Header headers.ResponseHeader
+	Body   SaslHandshakeResponseBody
+}
+
+type SaslHandshakeResponseBody struct {
+	// Version defines the protocol version to use for encode and decode
+	Version int16
+	// ErrorCode contains the error code, or 0 if there was no error.
+	ErrorCode value.Int16
+	// Mechanisms contains the mechanisms enabled in the server.
+	Mechanisms []value.String
+}
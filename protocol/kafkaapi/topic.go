@@ -5,6 +5,8 @@ import (
 )
 
 type Topic struct {
+	// Name is only sent in versions 0-12, later versions use UUID
+	Name       value.CompactString
 	UUID       value.UUID
 	Partitions []Partition
 }
@@ -0,0 +1,16 @@
+package sasl
+
+// Mechanism is the client side of a SASL mechanism.
+//
+// The exchange starts with InitialResponse. Each server message is then passed to HandleChallenge, which returns
+// the next client message, until it reports that the exchange is complete.
+type Mechanism interface {
+	// Name is the mechanism name sent in SaslHandshake requests (for eg. "PLAIN")
+	Name() string
+
+	// InitialResponse returns the first message sent by the client
+	InitialResponse() ([]byte, error)
+
+	// HandleChallenge returns the next client message, or isComplete = true if no more messages need to be sent
+	HandleChallenge(serverMessage []byte) (clientMessage []byte, isComplete bool, err error)
+}
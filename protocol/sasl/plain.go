@@ -0,0 +1,21 @@
+package sasl
+
+// Plain implements the PLAIN mechanism (RFC 4616)
+type Plain struct {
+	Username string
+	Password string
+}
+
+func (m *Plain) Name() string {
+	return "PLAIN"
+}
+
+func (m *Plain) InitialResponse() ([]byte, error) {
+	// authzid is left empty, the server uses the username as the identity
+	return []byte("\x00" + m.Username + "\x00" + m.Password), nil
+}
+
+func (m *Plain) HandleChallenge(serverMessage []byte) ([]byte, bool, error) {
+	// PLAIN is a single step mechanism, the server doesn't send anything back on success
+	return nil, true, nil
+}
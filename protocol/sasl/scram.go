@@ -0,0 +1,171 @@
+package sasl
+
+import (
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const scramSha256KeyLength = sha256.Size
+
+// ScramCredential is what the server stores for a SCRAM user (RFC 5802, section 3)
+type ScramCredential struct {
+	Salt       []byte
+	StoredKey  []byte
+	ServerKey  []byte
+	Iterations int
+}
+
+// NewScramSha256Credential derives the SCRAM-SHA-256 credential for the password
+func NewScramSha256Credential(password string, salt []byte, iterations int) (ScramCredential, error) {
+	saltedPassword, err := pbkdf2.Key(sha256.New, password, salt, iterations, scramSha256KeyLength)
+	if err != nil {
+		return ScramCredential{}, err
+	}
+
+	clientKey := hmacSha256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+
+	return ScramCredential{
+		Salt:       salt,
+		StoredKey:  storedKey[:],
+		ServerKey:  hmacSha256(saltedPassword, []byte("Server Key")),
+		Iterations: iterations,
+	}, nil
+}
+
+// ScramSha256 implements the client side of SCRAM-SHA-256 (RFC 7677), without channel binding
+type ScramSha256 struct {
+	Username string
+	Password string
+
+	clientNonce             string
+	clientFirstMessageBare  string
+	expectedServerSignature []byte
+}
+
+func (m *ScramSha256) Name() string {
+	return "SCRAM-SHA-256"
+}
+
+func (m *ScramSha256) InitialResponse() ([]byte, error) {
+	if m.clientNonce == "" {
+		nonceBytes := make([]byte, 18)
+		if _, err := rand.Read(nonceBytes); err != nil {
+			return nil, err
+		}
+
+		m.clientNonce = base64.RawStdEncoding.EncodeToString(nonceBytes)
+	}
+
+	m.clientFirstMessageBare = fmt.Sprintf("n=%s,r=%s", escapeScramUsername(m.Username), m.clientNonce)
+
+	// "n,," is the GS2 header: no channel binding, no authzid
+	return []byte("n,," + m.clientFirstMessageBare), nil
+}
+
+func (m *ScramSha256) HandleChallenge(serverMessage []byte) ([]byte, bool, error) {
+	if m.expectedServerSignature == nil {
+		clientFinalMessage, err := m.handleServerFirstMessage(string(serverMessage))
+		return clientFinalMessage, false, err
+	}
+
+	return nil, true, m.handleServerFinalMessage(string(serverMessage))
+}
+
+func (m *ScramSha256) handleServerFirstMessage(serverFirstMessage string) ([]byte, error) {
+	attributes, err := parseScramAttributes(serverFirstMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	serverNonce := attributes["r"]
+	if !strings.HasPrefix(serverNonce, m.clientNonce) || len(serverNonce) == len(m.clientNonce) {
+		return nil, fmt.Errorf("Expected server nonce (r=%s) to start with the client nonce (%s), followed by the server's own nonce", serverNonce, m.clientNonce)
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(attributes["s"])
+	if err != nil || len(salt) == 0 {
+		return nil, fmt.Errorf("Expected salt (s=%s) to be a non-empty base64 string", attributes["s"])
+	}
+
+	iterations, err := strconv.Atoi(attributes["i"])
+	if err != nil || iterations <= 0 {
+		return nil, fmt.Errorf("Expected iteration count (i=%s) to be a positive integer", attributes["i"])
+	}
+
+	saltedPassword, err := pbkdf2.Key(sha256.New, m.Password, salt, iterations, scramSha256KeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	clientKey := hmacSha256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	serverKey := hmacSha256(saltedPassword, []byte("Server Key"))
+
+	// "biws" is base64("n,,"), the GS2 header sent in the first message
+	clientFinalMessageWithoutProof := fmt.Sprintf("c=biws,r=%s", serverNonce)
+	authMessage := m.clientFirstMessageBare + "," + serverFirstMessage + "," + clientFinalMessageWithoutProof
+
+	clientSignature := hmacSha256(storedKey[:], []byte(authMessage))
+	clientProof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		clientProof[i] = clientKey[i] ^ clientSignature[i]
+	}
+
+	m.expectedServerSignature = hmacSha256(serverKey, []byte(authMessage))
+
+	return []byte(fmt.Sprintf("%s,p=%s", clientFinalMessageWithoutProof, base64.StdEncoding.EncodeToString(clientProof))), nil
+}
+
+func (m *ScramSha256) handleServerFinalMessage(serverFinalMessage string) error {
+	attributes, err := parseScramAttributes(serverFinalMessage)
+	if err != nil {
+		return err
+	}
+
+	if serverError, ok := attributes["e"]; ok {
+		return fmt.Errorf("Expected server-final-message to contain a signature, got error: %s", serverError)
+	}
+
+	serverSignature, err := base64.StdEncoding.DecodeString(attributes["v"])
+	if err != nil {
+		return fmt.Errorf("Expected server signature (v=%s) to be a base64 string", attributes["v"])
+	}
+
+	if !hmac.Equal(serverSignature, m.expectedServerSignature) {
+		return fmt.Errorf("Expected server signature to be %s, got %s", base64.StdEncoding.EncodeToString(m.expectedServerSignature), attributes["v"])
+	}
+
+	return nil
+}
+
+func parseScramAttributes(message string) (map[string]string, error) {
+	attributes := map[string]string{}
+
+	for _, part := range strings.Split(message, ",") {
+		key, value, found := strings.Cut(part, "=")
+		if !found || len(key) != 1 {
+			return nil, fmt.Errorf("Expected SCRAM message to be a list of comma separated attributes (like r=...), got %q", message)
+		}
+
+		attributes[key] = value
+	}
+
+	return attributes, nil
+}
+
+func escapeScramUsername(username string) string {
+	return strings.NewReplacer("=", "=3D", ",", "=2C").Replace(username)
+}
+
+func hmacSha256(key []byte, message []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+	return mac.Sum(nil)
+}
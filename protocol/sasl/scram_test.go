@@ -0,0 +1,56 @@
+package sasl
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from RFC 7677, section 3
+func TestScramSha256Exchange(t *testing.T) {
+	mechanism := &ScramSha256{Username: "user", Password: "pencil", clientNonce: "rOprNGfwEbeRWgbNEkqO"}
+
+	clientFirstMessage, err := mechanism.InitialResponse()
+	require.NoError(t, err)
+	assert.Equal(t, "n,,n=user,r=rOprNGfwEbeRWgbNEkqO", string(clientFirstMessage))
+
+	clientFinalMessage, isComplete, err := mechanism.HandleChallenge([]byte("r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"))
+	require.NoError(t, err)
+	assert.False(t, isComplete)
+	assert.Equal(t, "c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=", string(clientFinalMessage))
+
+	_, isComplete, err = mechanism.HandleChallenge([]byte("v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4="))
+	require.NoError(t, err)
+	assert.True(t, isComplete)
+}
+
+func TestScramSha256RejectsWrongServerSignature(t *testing.T) {
+	mechanism := &ScramSha256{Username: "user", Password: "pencil", clientNonce: "rOprNGfwEbeRWgbNEkqO"}
+
+	_, err := mechanism.InitialResponse()
+	require.NoError(t, err)
+
+	_, _, err = mechanism.HandleChallenge([]byte("r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"))
+	require.NoError(t, err)
+
+	_, _, err = mechanism.HandleChallenge([]byte("v=" + base64.StdEncoding.EncodeToString(make([]byte, 32))))
+	assert.Error(t, err)
+}
+
+func TestNewScramSha256CredentialMatchesExchange(t *testing.T) {
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+
+	credential, err := NewScramSha256Credential("pencil", salt, 4096)
+	require.NoError(t, err)
+
+	// The server signature in the RFC 7677 exchange is HMAC(ServerKey, AuthMessage)
+	authMessage := "n=user,r=rOprNGfwEbeRWgbNEkqO," +
+		"r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096," +
+		"c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0"
+	serverSignature := hmacSha256(credential.ServerKey, []byte(authMessage))
+
+	assert.Equal(t, "6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=", base64.StdEncoding.EncodeToString(serverSignature))
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+)
+
+// apiVersionRange is the range of versions the tester can encode and decode for an API
+type apiVersionRange struct {
+	minVersion int16
+	maxVersion int16
+	// firstFlexibleVersion is the first version that uses compact types and tag buffers
+	firstFlexibleVersion int16
+}
+
+var supportedApiVersions = map[int16]apiVersionRange{
+	0:  {minVersion: 3, maxVersion: 11, firstFlexibleVersion: 9},
+	1:  {minVersion: 4, maxVersion: 16, firstFlexibleVersion: 12},
+	2:  {minVersion: 0, maxVersion: 8, firstFlexibleVersion: 6},
+	3:  {minVersion: 0, maxVersion: 12, firstFlexibleVersion: 9},
+	8:  {minVersion: 0, maxVersion: 9, firstFlexibleVersion: 8},
+	9:  {minVersion: 0, maxVersion: 9, firstFlexibleVersion: 6},
+	10: {minVersion: 0, maxVersion: 4, firstFlexibleVersion: 3},
+	11: {minVersion: 0, maxVersion: 9, firstFlexibleVersion: 6},
+	12: {minVersion: 0, maxVersion: 4, firstFlexibleVersion: 4},
+	13: {minVersion: 0, maxVersion: 5, firstFlexibleVersion: 4},
+	14: {minVersion: 0, maxVersion: 5, firstFlexibleVersion: 4},
+	17: {minVersion: 0, maxVersion: 1, firstFlexibleVersion: math.MaxInt16},
+	18: {minVersion: 0, maxVersion: 4, firstFlexibleVersion: 3},
+	19: {minVersion: 0, maxVersion: 7, firstFlexibleVersion: 5},
+	36: {minVersion: 0, maxVersion: 2, firstFlexibleVersion: 2},
+	75: {minVersion: 0, maxVersion: 0, firstFlexibleVersion: 0},
+}
+
+func getApiVersionRange(apiKey int16) apiVersionRange {
+	versionRange, ok := supportedApiVersions[apiKey]
+	if !ok {
+		panic(fmt.Sprintf("CodeCrafters Internal Error: Expected %s to be in supportedApiVersions map", APIKeyToName(apiKey)))
+	}
+
+	return versionRange
+}
+
+// GetSupportedApiVersionRange returns the minimum and maximum versions of an API that the tester can send and decode
+func GetSupportedApiVersionRange(apiKey int16) (int16, int16) {
+	versionRange := getApiVersionRange(apiKey)
+	return versionRange.minVersion, versionRange.maxVersion
+}
+
+// IsSupportedApiVersion returns true if the tester can send and decode apiVersion of an API
+func IsSupportedApiVersion(apiKey int16, apiVersion int16) bool {
+	versionRange := getApiVersionRange(apiKey)
+	return apiVersion >= versionRange.minVersion && apiVersion <= versionRange.maxVersion
+}
+
+// IsFlexibleVersion returns true if apiVersion of an API uses compact types and tag buffers
+//
+// Versions above the supported range are treated as flexible, since brokers only respond to them with an error.
+func IsFlexibleVersion(apiKey int16, apiVersion int16) bool {
+	return apiVersion >= getApiVersionRange(apiKey).firstFlexibleVersion
+}
+
+// UsesFlexibleRequestHeader returns true if requests use the v2 request header (with a tag buffer), false for the v1 header
+func UsesFlexibleRequestHeader(apiKey int16, apiVersion int16) bool {
+	return IsFlexibleVersion(apiKey, apiVersion)
+}
+
+// UsesFlexibleResponseHeader returns true if responses use the v1 response header (with a tag buffer), false for the v0 header
+//
+// ApiVersions responses always use the v0 header, so that clients can read the error code before knowing which versions the broker supports.
+func UsesFlexibleResponseHeader(apiKey int16, apiVersion int16) bool {
+	return apiKey != 18 && IsFlexibleVersion(apiKey, apiVersion)
+}
@@ -0,0 +1,11 @@
+package utils
+
+import (
+	"math"
+
+	"github.com/codecrafters-io/tester-utils/random"
+)
+
+func GetRandomCorrelationId() int32 {
+	return int32(random.RandomInt(0, math.MaxInt32-1))
+}
@@ -5,28 +5,56 @@ import (
 	"strings"
 )
 
+var apiKeyNames = map[int16]string{
+	0:  "Produce",
+	1:  "Fetch",
+	2:  "ListOffsets",
+	3:  "Metadata",
+	8:  "OffsetCommit",
+	9:  "OffsetFetch",
+	10: "FindCoordinator",
+	11: "JoinGroup",
+	12: "Heartbeat",
+	13: "LeaveGroup",
+	14: "SyncGroup",
+	17: "SaslHandshake",
+	18: "ApiVersions",
+	19: "CreateTopics",
+	36: "SaslAuthenticate",
+	75: "DescribeTopicPartitions",
+}
+
 func APIKeyToName(apiKey int16) string {
-	switch apiKey {
-	case 0:
-		return "Produce"
-	case 1:
-		return "Fetch"
-	case 18:
-		return "ApiVersions"
-	case 19:
-		return "CreateTopics"
-	case 75:
-		return "DescribeTopicPartitions"
-	default:
+	apiName, ok := apiKeyNames[apiKey]
+	if !ok {
 		panic(fmt.Sprintf("CodeCrafters Internal Error: Unknown API key: %v", apiKey))
 	}
+
+	return apiName
+}
+
+// IsKnownAPIKey returns true if APIKeyToName can be called for apiKey.
+// This is only needed when the API key comes from outside the tester (for eg. the decoding proxy).
+func IsKnownAPIKey(apiKey int16) bool {
+	_, ok := apiKeyNames[apiKey]
+	return ok
 }
 
 func ErrorCodeToName(errorCode int16) string {
 	errorCodes := map[int16]string{
 		0:   "NO_ERROR",
 		3:   "UNKNOWN_TOPIC_OR_PARTITION",
+		17:  "INVALID_TOPIC_EXCEPTION",
+		25:  "UNKNOWN_MEMBER_ID",
+		27:  "REBALANCE_IN_PROGRESS",
+		33:  "UNSUPPORTED_SASL_MECHANISM",
+		34:  "ILLEGAL_SASL_STATE",
 		35:  "UNSUPPORTED_VERSION",
+		36:  "TOPIC_ALREADY_EXISTS",
+		37:  "INVALID_PARTITIONS",
+		38:  "INVALID_REPLICATION_FACTOR",
+		58:  "SASL_AUTHENTICATION_FAILED",
+		79:  "MEMBER_ID_REQUIRED",
 		100: "UNKNOWN_TOPIC_ID",
 	}
 
@@ -0,0 +1,17 @@
+package value
+
+import "fmt"
+
+// Bytes is encoded as an INT32 length, followed by the bytes
+// It is used in non-flexible versions, flexible versions use CompactBytes
+type Bytes struct {
+	Value []byte
+}
+
+func (v Bytes) String() string {
+	return fmt.Sprintf("%v -> UTF-8: (%s)", v.Value, string(v.Value))
+}
+
+func (v Bytes) GetType() string {
+	return "BYTES"
+}
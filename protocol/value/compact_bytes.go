@@ -0,0 +1,16 @@
+package value
+
+import "fmt"
+
+// CompactBytes is encoded as an unsigned varint (length + 1), followed by the bytes
+type CompactBytes struct {
+	Value []byte
+}
+
+func (v CompactBytes) String() string {
+	return fmt.Sprintf("%v -> UTF-8: (%s)", v.Value, string(v.Value))
+}
+
+func (v CompactBytes) GetType() string {
+	return "COMPACT_BYTES"
+}
@@ -0,0 +1,19 @@
+package value
+
+import "fmt"
+
+// CompactNullableBytes is encoded as an unsigned varint (length + 1), followed by the bytes, a length of 0 means null
+type CompactNullableBytes struct {
+	Value []byte
+}
+
+func (v CompactNullableBytes) String() string {
+	if v.Value == nil {
+		return "NULL"
+	}
+	return fmt.Sprintf("%v -> UTF-8: (%s)", v.Value, string(v.Value))
+}
+
+func (v CompactNullableBytes) GetType() string {
+	return "COMPACT_NULLABLE_BYTES"
+}
@@ -0,0 +1,16 @@
+package value
+
+import "fmt"
+
+// Float64 is an IEEE 754 double, encoded in 8 bytes (big-endian)
+type Float64 struct {
+	Value float64
+}
+
+func (v Float64) String() string {
+	return fmt.Sprintf("%v", v.Value)
+}
+
+func (v Float64) GetType() string {
+	return "FLOAT64"
+}
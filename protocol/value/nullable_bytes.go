@@ -0,0 +1,20 @@
+package value
+
+import "fmt"
+
+// NullableBytes is encoded like Bytes (INT32 length followed by the bytes), a length of -1 means null
+// It is used in non-flexible versions, flexible versions use CompactNullableBytes
+type NullableBytes struct {
+	Value []byte
+}
+
+func (v NullableBytes) String() string {
+	if v.Value == nil {
+		return "NULL"
+	}
+	return fmt.Sprintf("%v -> UTF-8: (%s)", v.Value, string(v.Value))
+}
+
+func (v NullableBytes) GetType() string {
+	return "NULLABLE_BYTES"
+}
@@ -0,0 +1,21 @@
+package value
+
+// NullableString is encoded like String (INT16 length followed by contents), a length of -1 means null
+// It is used in non-flexible versions, flexible versions use CompactNullableString
+type NullableString struct {
+	Value *string
+}
+
+func (v NullableString) String() string {
+	if v.Value == nil {
+		return "NULL"
+	}
+	if *v.Value == "" {
+		return `""`
+	}
+	return *v.Value
+}
+
+func (v NullableString) GetType() string {
+	return "NULLABLE_STRING"
+}
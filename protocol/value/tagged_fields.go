@@ -0,0 +1,31 @@
+package value
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TaggedField is a single entry in a tag buffer, its data is kept as raw bytes
+type TaggedField struct {
+	Tag  uint64
+	Data []byte
+}
+
+// TaggedFields is encoded as an unsigned varint (number of fields), followed by the tag (unsigned varint),
+// data length (unsigned varint) and data of each field. Tags must appear in increasing order.
+type TaggedFields struct {
+	Fields []TaggedField
+}
+
+func (v TaggedFields) String() string {
+	fieldStrings := make([]string, len(v.Fields))
+	for i, taggedField := range v.Fields {
+		fieldStrings[i] = fmt.Sprintf("%d: %v", taggedField.Tag, taggedField.Data)
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(fieldStrings, ", "))
+}
+
+func (v TaggedFields) GetType() string {
+	return "TAGGED_FIELDS"
+}
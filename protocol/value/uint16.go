@@ -0,0 +1,15 @@
+package value
+
+import "fmt"
+
+type Uint16 struct {
+	Value uint16
+}
+
+func (v Uint16) String() string {
+	return fmt.Sprintf("%d", v.Value)
+}
+
+func (v Uint16) GetType() string {
+	return "UINT16"
+}
@@ -28,6 +28,13 @@ func MustBeInt16(value KafkaProtocolValue) Int16 {
 	return value.(Int16)
 }
 
+func MustBeUint16(value KafkaProtocolValue) Uint16 {
+	if value.GetType() != "UINT16" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not UINT16", value.GetType()))
+	}
+	return value.(Uint16)
+}
+
 func MustBeInt32(value KafkaProtocolValue) Int32 {
 	if value.GetType() != "INT32" {
 		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not INT32", value.GetType()))
@@ -42,6 +49,13 @@ func MustBeInt64(value KafkaProtocolValue) Int64 {
 	return value.(Int64)
 }
 
+func MustBeFloat64(value KafkaProtocolValue) Float64 {
+	if value.GetType() != "FLOAT64" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not FLOAT64", value.GetType()))
+	}
+	return value.(Float64)
+}
+
 func MustBeVarint(value KafkaProtocolValue) Varint {
 	if value.GetType() != "VARINT" {
 		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not VARINT", value.GetType()))
@@ -77,6 +91,41 @@ func MustBeCompactNullableString(value KafkaProtocolValue) CompactNullableString
 	return value.(CompactNullableString)
 }
 
+func MustBeNullableString(value KafkaProtocolValue) NullableString {
+	if value.GetType() != "NULLABLE_STRING" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not NULLABLE_STRING", value.GetType()))
+	}
+	return value.(NullableString)
+}
+
+func MustBeBytes(value KafkaProtocolValue) Bytes {
+	if value.GetType() != "BYTES" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not BYTES", value.GetType()))
+	}
+	return value.(Bytes)
+}
+
+func MustBeNullableBytes(value KafkaProtocolValue) NullableBytes {
+	if value.GetType() != "NULLABLE_BYTES" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not NULLABLE_BYTES", value.GetType()))
+	}
+	return value.(NullableBytes)
+}
+
+func MustBeCompactBytes(value KafkaProtocolValue) CompactBytes {
+	if value.GetType() != "COMPACT_BYTES" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not COMPACT_BYTES", value.GetType()))
+	}
+	return value.(CompactBytes)
+}
+
+func MustBeCompactNullableBytes(value KafkaProtocolValue) CompactNullableBytes {
+	if value.GetType() != "COMPACT_NULLABLE_BYTES" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not COMPACT_NULLABLE_BYTES", value.GetType()))
+	}
+	return value.(CompactNullableBytes)
+}
+
 func MustBeRawBytes(value KafkaProtocolValue) RawBytes {
 	if value.GetType() != "RAW_BYTES" {
 		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not RAW_BYTES", value.GetType()))
@@ -111,3 +160,10 @@ func MustBeCompactRecordSize(value KafkaProtocolValue) CompactRecordSize {
 	}
 	return value.(CompactRecordSize)
 }
+
+func MustBeTaggedFields(value KafkaProtocolValue) TaggedFields {
+	if value.GetType() != "TAGGED_FIELDS" {
+		panic(fmt.Sprintf("Codecrafters Internal Error - Value of type %s is not TAGGED_FIELDS", value.GetType()))
+	}
+	return value.(TaggedFields)
+}